@@ -123,6 +123,7 @@ import (
 	liquidfarmingkeeper "github.com/crescent-network/crescent/v5/x/liquidfarming/keeper"
 	liquidfarmingtypes "github.com/crescent-network/crescent/v5/x/liquidfarming/types"
 	"github.com/crescent-network/crescent/v5/x/liquidity"
+	liquidityclient "github.com/crescent-network/crescent/v5/x/liquidity/client"
 	liquiditykeeper "github.com/crescent-network/crescent/v5/x/liquidity/keeper"
 	liquiditytypes "github.com/crescent-network/crescent/v5/x/liquidity/types"
 	"github.com/crescent-network/crescent/v5/x/liquidstaking"
@@ -172,6 +173,10 @@ var (
 			farmingclient.ProposalHandler,
 			marketmakerclient.ProposalHandler,
 			lpfarmclient.ProposalHandler,
+			liquidityclient.ProposalHandler,
+			liquidityclient.StablePoolAmplificationProposalHandler,
+			liquidityclient.PoolManagementProposalHandler,
+			liquidityclient.PairOrderRulesProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -482,6 +487,11 @@ func NewApp(
 		app.GetSubspace(liquiditytypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
+		liquiditytypes.ReadConfig(appOpts),
+	)
+	// register the liquidity hooks
+	app.LiquidityKeeper = *app.LiquidityKeeper.SetHooks(
+		liquiditytypes.NewMultiLiquidityHooks(),
 	)
 	app.MarketMakerKeeper = marketmakerkeeper.NewKeeper(
 		appCodec,
@@ -530,7 +540,8 @@ func NewApp(
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(farmingtypes.RouterKey, farming.NewPublicPlanProposalHandler(app.FarmingKeeper)).
 		AddRoute(marketmakertypes.RouterKey, marketmaker.NewMarketMakerProposalHandler(app.MarketMakerKeeper)).
-		AddRoute(lpfarmtypes.RouterKey, lpfarm.NewFarmingPlanProposalHandler(app.LPFarmKeeper))
+		AddRoute(lpfarmtypes.RouterKey, lpfarm.NewFarmingPlanProposalHandler(app.LPFarmKeeper)).
+		AddRoute(liquiditytypes.RouterKey, liquidity.NewProposalHandler(app.LiquidityKeeper))
 
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec,
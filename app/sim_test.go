@@ -359,3 +359,71 @@ func TestAppStateDeterminism(t *testing.T) {
 		}
 	}
 }
+
+// appOptions is a map-backed implementation of AppOptions.
+type appOptions map[string]interface{}
+
+// Get implements AppOptions
+func (opts appOptions) Get(key string) interface{} {
+	return opts[key]
+}
+
+// TestAppStateDeterminismParallelMatching checks that the app hash doesn't
+// change whether the liquidity module matches the pairs in parallel or not.
+func TestAppStateDeterminismParallelMatching(t *testing.T) {
+	if !simapp.FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+
+	config := simapp.NewConfigFromFlags()
+	config.InitialBlockHeight = 1
+	config.ExportParamsPath = ""
+	config.OnOperation = false
+	config.AllInvariants = false
+	config.ChainID = helpers.SimAppChainID
+
+	numSeeds := 3
+
+	for i := 0; i < numSeeds; i++ {
+		config.Seed = rand.Int63()
+
+		var appHashes [2][]byte
+		for j, parallel := range []bool{false, true} {
+			var logger log.Logger
+			if simapp.FlagVerboseValue {
+				logger = log.TestingLogger()
+			} else {
+				logger = log.NewNopLogger()
+			}
+
+			db := dbm.NewMemDB()
+			app := NewApp(logger, db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue,
+				MakeEncodingConfig(), appOptions{liquiditytypes.FlagParallelMatching: parallel}, fauxMerkleModeOpt)
+
+			fmt.Printf(
+				"running parallel matching determinism simulation; seed %d: %d/%d, parallel: %t\n",
+				config.Seed, i+1, numSeeds, parallel,
+			)
+
+			_, _, err := simulation.SimulateFromSeed(
+				t,
+				os.Stdout,
+				app.BaseApp,
+				AppStateFn(app.AppCodec(), app.SimulationManager()),
+				simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
+				simapp.SimulationOperations(app, app.AppCodec(), config),
+				app.ModuleAccountAddrs(),
+				config,
+				app.AppCodec(),
+			)
+			require.NoError(t, err)
+
+			appHashes[j] = app.LastCommitID().Hash
+		}
+
+		require.Equal(
+			t, string(appHashes[0]), string(appHashes[1]),
+			"parallel matching changed the app hash in seed %d: %d/%d\n", config.Seed, i+1, numSeeds,
+		)
+	}
+}
@@ -34,6 +34,7 @@ import (
 
 	chain "github.com/crescent-network/crescent/v5/app"
 	farmingparams "github.com/crescent-network/crescent/v5/app/params"
+	liquiditytypes "github.com/crescent-network/crescent/v5/x/liquidity/types"
 )
 
 var (
@@ -126,6 +127,8 @@ func initAppConfig() (string, interface{}) {
 		serverconfig.Config
 
 		WASM WASMConfig `mapstructure:"wasm"`
+
+		Liquidity liquiditytypes.Config `mapstructure:"liquidity"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -151,6 +154,7 @@ func initAppConfig() (string, interface{}) {
 			LruSize:       1,
 			QueryGasLimit: 300000,
 		},
+		Liquidity: liquiditytypes.DefaultConfig(),
 	}
 
 	customAppTemplate := serverconfig.DefaultConfigTemplate + `
@@ -159,7 +163,12 @@ func initAppConfig() (string, interface{}) {
 query_gas_limit = 300000
 # This is the number of wasm vm instances we keep cached in memory for speed-up
 # Warning: this is currently unstable and may lead to crashes, best to keep for 0 unless testing locally
-lru_size = 0`
+lru_size = 0
+
+[liquidity]
+# Compute the matching of the pairs concurrently in the batch.
+# The resulting state is the same as the serial matching.
+parallel-matching = false`
 
 	return customAppTemplate, customAppConfig
 }
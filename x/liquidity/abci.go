@@ -23,4 +23,5 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	if ctx.BlockHeight()%int64(params.BatchSize) == 0 {
 		k.ExecuteRequests(ctx)
 	}
+	k.TakePoolAccrualSnapshots(ctx)
 }
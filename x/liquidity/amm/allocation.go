@@ -0,0 +1,147 @@
+package amm
+
+import (
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ AllocationPolicy = PriorityAllocationPolicy{}
+	_ AllocationPolicy = ProRataAllocationPolicy{}
+)
+
+// AllocationPolicy defines how an amount matched at a tick is allocated to
+// the orders at the tick.
+type AllocationPolicy interface {
+	// Allocate fills the orders by the amount in total at the price.
+	// The amount must not exceed the total matchable amount of the orders.
+	Allocate(orders []Order, amt sdk.Int, price sdk.Dec) (quoteCoinDiff sdk.Int)
+}
+
+// PriorityAllocationPolicy allocates the amount to orders with higher
+// priority(have lower batch id) first, then the remaining amount is
+// allocated to the remaining orders.
+// Within the same batch, the amount is distributed by
+// DistributeOrderAmountToOrders.
+type PriorityAllocationPolicy struct{}
+
+// Allocate implements AllocationPolicy.
+func (PriorityAllocationPolicy) Allocate(orders []Order, amt sdk.Int, price sdk.Dec) (quoteCoinDiff sdk.Int) {
+	remainingAmt := amt
+	quoteCoinDiff = sdk.ZeroInt()
+	groups := GroupOrdersByBatchId(orders)
+	for _, group := range groups {
+		openAmt := TotalMatchableAmount(group.Orders, price)
+		if openAmt.IsZero() {
+			continue
+		}
+		if remainingAmt.GTE(openAmt) {
+			quoteCoinDiff = quoteCoinDiff.Add(FulfillOrders(group.Orders, price))
+			remainingAmt = remainingAmt.Sub(openAmt)
+		} else {
+			SortOrders(group.Orders)
+			quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToOrders(group.Orders, remainingAmt, price))
+			remainingAmt = sdk.ZeroInt()
+		}
+		if remainingAmt.IsZero() {
+			break
+		}
+	}
+	return
+}
+
+// ProRataAllocationPolicy allocates the amount to all orders at the tick
+// proportional to each order's matchable amount, regardless of the
+// orders' priority.
+// Dust amount due to the truncation is allocated one by one to the orders
+// with the largest truncated remainders, and ties are broken by the orders'
+// priority.
+// Sell orders whose allocated amount is too small to receive any quote coin
+// are excluded and the amount is allocated again to the other orders.
+type ProRataAllocationPolicy struct{}
+
+// Allocate implements AllocationPolicy.
+func (ProRataAllocationPolicy) Allocate(orders []Order, amt sdk.Int, price sdk.Dec) (quoteCoinDiff sdk.Int) {
+	var candidates []Order
+	for _, order := range orders {
+		if MatchableAmount(order, price).IsPositive() {
+			candidates = append(candidates, order)
+		}
+	}
+	SortOrders(candidates)
+
+	for len(candidates) > 0 {
+		if TotalMatchableAmount(candidates, price).LT(amt) {
+			break
+		}
+		allocatedAmts := AllocateProRata(candidates, amt, price)
+		var remainingCandidates []Order
+		for i, order := range candidates {
+			if order.GetDirection() == Sell && allocatedAmts[i].IsPositive() &&
+				price.MulInt(allocatedAmts[i]).TruncateInt().IsZero() {
+				continue
+			}
+			remainingCandidates = append(remainingCandidates, order)
+		}
+		if len(remainingCandidates) == len(candidates) {
+			quoteCoinDiff = sdk.ZeroInt()
+			for i, order := range candidates {
+				if allocatedAmts[i].IsPositive() {
+					quoteCoinDiff = quoteCoinDiff.Add(FillOrder(order, allocatedAmts[i], price))
+				}
+			}
+			return quoteCoinDiff
+		}
+		candidates = remainingCandidates
+	}
+	// The amount cannot be allocated pro-rata without making orders
+	// receive nothing, so fall back to the priority allocation.
+	return PriorityAllocationPolicy{}.Allocate(orders, amt, price)
+}
+
+// AllocateProRata returns the amounts allocated to each order when
+// the amount is allocated to the orders proportional to their matchable
+// amounts at the price.
+// The orders must be sorted by their priority, which is used to break ties
+// when allocating the dust amount.
+func AllocateProRata(orders []Order, amt sdk.Int, price sdk.Dec) []sdk.Int {
+	matchableAmts := make([]sdk.Int, len(orders))
+	totalAmt := sdk.ZeroInt()
+	for i, order := range orders {
+		matchableAmts[i] = MatchableAmount(order, price)
+		totalAmt = totalAmt.Add(matchableAmts[i])
+	}
+	if amt.GTE(totalAmt) {
+		return matchableAmts
+	}
+
+	allocatedAmts := make([]sdk.Int, len(orders))
+	remainders := make([]*big.Int, len(orders))
+	remainingAmt := amt
+	for i := range orders {
+		// Use big.Int to avoid overflow during the multiplication.
+		q, r := new(big.Int).QuoRem(
+			new(big.Int).Mul(amt.BigInt(), matchableAmts[i].BigInt()), totalAmt.BigInt(), new(big.Int))
+		allocatedAmts[i] = sdk.NewIntFromBigInt(q)
+		remainders[i] = r
+		remainingAmt = remainingAmt.Sub(allocatedAmts[i])
+	}
+
+	idxs := make([]int, len(orders))
+	for i := range idxs {
+		idxs[i] = i
+	}
+	sort.SliceStable(idxs, func(i, j int) bool {
+		return remainders[idxs[i]].Cmp(remainders[idxs[j]]) > 0
+	})
+	for _, i := range idxs {
+		if !remainingAmt.IsPositive() || remainders[i].Sign() == 0 {
+			break
+		}
+		allocatedAmts[i] = allocatedAmts[i].Add(sdk.OneInt())
+		remainingAmt = remainingAmt.Sub(sdk.OneInt())
+	}
+	return allocatedAmts
+}
@@ -0,0 +1,134 @@
+package amm_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/liquidity/amm"
+)
+
+// batchOrder is an order with a batch id, which is used to test
+// the priority between orders.
+type batchOrder struct {
+	*amm.BaseOrder
+	batchId uint64
+}
+
+func newBatchOrder(batchId uint64, dir amm.OrderDirection, price sdk.Dec, amt sdk.Int) *batchOrder {
+	return &batchOrder{
+		BaseOrder: newOrder(dir, price, amt).(*amm.BaseOrder),
+		batchId:   batchId,
+	}
+}
+
+func (order *batchOrder) GetBatchId() uint64 {
+	return order.batchId
+}
+
+func TestPriorityAllocationPolicy(t *testing.T) {
+	price := utils.ParseDec("1.0")
+	orders := []amm.Order{
+		newBatchOrder(2, amm.Sell, price, sdk.NewInt(200)),
+		newBatchOrder(1, amm.Sell, price, sdk.NewInt(100)),
+	}
+	amm.PriorityAllocationPolicy{}.Allocate(orders, sdk.NewInt(100), price)
+	// The order from the earlier batch takes up the whole amount.
+	require.True(sdk.IntEq(t, sdk.NewInt(200), orders[0].GetOpenAmount()))
+	require.True(sdk.IntEq(t, sdk.ZeroInt(), orders[1].GetOpenAmount()))
+}
+
+func TestProRataAllocationPolicy(t *testing.T) {
+	price := utils.ParseDec("1.0")
+	for _, tc := range []struct {
+		name       string
+		amts       []int64
+		amt        int64
+		matchedAmt []int64
+	}{
+		{
+			"exact split",
+			[]int64{100, 200, 300},
+			300,
+			[]int64{50, 100, 150},
+		},
+		{
+			"dust to the largest remainder",
+			[]int64{100, 200, 300},
+			301,
+			[]int64{50, 100, 151},
+		},
+		{
+			"dust to the order with higher priority on ties",
+			[]int64{100, 100, 100},
+			200,
+			[]int64{67, 67, 66},
+		},
+		{
+			"whole amount",
+			[]int64{100, 200},
+			300,
+			[]int64{100, 200},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var orders []amm.Order
+			for i, amt := range tc.amts {
+				// Later orders come from earlier batches, which doesn't matter
+				// in the pro-rata allocation.
+				orders = append(orders, newBatchOrder(uint64(len(tc.amts)-i), amm.Buy, price, sdk.NewInt(amt)))
+			}
+			quoteCoinDiff := amm.ProRataAllocationPolicy{}.Allocate(orders, sdk.NewInt(tc.amt), price)
+			require.True(sdk.IntEq(t, sdk.NewInt(tc.amt), quoteCoinDiff))
+			for i, order := range orders {
+				require.True(sdk.IntEq(t, sdk.NewInt(tc.matchedAmt[i]), order.GetAmount().Sub(order.GetOpenAmount())))
+			}
+		})
+	}
+}
+
+func TestProRataAllocationPolicy_SmallSellOrders(t *testing.T) {
+	// A sell order which would receive no quote coin from its share is
+	// excluded from the allocation.
+	price := utils.ParseDec("0.1")
+	orders := []amm.Order{
+		newBatchOrder(1, amm.Sell, price, sdk.NewInt(10000)),
+		newBatchOrder(1, amm.Sell, price, sdk.NewInt(100)),
+	}
+	amm.ProRataAllocationPolicy{}.Allocate(orders, sdk.NewInt(500), price)
+	require.True(sdk.IntEq(t, sdk.NewInt(9500), orders[0].GetOpenAmount()))
+	require.True(sdk.IntEq(t, sdk.NewInt(100), orders[1].GetOpenAmount()))
+	for _, order := range orders {
+		if order.IsMatched() {
+			require.True(t, order.GetReceivedDemandCoinAmount().IsPositive())
+		}
+	}
+}
+
+func TestOrderBook_ProRataMatch(t *testing.T) {
+	price := utils.ParseDec("1.0")
+	newOrderBook := func() (*amm.OrderBook, []amm.Order) {
+		sellOrders := []amm.Order{
+			newBatchOrder(1, amm.Sell, price, sdk.NewInt(3000)),
+			newBatchOrder(2, amm.Sell, price, sdk.NewInt(1000)),
+		}
+		ob := amm.NewOrderBook(append(sellOrders, newBatchOrder(3, amm.Buy, price, sdk.NewInt(2000)))...)
+		return ob, sellOrders
+	}
+
+	ob, sellOrders := newOrderBook()
+	_, _, matched := ob.Match(price)
+	require.True(t, matched)
+	// With the default policy, the order from the later batch is starved.
+	require.True(sdk.IntEq(t, sdk.NewInt(1000), sellOrders[0].GetOpenAmount()))
+	require.False(t, sellOrders[1].IsMatched())
+
+	ob, sellOrders = newOrderBook()
+	ob.SetAllocationPolicy(amm.ProRataAllocationPolicy{})
+	_, _, matched = ob.Match(price)
+	require.True(t, matched)
+	require.True(sdk.IntEq(t, sdk.NewInt(1500), sellOrders[0].GetOpenAmount()))
+	require.True(sdk.IntEq(t, sdk.NewInt(500), sellOrders[1].GetOpenAmount()))
+}
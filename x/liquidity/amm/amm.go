@@ -15,3 +15,16 @@ var (
 	MaxPoolPrice               = sdk.NewIntWithDecimal(1, 20).ToDec() // 10^20
 	MinRangedPoolPriceGapRatio = sdk.NewDecWithPrec(1, 3)             // 0.001, 0.1%
 )
+
+// The minimum and maximum amplification coefficient of a stable pool.
+const (
+	MinStablePoolAmplification uint32 = 1
+	MaxStablePoolAmplification uint32 = 10000
+)
+
+// The minimum weight of each coin and the total weight of a weighted pool.
+// Weights are expressed in percentages.
+const (
+	MinWeightedPoolWeight   uint32 = 1
+	TotalWeightedPoolWeight uint32 = 100
+)
@@ -192,7 +192,7 @@ func (ob *OrderBook) MatchAtSinglePrice(matchPrice sdk.Dec) (quoteCoinDiff sdk.I
 					break
 				}
 			} else {
-				quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(tick, remainingAmt, matchPrice))
+				quoteCoinDiff = quoteCoinDiff.Add(ob.allocationPolicy.Allocate(tick.orders, remainingAmt, matchPrice))
 				break
 			}
 		}
@@ -281,16 +281,16 @@ func (ob *OrderBook) Match(lastPrice sdk.Dec) (matchPrice sdk.Dec, quoteCoinDiff
 			continue
 		}
 		if buyTickOpenAmt.LTE(sellTickOpenAmt) {
-			quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(buyTick, buyTickOpenAmt, p))
+			quoteCoinDiff = quoteCoinDiff.Add(ob.allocationPolicy.Allocate(buyTick.orders, buyTickOpenAmt, p))
 			bi++
 		} else {
-			quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(buyTick, sellTickOpenAmt, p))
+			quoteCoinDiff = quoteCoinDiff.Add(ob.allocationPolicy.Allocate(buyTick.orders, sellTickOpenAmt, p))
 		}
 		if sellTickOpenAmt.LTE(buyTickOpenAmt) {
-			quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(sellTick, sellTickOpenAmt, p))
+			quoteCoinDiff = quoteCoinDiff.Add(ob.allocationPolicy.Allocate(sellTick.orders, sellTickOpenAmt, p))
 			si++
 		} else {
-			quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToTick(sellTick, buyTickOpenAmt, p))
+			quoteCoinDiff = quoteCoinDiff.Add(ob.allocationPolicy.Allocate(sellTick.orders, buyTickOpenAmt, p))
 		}
 		matchPrice = p
 		matched = true
@@ -302,28 +302,11 @@ func (ob *OrderBook) Match(lastPrice sdk.Dec) (matchPrice sdk.Dec, quoteCoinDiff
 // at the tick.
 // Orders with higher priority(have lower batch id) get matched first,
 // then the remaining amount is distributed to the remaining orders.
+//
+// Deprecated: Use PriorityAllocationPolicy or the order book's allocation
+// policy instead.
 func DistributeOrderAmountToTick(tick *orderBookTick, amt sdk.Int, price sdk.Dec) (quoteCoinDiff sdk.Int) {
-	remainingAmt := amt
-	quoteCoinDiff = sdk.ZeroInt()
-	groups := GroupOrdersByBatchId(tick.orders)
-	for _, group := range groups {
-		openAmt := TotalMatchableAmount(group.Orders, price)
-		if openAmt.IsZero() {
-			continue
-		}
-		if remainingAmt.GTE(openAmt) {
-			quoteCoinDiff = quoteCoinDiff.Add(FulfillOrders(group.Orders, price))
-			remainingAmt = remainingAmt.Sub(openAmt)
-		} else {
-			SortOrders(group.Orders)
-			quoteCoinDiff = quoteCoinDiff.Add(DistributeOrderAmountToOrders(group.Orders, remainingAmt, price))
-			remainingAmt = sdk.ZeroInt()
-		}
-		if remainingAmt.IsZero() {
-			break
-		}
-	}
-	return
+	return PriorityAllocationPolicy{}.Allocate(tick.orders, amt, price)
 }
 
 // DistributeOrderAmountToOrders distributes the given order amount to the orders
@@ -91,72 +91,76 @@ func TestMatchOrders(t *testing.T) {
 	_, _, matched := amm.NewOrderBook().Match(utils.ParseDec("1.0"))
 	require.False(t, matched)
 
-	for _, tc := range []struct {
-		name          string
-		ob            *amm.OrderBook
-		lastPrice     sdk.Dec
-		matched       bool
-		matchPrice    sdk.Dec
-		quoteCoinDust sdk.Int
-	}{
-		{
-			"happy case",
-			amm.NewOrderBook(
-				newOrder(amm.Buy, utils.ParseDec("1.0"), sdk.NewInt(10000)),
-				newOrder(amm.Sell, utils.ParseDec("1.0"), sdk.NewInt(10000)),
-			),
-			utils.ParseDec("1.0"),
-			true,
-			utils.ParseDec("1.0"),
-			sdk.ZeroInt(),
-		},
-		{
-			"happy case #2",
-			amm.NewOrderBook(
-				newOrder(amm.Buy, utils.ParseDec("1.1"), sdk.NewInt(10000)),
-				newOrder(amm.Sell, utils.ParseDec("0.9"), sdk.NewInt(10000)),
-			),
-			utils.ParseDec("1.0"),
-			true,
-			utils.ParseDec("1.0"),
-			sdk.ZeroInt(),
-		},
-		{
-			"positive quote coin dust",
-			amm.NewOrderBook(
-				newOrder(amm.Buy, utils.ParseDec("0.9999"), sdk.NewInt(1000)),
-				newOrder(amm.Buy, utils.ParseDec("0.9999"), sdk.NewInt(1000)),
-				newOrder(amm.Sell, utils.ParseDec("0.9999"), sdk.NewInt(1000)),
-				newOrder(amm.Sell, utils.ParseDec("0.9999"), sdk.NewInt(1000)),
-			),
-			utils.ParseDec("0.9999"),
-			true,
-			utils.ParseDec("0.9999"),
-			sdk.NewInt(2),
-		},
-	} {
-		t.Run(tc.name, func(t *testing.T) {
-			matchPrice, quoteCoinDust, matched := tc.ob.Match(tc.lastPrice)
-			require.Equal(t, tc.matched, matched)
-			require.True(sdk.DecEq(t, tc.matchPrice, matchPrice))
-			if matched {
-				require.True(sdk.IntEq(t, tc.quoteCoinDust, quoteCoinDust))
-				for _, order := range tc.ob.Orders() {
-					if order.IsMatched() {
-						paid := order.GetPaidOfferCoinAmount()
-						received := order.GetReceivedDemandCoinAmount()
-						var effPrice sdk.Dec // Effective swap price
-						switch order.GetDirection() {
-						case amm.Buy:
-							effPrice = paid.ToDec().QuoInt(received)
-						case amm.Sell:
-							effPrice = received.ToDec().QuoInt(paid)
+	// Every case is tested with all allocation policies.
+	for _, policy := range []amm.AllocationPolicy{amm.PriorityAllocationPolicy{}, amm.ProRataAllocationPolicy{}} {
+		for _, tc := range []struct {
+			name          string
+			ob            *amm.OrderBook
+			lastPrice     sdk.Dec
+			matched       bool
+			matchPrice    sdk.Dec
+			quoteCoinDust sdk.Int
+		}{
+			{
+				"happy case",
+				amm.NewOrderBook(
+					newOrder(amm.Buy, utils.ParseDec("1.0"), sdk.NewInt(10000)),
+					newOrder(amm.Sell, utils.ParseDec("1.0"), sdk.NewInt(10000)),
+				),
+				utils.ParseDec("1.0"),
+				true,
+				utils.ParseDec("1.0"),
+				sdk.ZeroInt(),
+			},
+			{
+				"happy case #2",
+				amm.NewOrderBook(
+					newOrder(amm.Buy, utils.ParseDec("1.1"), sdk.NewInt(10000)),
+					newOrder(amm.Sell, utils.ParseDec("0.9"), sdk.NewInt(10000)),
+				),
+				utils.ParseDec("1.0"),
+				true,
+				utils.ParseDec("1.0"),
+				sdk.ZeroInt(),
+			},
+			{
+				"positive quote coin dust",
+				amm.NewOrderBook(
+					newOrder(amm.Buy, utils.ParseDec("0.9999"), sdk.NewInt(1000)),
+					newOrder(amm.Buy, utils.ParseDec("0.9999"), sdk.NewInt(1000)),
+					newOrder(amm.Sell, utils.ParseDec("0.9999"), sdk.NewInt(1000)),
+					newOrder(amm.Sell, utils.ParseDec("0.9999"), sdk.NewInt(1000)),
+				),
+				utils.ParseDec("0.9999"),
+				true,
+				utils.ParseDec("0.9999"),
+				sdk.NewInt(2),
+			},
+		} {
+			t.Run(fmt.Sprintf("%T/%s", policy, tc.name), func(t *testing.T) {
+				tc.ob.SetAllocationPolicy(policy)
+				matchPrice, quoteCoinDust, matched := tc.ob.Match(tc.lastPrice)
+				require.Equal(t, tc.matched, matched)
+				require.True(sdk.DecEq(t, tc.matchPrice, matchPrice))
+				if matched {
+					require.True(sdk.IntEq(t, tc.quoteCoinDust, quoteCoinDust))
+					for _, order := range tc.ob.Orders() {
+						if order.IsMatched() {
+							paid := order.GetPaidOfferCoinAmount()
+							received := order.GetReceivedDemandCoinAmount()
+							var effPrice sdk.Dec // Effective swap price
+							switch order.GetDirection() {
+							case amm.Buy:
+								effPrice = paid.ToDec().QuoInt(received)
+							case amm.Sell:
+								effPrice = received.ToDec().QuoInt(paid)
+							}
+							require.True(t, utils.DecApproxEqual(tc.lastPrice, effPrice))
 						}
-						require.True(t, utils.DecApproxEqual(tc.lastPrice, effPrice))
 					}
 				}
-			}
-		})
+			})
+		}
 	}
 }
 
@@ -10,19 +10,28 @@ import (
 
 // OrderBook is an order book.
 type OrderBook struct {
-	buys, sells *orderBookTicks
+	buys, sells      *orderBookTicks
+	allocationPolicy AllocationPolicy
 }
 
 // NewOrderBook returns a new OrderBook.
+// The order book uses PriorityAllocationPolicy by default.
 func NewOrderBook(orders ...Order) *OrderBook {
 	ob := &OrderBook{
-		buys:  newOrderBookBuyTicks(),
-		sells: newOrderBookSellTicks(),
+		buys:             newOrderBookBuyTicks(),
+		sells:            newOrderBookSellTicks(),
+		allocationPolicy: PriorityAllocationPolicy{},
 	}
 	ob.AddOrder(orders...)
 	return ob
 }
 
+// SetAllocationPolicy sets the policy used to allocate matched amount to
+// the orders at the same tick.
+func (ob *OrderBook) SetAllocationPolicy(policy AllocationPolicy) {
+	ob.allocationPolicy = policy
+}
+
 // AddOrder adds orders to the order book.
 func (ob *OrderBook) AddOrder(orders ...Order) {
 	for _, order := range orders {
@@ -12,6 +12,9 @@ import (
 var (
 	_ Pool = (*BasicPool)(nil)
 	_ Pool = (*RangedPool)(nil)
+	_ Pool = (*StablePool)(nil)
+	_ Pool = (*WeightedPool)(nil)
+	_ Pool = (*ConcentratedPool)(nil)
 )
 
 // Pool is the interface of a pool.
@@ -472,8 +475,844 @@ func (pool *RangedPool) Clone() Pool {
 	}
 }
 
+// StablePool is the stableswap pool type, which is designed for two coins
+// pegged to each other.
+// The pool follows the curve-style invariant for two coins:
+// 4A(x + y) + D = 4AD + D^3/(4xy)
+// where A is the amplification coefficient and D is the invariant.
+type StablePool struct {
+	rx, ry sdk.Int
+	ps     sdk.Int
+	// amp is the amplification coefficient of the pool.
+	amp uint32
+	// d is the pool's invariant derived from rx and ry.
+	d sdk.Dec
+}
+
+// NewStablePool returns a new StablePool.
+// It is OK to pass an empty sdk.Int to ps when ps is not going to be used.
+func NewStablePool(rx, ry, ps sdk.Int, amp uint32) *StablePool {
+	return &StablePool{
+		rx:  rx,
+		ry:  ry,
+		ps:  ps,
+		amp: amp,
+		d:   StableSwapInvariant(rx, ry, amp),
+	}
+}
+
+// CreateStablePool creates new StablePool from given inputs, while validating
+// the inputs and using the initial pool coin supply.
+func CreateStablePool(rx, ry sdk.Int, amp uint32) (*StablePool, error) {
+	if rx.IsZero() || ry.IsZero() {
+		return nil, fmt.Errorf("cannot create stable pool with zero reserve amount")
+	}
+	if err := ValidateStablePoolAmplification(amp); err != nil {
+		return nil, err
+	}
+	pool := NewStablePool(rx, ry, InitialPoolCoinSupply(rx, ry), amp)
+	if pool.d.IsZero() {
+		return nil, fmt.Errorf("reserve amounts are too imbalanced")
+	}
+	p := pool.Price()
+	if p.LT(MinPoolPrice) {
+		return nil, fmt.Errorf("pool price is lower than min price %s", MinPoolPrice)
+	}
+	if p.GT(MaxPoolPrice) {
+		return nil, fmt.Errorf("pool price is greater than max price %s", MaxPoolPrice)
+	}
+	return pool, nil
+}
+
+// ValidateStablePoolAmplification validates the amplification coefficient
+// of a stable pool.
+func ValidateStablePoolAmplification(amp uint32) error {
+	if amp < MinStablePoolAmplification {
+		return fmt.Errorf("amplification must not be lower than %d", MinStablePoolAmplification)
+	}
+	if amp > MaxStablePoolAmplification {
+		return fmt.Errorf("amplification must not be greater than %d", MaxStablePoolAmplification)
+	}
+	return nil
+}
+
+// Balances returns the balances of the pool.
+func (pool *StablePool) Balances() (rx, ry sdk.Int) {
+	return pool.rx, pool.ry
+}
+
+// SetBalances sets StablePool's balances without recalculating
+// the invariant unless derive is true.
+func (pool *StablePool) SetBalances(rx, ry sdk.Int, derive bool) {
+	if derive {
+		pool.d = StableSwapInvariant(rx, ry, pool.amp)
+	}
+	pool.rx = rx
+	pool.ry = ry
+}
+
+// PoolCoinSupply returns the pool coin supply.
+func (pool *StablePool) PoolCoinSupply() sdk.Int {
+	return pool.ps
+}
+
+// Amplification returns the amplification coefficient of the pool.
+func (pool *StablePool) Amplification() uint32 {
+	return pool.amp
+}
+
+// Price returns the pool price.
+// P = (16A*u^2*v^2 + u) / (16A*u^2*v^2 + v), where u = rx/D and v = ry/D
+func (pool *StablePool) Price() sdk.Dec {
+	if pool.rx.IsZero() || pool.ry.IsZero() || pool.d.IsZero() {
+		panic("pool price is not defined for a depleted pool")
+	}
+	return stablePrice(pool.rx.ToDec().Quo(pool.d), pool.ry.ToDec().Quo(pool.d), pool.amp)
+}
+
+// IsDepleted returns whether the pool is depleted or not.
+func (pool *StablePool) IsDepleted() bool {
+	return pool.ps.IsZero() || pool.rx.IsZero() || pool.ry.IsZero()
+}
+
+// HighestBuyPrice returns the highest buy price of the pool.
+func (pool *StablePool) HighestBuyPrice() (price sdk.Dec, found bool) {
+	// The highest buy price is actually a bit lower than pool price,
+	// but it's not important for our matching logic.
+	return pool.Price(), true
+}
+
+// LowestSellPrice returns the lowest sell price of the pool.
+func (pool *StablePool) LowestSellPrice() (price sdk.Dec, found bool) {
+	// The lowest sell price is actually a bit higher than the pool price,
+	// but it's not important for our matching logic.
+	return pool.Price(), true
+}
+
+// BuyAmountOver returns the amount of buy orders for price greater than
+// or equal to given price.
+func (pool *StablePool) BuyAmountOver(price sdk.Dec, _ bool) (amt sdk.Int) {
+	origPrice := price
+	if price.LT(MinPoolPrice) {
+		price = MinPoolPrice
+	}
+	if price.GTE(pool.Price()) {
+		return zeroInt
+	}
+	// dx = rx - x', where x' is the x reserve at which the pool price
+	// becomes P along the curve.
+	dx := pool.rx.ToDec().Sub(stableXAt(price, pool.amp).Mul(pool.d))
+	if !dx.IsPositive() {
+		return zeroInt
+	} else if dx.GT(pool.rx.ToDec()) {
+		dx = pool.rx.ToDec()
+	}
+	utils.SafeMath(func() {
+		amt = dx.QuoTruncate(origPrice).TruncateInt() // dy = dx / P
+		if amt.GT(MaxCoinAmount) {
+			amt = MaxCoinAmount
+		}
+	}, func() {
+		amt = MaxCoinAmount
+	})
+	return
+}
+
+// SellAmountUnder returns the amount of sell orders for price less than
+// or equal to given price.
+func (pool *StablePool) SellAmountUnder(price sdk.Dec, _ bool) (amt sdk.Int) {
+	if price.GT(MaxPoolPrice) {
+		price = MaxPoolPrice
+	}
+	if price.LTE(pool.Price()) {
+		return zeroInt
+	}
+	// dy = ry - y', where y' is the y reserve at which the pool price
+	// becomes P along the curve.
+	// Since the invariant is symmetric, y' can be found the same way as x'
+	// using the inverse of the price.
+	amt = pool.ry.ToDec().Sub(stableXAt(inv(price), pool.amp).Mul(pool.d)).TruncateInt()
+	if amt.GT(pool.ry) {
+		amt = pool.ry
+	}
+	if !amt.IsPositive() {
+		return zeroInt
+	}
+	return
+}
+
+// BuyAmountTo returns the amount of buy orders of the pool for price,
+// where BuyAmountTo is used when the pool price is higher than the highest
+// price of the order book.
+// Since the pool buys all the amount at the single price, the invariant
+// grows after the trade. The amount is found by a binary search so that
+// the pool price after the trade does not go below the price.
+func (pool *StablePool) BuyAmountTo(price sdk.Dec) (amt sdk.Int) {
+	hi := pool.BuyAmountOver(price, true)
+	if hi.IsZero() {
+		return zeroInt
+	}
+	lo := zeroInt
+	for hi.Sub(lo).GT(sdk.OneInt()) {
+		mid := lo.Add(hi).QuoRaw(2)
+		rx := pool.rx.Sub(price.MulInt(mid).Ceil().TruncateInt())
+		if !rx.IsPositive() || NewStablePool(rx, pool.ry.Add(mid), sdk.Int{}, pool.amp).Price().LT(price) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return lo
+}
+
+// SellAmountTo returns the amount of sell orders of the pool for price,
+// where SellAmountTo is used when the pool price is lower than the lowest
+// price of the order book.
+// Like BuyAmountTo, the amount is found by a binary search so that
+// the pool price after the trade does not go above the price.
+func (pool *StablePool) SellAmountTo(price sdk.Dec) (amt sdk.Int) {
+	hi := pool.SellAmountUnder(price, true)
+	if hi.IsZero() {
+		return zeroInt
+	}
+	lo := zeroInt
+	for hi.Sub(lo).GT(sdk.OneInt()) {
+		mid := lo.Add(hi).QuoRaw(2)
+		ry := pool.ry.Sub(mid)
+		if !ry.IsPositive() || NewStablePool(pool.rx.Add(price.MulInt(mid).TruncateInt()), ry, sdk.Int{}, pool.amp).Price().GT(price) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return lo
+}
+
+func (pool *StablePool) Clone() Pool {
+	return &StablePool{
+		rx:  pool.rx,
+		ry:  pool.ry,
+		ps:  pool.ps,
+		amp: pool.amp,
+		d:   pool.d,
+	}
+}
+
+// StableSwapInvariant returns the stableswap invariant D for given reserves
+// and amplification coefficient.
+// It returns zero if any of the reserves is zero.
+func StableSwapInvariant(rx, ry sdk.Int, amp uint32) sdk.Dec {
+	if !rx.IsPositive() || !ry.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	// Solve the invariant with the reserves normalized by S = rx + ry,
+	// to keep intermediate values small, and scale it back.
+	s := rx.Add(ry).ToDec()
+	x, y := rx.ToDec().Quo(s), ry.ToDec().Quo(s)
+	if x.IsZero() || y.IsZero() {
+		return sdk.ZeroDec()
+	}
+	ann := sdk.NewDec(4 * int64(amp)) // A * n^n
+	d := oneDec
+	for i := 0; i < 255; i++ {
+		// dp = D^3 / (4xy)
+		dp := d.Quo(x.MulInt64(2)).Mul(d).Quo(y.MulInt64(2)).Mul(d)
+		prev := d
+		// D = (4A*S + 2*dp) * D / ((4A - 1) * D + 3*dp), where S = 1
+		d = ann.Add(dp.MulInt64(2)).Mul(d).Quo(ann.Sub(oneDec).Mul(d).Add(dp.MulInt64(3)))
+		if d.Sub(prev).Abs().LTE(sdk.SmallestDec()) {
+			break
+		}
+	}
+	return d.Mul(s)
+}
+
+// stablePrice returns the price of a stable pool with normalized
+// reserves u = x/D and v = y/D.
+func stablePrice(u, v sdk.Dec, amp uint32) sdk.Dec {
+	k := sdk.NewDec(16 * int64(amp)).Mul(u).Mul(u).Mul(v).Mul(v) // 16A*u^2*v^2
+	return k.Add(u).Quo(k.Add(v))
+}
+
+// stableXAt returns the normalized x reserve u(= x/D) at which the price of
+// a stable pool becomes given price.
+//
+// With r = 1/uv, the invariant with D = 1 gives u + v = 1 + (r - 4)/16A
+// and the price formula gives u - Pv = 16A(P - 1)/r^2, so u and v can be
+// expressed in terms of r. r is then found by the safeguarded Newton's
+// method solving h(r) = r*u(r)*v(r) - 1 = 0 within [4, inf).
+func stableXAt(price sdk.Dec, amp uint32) sdk.Dec {
+	// The inverse of a very high price used for the sell side can be
+	// truncated to zero, where the pool has to give out all the reserve.
+	if !price.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	a16 := sdk.NewDec(16 * int64(amp))
+	invA16 := inv(a16)
+	invP1 := inv(oneDec.Add(price)) // 1 / (1 + P)
+	pm1 := price.Sub(oneDec)        // P - 1
+	uv := func(r, invR sdk.Dec) (u, v sdk.Dec) {
+		s := oneDec.Add(r.Sub(sdk.NewDec(4)).Mul(invA16)) // u + v
+		k := a16.Mul(invR).Mul(invR)                      // 16A/r^2
+		v = s.Sub(k.Mul(pm1)).Mul(invP1)
+		u = s.Sub(v)
+		return
+	}
+	// h(4) <= 0 and h(r) > 0 for a sufficiently large r.
+	lo, hi := sdk.NewDec(4), sdk.Dec{}
+	r := lo
+	for i := 0; i < 255; i++ {
+		invR := inv(r)
+		u, v := uv(r, invR)
+		h := r.Mul(u).Mul(v).Sub(oneDec)
+		if h.IsZero() {
+			break
+		} else if h.IsNegative() {
+			lo = r
+		} else {
+			hi = r
+		}
+		// h'(r) = uv + r(u'v + uv')
+		dv := invA16.Add(a16.MulInt64(2).Mul(invR).Mul(invR).Mul(invR).Mul(pm1)).Mul(invP1)
+		du := invA16.Sub(dv)
+		dh := u.Mul(v).Add(r.Mul(du.Mul(v).Add(u.Mul(dv))))
+		var next sdk.Dec
+		if !dh.IsZero() {
+			next = r.Sub(h.Quo(dh))
+		}
+		if next.IsNil() || next.LTE(lo) || (!hi.IsNil() && next.GTE(hi)) {
+			if hi.IsNil() {
+				next = lo.MulInt64(2)
+			} else {
+				next = lo.Add(hi).QuoInt64(2)
+			}
+		}
+		if next.Sub(r).Abs().LTE(sdk.SmallestDec()) {
+			r = next
+			break
+		}
+		r = next
+	}
+	u, _ := uv(r, inv(r))
+	return u
+}
+
+// WeightedPool is the constant product pool type whose coins have
+// non-equal weights.
+// The pool follows the invariant x^wx * y^wy = k, where wx and wy are
+// the weights of x coin and y coin respectively.
+type WeightedPool struct {
+	rx, ry sdk.Int
+	ps     sdk.Int
+	// wx and wy are the weights of x coin and y coin.
+	wx, wy uint32
+}
+
+// NewWeightedPool returns a new WeightedPool.
+// It is OK to pass an empty sdk.Int to ps when ps is not going to be used.
+func NewWeightedPool(rx, ry, ps sdk.Int, wx, wy uint32) *WeightedPool {
+	return &WeightedPool{
+		rx: rx,
+		ry: ry,
+		ps: ps,
+		wx: wx,
+		wy: wy,
+	}
+}
+
+// CreateWeightedPool creates new WeightedPool from given inputs, while
+// validating the inputs and using the initial pool coin supply.
+func CreateWeightedPool(rx, ry sdk.Int, wx, wy uint32) (*WeightedPool, error) {
+	if rx.IsZero() || ry.IsZero() {
+		return nil, fmt.Errorf("cannot create weighted pool with zero reserve amount")
+	}
+	if err := ValidateWeightedPoolWeights(wx, wy); err != nil {
+		return nil, err
+	}
+	pool := NewWeightedPool(rx, ry, InitialPoolCoinSupply(rx, ry), wx, wy)
+	p := pool.Price()
+	if p.LT(MinPoolPrice) {
+		return nil, fmt.Errorf("pool price is lower than min price %s", MinPoolPrice)
+	}
+	if p.GT(MaxPoolPrice) {
+		return nil, fmt.Errorf("pool price is greater than max price %s", MaxPoolPrice)
+	}
+	return pool, nil
+}
+
+// ValidateWeightedPoolWeights validates the coin weights of a weighted pool.
+func ValidateWeightedPoolWeights(wx, wy uint32) error {
+	if wx < MinWeightedPoolWeight || wy < MinWeightedPoolWeight {
+		return fmt.Errorf("weight must not be lower than %d", MinWeightedPoolWeight)
+	}
+	if wx+wy != TotalWeightedPoolWeight {
+		return fmt.Errorf("sum of weights must be %d", TotalWeightedPoolWeight)
+	}
+	return nil
+}
+
+// Balances returns the balances of the pool.
+func (pool *WeightedPool) Balances() (rx, ry sdk.Int) {
+	return pool.rx, pool.ry
+}
+
+func (pool *WeightedPool) SetBalances(rx, ry sdk.Int, _ bool) {
+	pool.rx = rx
+	pool.ry = ry
+}
+
+// PoolCoinSupply returns the pool coin supply.
+func (pool *WeightedPool) PoolCoinSupply() sdk.Int {
+	return pool.ps
+}
+
+// Weights returns the weights of x coin and y coin.
+func (pool *WeightedPool) Weights() (wx, wy uint32) {
+	return pool.wx, pool.wy
+}
+
+// Price returns the pool price.
+// P = (X/wx) / (Y/wy)
+func (pool *WeightedPool) Price() sdk.Dec {
+	if pool.rx.IsZero() || pool.ry.IsZero() {
+		panic("pool price is not defined for a depleted pool")
+	}
+	return pool.rx.ToDec().MulInt64(int64(pool.wy)).Quo(pool.ry.ToDec().MulInt64(int64(pool.wx)))
+}
+
+// IsDepleted returns whether the pool is depleted or not.
+func (pool *WeightedPool) IsDepleted() bool {
+	return pool.ps.IsZero() || pool.rx.IsZero() || pool.ry.IsZero()
+}
+
+// HighestBuyPrice returns the highest buy price of the pool.
+func (pool *WeightedPool) HighestBuyPrice() (price sdk.Dec, found bool) {
+	// The highest buy price is actually a bit lower than pool price,
+	// but it's not important for our matching logic.
+	return pool.Price(), true
+}
+
+// LowestSellPrice returns the lowest sell price of the pool.
+func (pool *WeightedPool) LowestSellPrice() (price sdk.Dec, found bool) {
+	// The lowest sell price is actually a bit higher than the pool price,
+	// but it's not important for our matching logic.
+	return pool.Price(), true
+}
+
+// BuyAmountOver returns the amount of buy orders for price greater than
+// or equal to given price.
+// amt = (X - P*Y*wx/wy)/P
+func (pool *WeightedPool) BuyAmountOver(price sdk.Dec, _ bool) (amt sdk.Int) {
+	origPrice := price
+	if price.LT(MinPoolPrice) {
+		price = MinPoolPrice
+	}
+	if price.GTE(pool.Price()) {
+		return zeroInt
+	}
+	dx := pool.rx.ToDec().Sub(price.MulInt(pool.ry).MulInt64(int64(pool.wx)).QuoInt64(int64(pool.wy)))
+	if !dx.IsPositive() {
+		return zeroInt
+	}
+	utils.SafeMath(func() {
+		amt = dx.QuoTruncate(origPrice).TruncateInt()
+		if amt.GT(MaxCoinAmount) {
+			amt = MaxCoinAmount
+		}
+	}, func() {
+		amt = MaxCoinAmount
+	})
+	return
+}
+
+// SellAmountUnder returns the amount of sell orders for price less than
+// or equal to given price.
+// amt = Y - X*wy/(P*wx)
+func (pool *WeightedPool) SellAmountUnder(price sdk.Dec, _ bool) (amt sdk.Int) {
+	if price.GT(MaxPoolPrice) {
+		price = MaxPoolPrice
+	}
+	if price.LTE(pool.Price()) {
+		return zeroInt
+	}
+	y := pool.rx.ToDec().MulInt64(int64(pool.wy)).QuoRoundUp(price.MulInt64(int64(pool.wx)))
+	amt = pool.ry.ToDec().Sub(y).TruncateInt()
+	if !amt.IsPositive() {
+		return zeroInt
+	}
+	return
+}
+
+// BuyAmountTo returns the amount of buy orders of the pool for price,
+// where BuyAmountTo is used when the pool price is higher than the highest
+// price of the order book.
+func (pool *WeightedPool) BuyAmountTo(price sdk.Dec) (amt sdk.Int) {
+	origPrice := price
+	if price.LT(MinPoolPrice) {
+		price = MinPoolPrice
+	}
+	poolPrice := pool.Price()
+	if price.GTE(poolPrice) {
+		return zeroInt
+	}
+	// dx = rx - rx / (Pc/P)^(wy/(wx+wy)), where Pc is the current pool price
+	wx, wy := pool.reducedWeights()
+	dx := pool.rx.ToDec().Sub(pool.rx.ToDec().Quo(decPow(poolPrice.Quo(price), wy, wx+wy)))
+	if !dx.IsPositive() {
+		return zeroInt
+	}
+	utils.SafeMath(func() {
+		amt = dx.QuoTruncate(origPrice).TruncateInt() // dy = dx / P
+		if amt.GT(MaxCoinAmount) {
+			amt = MaxCoinAmount
+		}
+	}, func() {
+		amt = MaxCoinAmount
+	})
+	return
+}
+
+// SellAmountTo returns the amount of sell orders of the pool for price,
+// where SellAmountTo is used when the pool price is lower than the lowest
+// price of the order book.
+func (pool *WeightedPool) SellAmountTo(price sdk.Dec) (amt sdk.Int) {
+	if price.GT(MaxPoolPrice) {
+		price = MaxPoolPrice
+	}
+	poolPrice := pool.Price()
+	if price.LTE(poolPrice) {
+		return zeroInt
+	}
+	// dy = ry - ry / (P/Pc)^(wx/(wx+wy)), where Pc is the current pool price
+	wx, wy := pool.reducedWeights()
+	amt = pool.ry.ToDec().Sub(pool.ry.ToDec().Quo(decPow(price.Quo(poolPrice), wx, wx+wy))).TruncateInt()
+	if !amt.IsPositive() {
+		return zeroInt
+	}
+	return
+}
+
+func (pool *WeightedPool) Clone() Pool {
+	return NewWeightedPool(pool.rx, pool.ry, pool.ps, pool.wx, pool.wy)
+}
+
+// reducedWeights returns the pool's weights divided by their greatest
+// common divisor, which keeps the degree of roots as small as possible.
+func (pool *WeightedPool) reducedWeights() (wx, wy uint64) {
+	d := gcd(pool.wx, pool.wy)
+	return uint64(pool.wx / d), uint64(pool.wy / d)
+}
+
+// LiquidityTick is a price at which the liquidity of a ConcentratedPool
+// changes.
+// LiquidityNet is the amount of liquidity added to the pool when the price
+// crosses the tick upward.
+type LiquidityTick struct {
+	Price        sdk.Dec
+	LiquidityNet sdk.Dec
+}
+
+// ConcentratedPool is the pool type in which each liquidity provider
+// provides liquidity within its own price range.
+// The pool's liquidity curve is the aggregation of all those ranges.
+// Within a price segment between two adjacent ticks, the pool's reserves
+// follow the curve of the segment's liquidity L:
+// x = L * (sqrt(P) - sqrt(Pa)), y = L * (1/sqrt(P) - 1/sqrt(Pb))
+type ConcentratedPool struct {
+	rx, ry sdk.Int
+	// price and sqrtPrice are the pool's current price and its square root.
+	price, sqrtPrice sdk.Dec
+	// sqrtPrices are the square roots of the tick prices in ascending order
+	// and liquidity[i] is the pool's liquidity between sqrtPrices[i] and
+	// sqrtPrices[i+1].
+	sqrtPrices []sdk.Dec
+	liquidity  []sdk.Dec
+	// x and y are the pool's reserve amounts on the curve at the current
+	// price.
+	x, y sdk.Dec
+}
+
+// NewConcentratedPool returns a new ConcentratedPool.
+// ticks must be sorted by price in ascending order.
+// The pool's reserve balances are derived from the liquidity curve,
+// so collected fees in the pool's reserve account are not taken into
+// account.
+func NewConcentratedPool(price sdk.Dec, ticks []LiquidityTick) *ConcentratedPool {
+	pool := &ConcentratedPool{
+		price:     price,
+		sqrtPrice: utils.DecApproxSqrt(price),
+	}
+	liquidity := sdk.ZeroDec()
+	for i, tick := range ticks {
+		pool.sqrtPrices = append(pool.sqrtPrices, utils.DecApproxSqrt(tick.Price))
+		liquidity = liquidity.Add(tick.LiquidityNet)
+		if i < len(ticks)-1 {
+			pool.liquidity = append(pool.liquidity, liquidity)
+		}
+	}
+	pool.x, pool.y = pool.xAt(pool.sqrtPrice), pool.yAt(pool.sqrtPrice)
+	pool.rx, pool.ry = pool.x.TruncateInt(), pool.y.TruncateInt()
+	return pool
+}
+
+// ValidateConcentratedLiquidityRange validates the price range of
+// a concentrated liquidity.
+func ValidateConcentratedLiquidityRange(lowerPrice, upperPrice sdk.Dec) error {
+	if lowerPrice.LT(MinPoolPrice) {
+		return fmt.Errorf("lower price must not be lower than the min price %s", MinPoolPrice)
+	}
+	if upperPrice.GT(MaxPoolPrice) {
+		return fmt.Errorf("upper price must not be greater than the max price %s", MaxPoolPrice)
+	}
+	if !lowerPrice.LT(upperPrice) {
+		return fmt.Errorf("lower price must be lower than upper price")
+	}
+	return nil
+}
+
+// Balances returns the balances of the pool.
+func (pool *ConcentratedPool) Balances() (rx, ry sdk.Int) {
+	return pool.rx, pool.ry
+}
+
+// SetBalances sets ConcentratedPool's balances and moves the pool price
+// along the liquidity curve by the change of ry.
+func (pool *ConcentratedPool) SetBalances(rx, ry sdk.Int, _ bool) {
+	dy := ry.Sub(pool.ry)
+	pool.rx = rx
+	pool.ry = ry
+	if !dy.IsZero() {
+		pool.moveY(pool.y.Add(dy.ToDec()))
+	}
+}
+
+// PoolCoinSupply returns zero since a concentrated pool has no pool coin.
+func (pool *ConcentratedPool) PoolCoinSupply() sdk.Int {
+	return zeroInt
+}
+
+// Price returns the pool price.
+func (pool *ConcentratedPool) Price() sdk.Dec {
+	return pool.price
+}
+
+// Liquidity returns the pool's liquidity at the current price.
+func (pool *ConcentratedPool) Liquidity() sdk.Dec {
+	for i, l := range pool.liquidity {
+		if pool.sqrtPrice.GTE(pool.sqrtPrices[i]) && pool.sqrtPrice.LT(pool.sqrtPrices[i+1]) {
+			return l
+		}
+	}
+	return sdk.ZeroDec()
+}
+
+// IsDepleted returns whether the pool is depleted or not.
+func (pool *ConcentratedPool) IsDepleted() bool {
+	return pool.rx.IsZero() && pool.ry.IsZero()
+}
+
+// HighestBuyPrice returns the highest buy price of the pool.
+func (pool *ConcentratedPool) HighestBuyPrice() (price sdk.Dec, found bool) {
+	return pool.price, pool.rx.IsPositive()
+}
+
+// LowestSellPrice returns the lowest sell price of the pool.
+func (pool *ConcentratedPool) LowestSellPrice() (price sdk.Dec, found bool) {
+	return pool.price, pool.ry.IsPositive()
+}
+
+// BuyAmountOver returns the amount of buy orders for price greater than
+// or equal to given price, which is the amount of y coin provided by
+// the liquidity curve between the current price and given price.
+func (pool *ConcentratedPool) BuyAmountOver(price sdk.Dec, _ bool) (amt sdk.Int) {
+	origPrice := price
+	if price.LT(MinPoolPrice) {
+		price = MinPoolPrice
+	}
+	if price.GTE(pool.price) {
+		return zeroInt
+	}
+	dy := pool.yAt(utils.DecApproxSqrt(price)).Sub(pool.y)
+	if !dy.IsPositive() {
+		return zeroInt
+	}
+	utils.SafeMath(func() {
+		amt = sdk.MinInt(dy.TruncateInt(), pool.rx.ToDec().QuoTruncate(origPrice).TruncateInt())
+		if amt.GT(MaxCoinAmount) {
+			amt = MaxCoinAmount
+		}
+	}, func() {
+		amt = MaxCoinAmount
+	})
+	return
+}
+
+// SellAmountUnder returns the amount of sell orders for price less than
+// or equal to given price, which is the amount of y coin provided by
+// the liquidity curve between the current price and given price.
+func (pool *ConcentratedPool) SellAmountUnder(price sdk.Dec, _ bool) (amt sdk.Int) {
+	if price.GT(MaxPoolPrice) {
+		price = MaxPoolPrice
+	}
+	if price.LTE(pool.price) {
+		return zeroInt
+	}
+	amt = sdk.MinInt(pool.y.Sub(pool.yAt(utils.DecApproxSqrt(price))).TruncateInt(), pool.ry)
+	if !amt.IsPositive() {
+		return zeroInt
+	}
+	return
+}
+
+// BuyAmountTo returns the amount of buy orders of the pool for price,
+// where BuyAmountTo is used when the pool price is higher than the highest
+// price of the order book.
+func (pool *ConcentratedPool) BuyAmountTo(price sdk.Dec) sdk.Int {
+	return pool.BuyAmountOver(price, true)
+}
+
+// SellAmountTo returns the amount of sell orders of the pool for price,
+// where SellAmountTo is used when the pool price is lower than the lowest
+// price of the order book.
+func (pool *ConcentratedPool) SellAmountTo(price sdk.Dec) sdk.Int {
+	return pool.SellAmountUnder(price, true)
+}
+
+func (pool *ConcentratedPool) Clone() Pool {
+	return &ConcentratedPool{
+		rx:         pool.rx,
+		ry:         pool.ry,
+		price:      pool.price,
+		sqrtPrice:  pool.sqrtPrice,
+		sqrtPrices: pool.sqrtPrices,
+		liquidity:  pool.liquidity,
+		x:          pool.x,
+		y:          pool.y,
+	}
+}
+
+// xAt returns the x reserve on the liquidity curve at given sqrt price.
+func (pool *ConcentratedPool) xAt(sqrtPrice sdk.Dec) sdk.Dec {
+	x := sdk.ZeroDec()
+	for i, l := range pool.liquidity {
+		lower := pool.sqrtPrices[i]
+		if sqrtPrice.LTE(lower) {
+			break
+		}
+		if l.IsPositive() {
+			x = x.Add(l.Mul(sdk.MinDec(sqrtPrice, pool.sqrtPrices[i+1]).Sub(lower)))
+		}
+	}
+	return x
+}
+
+// yAt returns the y reserve on the liquidity curve at given sqrt price.
+func (pool *ConcentratedPool) yAt(sqrtPrice sdk.Dec) sdk.Dec {
+	y := sdk.ZeroDec()
+	for i := len(pool.liquidity) - 1; i >= 0; i-- {
+		upper := pool.sqrtPrices[i+1]
+		if sqrtPrice.GTE(upper) {
+			break
+		}
+		if l := pool.liquidity[i]; l.IsPositive() {
+			y = y.Add(l.Quo(sdk.MaxDec(sqrtPrice, pool.sqrtPrices[i])).Sub(l.Quo(upper)))
+		}
+	}
+	return y
+}
+
+// moveY moves the pool price along the liquidity curve, as little as
+// possible, so that the y reserve on the curve becomes y.
+// If the curve doesn't have enough liquidity, the price stops at the end
+// of the curve.
+func (pool *ConcentratedPool) moveY(y sdk.Dec) {
+	s := pool.sqrtPrice
+	remaining := y.Sub(pool.y)
+	if remaining.IsPositive() { // the price goes down
+		for i := len(pool.liquidity) - 1; i >= 0 && remaining.IsPositive(); i-- {
+			lower, upper := pool.sqrtPrices[i], pool.sqrtPrices[i+1]
+			if s.LTE(lower) {
+				continue
+			}
+			s = sdk.MinDec(s, upper)
+			l := pool.liquidity[i]
+			if !l.IsPositive() {
+				s = lower
+				continue
+			}
+			// gain = L/sqrt(Pa) - L/s
+			gain := l.Quo(lower).Sub(l.Quo(s))
+			if remaining.LTE(gain) {
+				s = l.Quo(l.Quo(s).Add(remaining)) // L/s' = L/s + remaining
+				break
+			}
+			remaining = remaining.Sub(gain)
+			s = lower
+		}
+	} else if remaining.IsNegative() { // the price goes up
+		remaining = remaining.Neg()
+		for i := 0; i < len(pool.liquidity) && remaining.IsPositive(); i++ {
+			lower, upper := pool.sqrtPrices[i], pool.sqrtPrices[i+1]
+			if s.GTE(upper) {
+				continue
+			}
+			s = sdk.MaxDec(s, lower)
+			l := pool.liquidity[i]
+			if !l.IsPositive() {
+				s = upper
+				continue
+			}
+			// loss = L/s - L/sqrt(Pb)
+			loss := l.Quo(s).Sub(l.Quo(upper))
+			if remaining.LTE(loss) {
+				s = l.Quo(l.Quo(s).Sub(remaining)) // L/s' = L/s - remaining
+				break
+			}
+			remaining = remaining.Sub(loss)
+			s = upper
+		}
+	}
+	pool.sqrtPrice = s
+	pool.price = s.Power(2)
+	pool.x, pool.y = pool.xAt(s), pool.yAt(s)
+}
+
+// ConcentratedLiquidityAmounts returns the x and y coin amounts of
+// the liquidity within the price range [lowerPrice, upperPrice] at
+// given pool price.
+func ConcentratedLiquidityAmounts(liquidity, lowerPrice, upperPrice, price sdk.Dec) (x, y sdk.Dec) {
+	sqrtLower := utils.DecApproxSqrt(lowerPrice)
+	sqrtUpper := utils.DecApproxSqrt(upperPrice)
+	sqrtPrice := sdk.MinDec(sdk.MaxDec(utils.DecApproxSqrt(price), sqrtLower), sqrtUpper)
+	x = liquidity.Mul(sqrtPrice.Sub(sqrtLower))
+	y = liquidity.Quo(sqrtPrice).Sub(liquidity.Quo(sqrtUpper))
+	return
+}
+
+// ConcentratedLiquidityForAmounts returns the maximum liquidity within
+// the price range [lowerPrice, upperPrice] that can be provided with
+// x and y coins at given pool price.
+func ConcentratedLiquidityForAmounts(x, y sdk.Int, lowerPrice, upperPrice, price sdk.Dec) sdk.Dec {
+	sqrtLower := utils.DecApproxSqrt(lowerPrice)
+	sqrtUpper := utils.DecApproxSqrt(upperPrice)
+	sqrtPrice := utils.DecApproxSqrt(price)
+	switch {
+	case sqrtPrice.LTE(sqrtLower): // only y coin is needed
+		return y.ToDec().QuoTruncate(inv(sqrtLower).Sub(inv(sqrtUpper)))
+	case sqrtPrice.GTE(sqrtUpper): // only x coin is needed
+		return x.ToDec().QuoTruncate(sqrtUpper.Sub(sqrtLower))
+	default:
+		return sdk.MinDec(
+			x.ToDec().QuoTruncate(sqrtPrice.Sub(sqrtLower)),
+			y.ToDec().QuoTruncate(inv(sqrtPrice).Sub(inv(sqrtUpper))))
+	}
+}
+
 // Deposit returns accepted x and y coin amount and minted pool coin amount
 // when someone deposits x and y coins.
+// The accepted amounts always follow the ratio of the pool's reserves,
+// so for a weighted pool the depositor provides coins in the ratio of the
+// pool's weights in value and the pool price stays the same.
 func Deposit(rx, ry, ps, x, y sdk.Int) (ax, ay, pc sdk.Int) {
 	// Calculate accepted amount and minting amount.
 	// Note that we take as many coins as possible(by ceiling numbers)
@@ -508,8 +1347,45 @@ func Deposit(rx, ry, ps, x, y sdk.Int) (ax, ay, pc sdk.Int) {
 	return
 }
 
+// SingleSidedDepositSwapAmount returns the amount of the deposit coin to be
+// swapped against the pool when someone deposits amt of only one coin.
+// isQuote tells whether the deposit coin is the pool's quote coin(x).
+// After the swap, the rest of the deposit coin and the swapped coin are
+// deposited together.
+// For basic pools, the amount is exact so that the two coins follow the
+// ratio of the pool's reserves after the swap:
+//
+//	s = sqrt(r * (r + amt)) - r
+//
+// where r is the pool's reserve of the deposit coin.
+// For other pools, the amount is the share of the other coin's reserve in
+// the pool's value, which doesn't take the price impact into account.
+func SingleSidedDepositSwapAmount(pool Pool, isQuote bool, amt sdk.Int) (s sdk.Int) {
+	rx, ry := pool.Balances()
+	if _, ok := pool.(*BasicPool); ok {
+		r := ry
+		if isQuote {
+			r = rx
+		}
+		rr := new(big.Int).Mul(r.BigInt(), r.Add(amt).BigInt())
+		return sdk.NewIntFromBigInt(new(big.Int).Sqrt(rr)).Sub(r)
+	}
+	vx := rx.ToDec()
+	vy := ry.ToDec().Mul(pool.Price())
+	total := vx.Add(vy)
+	if !total.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	if isQuote {
+		return amt.ToDec().Mul(vy).QuoTruncate(total).TruncateInt()
+	}
+	return amt.ToDec().Mul(vx).QuoTruncate(total).TruncateInt()
+}
+
 // Withdraw returns withdrawn x and y coin amount when someone withdraws
 // pc pool coin.
+// Like Deposit, the withdrawn amounts follow the ratio of the pool's
+// reserves, which keeps the weighted pool's price as well.
 // Withdraw also takes care of the fee rate.
 func Withdraw(rx, ry, ps, pc sdk.Int, feeRate sdk.Dec) (x, y sdk.Int) {
 	if pc.Equal(ps) {
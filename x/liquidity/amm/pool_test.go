@@ -967,6 +967,544 @@ func TestRangedPool_SwapPriceOutOfRange(t *testing.T) {
 	}
 }
 
+func TestCreateStablePool(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		rx, ry      sdk.Int
+		amp         uint32
+		expectedErr string
+	}{
+		{
+			"happy case",
+			sdk.NewInt(1000000), sdk.NewInt(1000000), 100,
+			"",
+		},
+		{
+			"zero x amount",
+			sdk.NewInt(0), sdk.NewInt(1000000), 100,
+			"cannot create stable pool with zero reserve amount",
+		},
+		{
+			"zero y amount",
+			sdk.NewInt(1000000), sdk.NewInt(0), 100,
+			"cannot create stable pool with zero reserve amount",
+		},
+		{
+			"too low amplification",
+			sdk.NewInt(1000000), sdk.NewInt(1000000), 0,
+			"amplification must not be lower than 1",
+		},
+		{
+			"too high amplification",
+			sdk.NewInt(1000000), sdk.NewInt(1000000), 10001,
+			"amplification must not be greater than 10000",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pool, err := amm.CreateStablePool(tc.rx, tc.ry, tc.amp)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				require.True(sdk.IntEq(t, amm.InitialPoolCoinSupply(tc.rx, tc.ry), pool.PoolCoinSupply()))
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestStablePool_Price(t *testing.T) {
+	for _, tc := range []struct {
+		rx, ry int64
+		amp    uint32
+		price  sdk.Dec
+	}{
+		{1000000, 1000000, 1, utils.ParseDec("1")},
+		{1000000, 1000000, 10000, utils.ParseDec("1")},
+		{1500000, 500000, 1, utils.ParseDec("1.553136245051927781")},
+		{1500000, 500000, 100, utils.ParseDec("1.008827713145890352")},
+		{1500000, 500000, 10000, utils.ParseDec("1.000088882716607625")},
+	} {
+		t.Run("", func(t *testing.T) {
+			pool := amm.NewStablePool(sdk.NewInt(tc.rx), sdk.NewInt(tc.ry), sdk.Int{}, tc.amp)
+			require.True(sdk.DecEq(t, tc.price, pool.Price()))
+			// The invariant is symmetric.
+			pool = amm.NewStablePool(sdk.NewInt(tc.ry), sdk.NewInt(tc.rx), sdk.Int{}, tc.amp)
+			require.True(t, utils.DecApproxEqual(sdk.OneDec().Quo(tc.price), pool.Price()))
+		})
+	}
+}
+
+func TestStablePool_BuyAmountOver(t *testing.T) {
+	pool := amm.NewStablePool(sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.Int{}, 100)
+
+	for _, tc := range []struct {
+		price sdk.Dec
+		amt   sdk.Int
+	}{
+		{utils.ParseDec("1.1"), sdk.ZeroInt()},
+		{utils.ParseDec("1.0"), sdk.ZeroInt()},
+		{utils.ParseDec("0.99"), sdk.NewInt(533393)},
+		{utils.ParseDec("0.9"), sdk.NewInt(946309)},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.True(sdk.IntEq(t, tc.amt, pool.BuyAmountOver(tc.price, true)))
+		})
+	}
+}
+
+func TestStablePool_SellAmountUnder(t *testing.T) {
+	pool := amm.NewStablePool(sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.Int{}, 100)
+
+	for _, tc := range []struct {
+		price sdk.Dec
+		amt   sdk.Int
+	}{
+		{utils.ParseDec("0.9"), sdk.ZeroInt()},
+		{utils.ParseDec("1.0"), sdk.ZeroInt()},
+		{utils.ParseDec("1.01"), sdk.NewInt(525969)},
+		{utils.ParseDec("1.1"), sdk.NewInt(843651)},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.True(sdk.IntEq(t, tc.amt, pool.SellAmountUnder(tc.price, true)))
+		})
+	}
+}
+
+func TestStablePool_BuyAmountTo(t *testing.T) {
+	pool := amm.NewStablePool(sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.Int{}, 100)
+
+	for _, tc := range []struct {
+		price sdk.Dec
+		amt   sdk.Int
+	}{
+		{utils.ParseDec("1.1"), sdk.ZeroInt()},
+		{utils.ParseDec("1.0"), sdk.ZeroInt()},
+		{utils.ParseDec("0.99"), sdk.NewInt(532583)},
+		{utils.ParseDec("0.9"), sdk.NewInt(939687)},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.True(sdk.IntEq(t, tc.amt, pool.BuyAmountTo(tc.price)))
+		})
+	}
+}
+
+func TestStablePool_SellAmountTo(t *testing.T) {
+	pool := amm.NewStablePool(sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.Int{}, 100)
+
+	for _, tc := range []struct {
+		price sdk.Dec
+		amt   sdk.Int
+	}{
+		{utils.ParseDec("0.9"), sdk.ZeroInt()},
+		{utils.ParseDec("1.0"), sdk.ZeroInt()},
+		{utils.ParseDec("1.01"), sdk.NewInt(525176)},
+		{utils.ParseDec("1.1"), sdk.NewInt(838090)},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.True(sdk.IntEq(t, tc.amt, pool.SellAmountTo(tc.price)))
+		})
+	}
+}
+
+func TestStablePool_SwapPrice(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 100; i++ {
+		rx := utils.RandomInt(r, sdk.NewInt(1_000000), sdk.NewInt(1000_000000))
+		ry := utils.RandomInt(r, sdk.NewInt(1_000000), sdk.NewInt(1000_000000))
+		amp := uint32(1 + r.Intn(int(amm.MaxStablePoolAmplification)))
+		pool, err := amm.CreateStablePool(rx, ry, amp)
+		require.NoError(t, err)
+		d := amm.StableSwapInvariant(rx, ry, amp)
+
+		// After the pool's buy order is executed, the pool price should
+		// be the order price and the invariant should not decrease.
+		p := pool.Price().Mul(utils.RandomDec(r, utils.ParseDec("0.9"), utils.ParseDec("0.999")))
+		amt := pool.BuyAmountTo(p)
+		nextRx := rx.Sub(p.MulInt(amt).Ceil().TruncateInt())
+		nextRy := ry.Add(amt)
+		nextPool := amm.NewStablePool(nextRx, nextRy, sdk.Int{}, amp)
+		require.True(t, utils.DecApproxEqual(p, nextPool.Price()))
+		require.True(t, amm.StableSwapInvariant(nextRx, nextRy, amp).GTE(d))
+
+		p = pool.Price().Mul(utils.RandomDec(r, utils.ParseDec("1.001"), utils.ParseDec("1.1")))
+		amt = pool.SellAmountTo(p)
+		nextRx = rx.Add(p.MulInt(amt).TruncateInt())
+		nextRy = ry.Sub(amt)
+		nextPool = amm.NewStablePool(nextRx, nextRy, sdk.Int{}, amp)
+		require.True(t, utils.DecApproxEqual(p, nextPool.Price()))
+		require.True(t, amm.StableSwapInvariant(nextRx, nextRy, amp).GTE(d))
+	}
+}
+
+func TestStablePoolOrders(t *testing.T) {
+	pool := amm.NewStablePool(sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.Int{}, 100)
+	lowestPrice, highestPrice := utils.ParseDec("0.9"), utils.ParseDec("1.1")
+	require.Len(t, amm.PoolOrders(pool, amm.DefaultOrderer, lowestPrice, highestPrice, 4), 370)
+}
+
+func TestCreateWeightedPool(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		rx, ry      sdk.Int
+		wx, wy      uint32
+		expectedErr string
+	}{
+		{
+			"happy case",
+			sdk.NewInt(4000000), sdk.NewInt(1000000), 80, 20,
+			"",
+		},
+		{
+			"zero x amount",
+			sdk.NewInt(0), sdk.NewInt(1000000), 80, 20,
+			"cannot create weighted pool with zero reserve amount",
+		},
+		{
+			"zero y amount",
+			sdk.NewInt(4000000), sdk.NewInt(0), 80, 20,
+			"cannot create weighted pool with zero reserve amount",
+		},
+		{
+			"zero weight",
+			sdk.NewInt(4000000), sdk.NewInt(1000000), 100, 0,
+			"weight must not be lower than 1",
+		},
+		{
+			"wrong sum of weights",
+			sdk.NewInt(4000000), sdk.NewInt(1000000), 80, 30,
+			"sum of weights must be 100",
+		},
+		{
+			"too low price",
+			sdk.NewInt(1), sdk.NewInt(1_000000000_000000000), 1, 99,
+			"pool price is lower than min price 0.000000000000001000",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pool, err := amm.CreateWeightedPool(tc.rx, tc.ry, tc.wx, tc.wy)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				require.True(sdk.IntEq(t, amm.InitialPoolCoinSupply(tc.rx, tc.ry), pool.PoolCoinSupply()))
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestWeightedPool_Price(t *testing.T) {
+	for _, tc := range []struct {
+		rx, ry int64
+		wx, wy uint32
+		p      sdk.Dec
+	}{
+		{4000000, 1000000, 80, 20, utils.ParseDec("1")},
+		{1000000, 1000000, 80, 20, utils.ParseDec("0.25")},
+		{1000000, 1000000, 20, 80, utils.ParseDec("4")},
+		{1000000, 1000000, 50, 50, utils.ParseDec("1")},
+	} {
+		t.Run("", func(t *testing.T) {
+			pool := amm.NewWeightedPool(sdk.NewInt(tc.rx), sdk.NewInt(tc.ry), sdk.Int{}, tc.wx, tc.wy)
+			require.True(sdk.DecEq(t, tc.p, pool.Price()))
+		})
+	}
+}
+
+func TestWeightedPool_EqualWeights(t *testing.T) {
+	// A weighted pool with equal weights behaves exactly like a basic pool.
+	basicPool := amm.NewBasicPool(sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.Int{})
+	weightedPool := amm.NewWeightedPool(sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.Int{}, 50, 50)
+
+	for _, price := range []sdk.Dec{
+		utils.ParseDec("0.5"), utils.ParseDec("0.9"), utils.ParseDec("0.99"),
+		utils.ParseDec("1.01"), utils.ParseDec("1.1"), utils.ParseDec("2"),
+	} {
+		t.Run("", func(t *testing.T) {
+			require.True(sdk.IntEq(t, basicPool.BuyAmountOver(price, true), weightedPool.BuyAmountOver(price, true)))
+			require.True(sdk.IntEq(t, basicPool.SellAmountUnder(price, true), weightedPool.SellAmountUnder(price, true)))
+			require.True(sdk.IntEq(t, basicPool.BuyAmountTo(price), weightedPool.BuyAmountTo(price)))
+			require.True(sdk.IntEq(t, basicPool.SellAmountTo(price), weightedPool.SellAmountTo(price)))
+		})
+	}
+}
+
+func TestWeightedPool_BuyAmountOver(t *testing.T) {
+	pool := amm.NewWeightedPool(sdk.NewInt(4000000), sdk.NewInt(1000000), sdk.Int{}, 80, 20)
+
+	for _, tc := range []struct {
+		price sdk.Dec
+		amt   sdk.Int
+	}{
+		{utils.ParseDec("1.1"), sdk.ZeroInt()},
+		{utils.ParseDec("1.0"), sdk.ZeroInt()},
+		{utils.ParseDec("0.99"), sdk.NewInt(40404)},
+		{utils.ParseDec("0.9"), sdk.NewInt(444444)},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.True(sdk.IntEq(t, tc.amt, pool.BuyAmountOver(tc.price, true)))
+		})
+	}
+}
+
+func TestWeightedPool_SellAmountUnder(t *testing.T) {
+	pool := amm.NewWeightedPool(sdk.NewInt(4000000), sdk.NewInt(1000000), sdk.Int{}, 80, 20)
+
+	for _, tc := range []struct {
+		price sdk.Dec
+		amt   sdk.Int
+	}{
+		{utils.ParseDec("0.9"), sdk.ZeroInt()},
+		{utils.ParseDec("1.0"), sdk.ZeroInt()},
+		{utils.ParseDec("1.01"), sdk.NewInt(9900)},
+		{utils.ParseDec("1.1"), sdk.NewInt(90909)},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.True(sdk.IntEq(t, tc.amt, pool.SellAmountUnder(tc.price, true)))
+		})
+	}
+}
+
+func TestWeightedPool_BuyAmountTo(t *testing.T) {
+	pool := amm.NewWeightedPool(sdk.NewInt(4000000), sdk.NewInt(1000000), sdk.Int{}, 80, 20)
+
+	for _, tc := range []struct {
+		price sdk.Dec
+		amt   sdk.Int
+	}{
+		{utils.ParseDec("1.1"), sdk.ZeroInt()},
+		{utils.ParseDec("1.0"), sdk.ZeroInt()},
+		{utils.ParseDec("0.99"), sdk.NewInt(8113)},
+		{utils.ParseDec("0.9"), sdk.NewInt(92673)},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.True(sdk.IntEq(t, tc.amt, pool.BuyAmountTo(tc.price)))
+		})
+	}
+}
+
+func TestWeightedPool_SellAmountTo(t *testing.T) {
+	pool := amm.NewWeightedPool(sdk.NewInt(4000000), sdk.NewInt(1000000), sdk.Int{}, 80, 20)
+
+	for _, tc := range []struct {
+		price sdk.Dec
+		amt   sdk.Int
+	}{
+		{utils.ParseDec("0.9"), sdk.ZeroInt()},
+		{utils.ParseDec("1.0"), sdk.ZeroInt()},
+		{utils.ParseDec("1.01"), sdk.NewInt(7928)},
+		{utils.ParseDec("1.1"), sdk.NewInt(73413)},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.True(sdk.IntEq(t, tc.amt, pool.SellAmountTo(tc.price)))
+		})
+	}
+}
+
+func TestWeightedPool_DepositWithdraw(t *testing.T) {
+	pool := amm.NewWeightedPool(sdk.NewInt(4000000), sdk.NewInt(1000000), sdk.NewInt(10000000), 80, 20)
+
+	// Deposit coins in the ratio of the pool's weights, not in 1:1 value.
+	rx, ry := pool.Balances()
+	ax, ay, pc := amm.Deposit(rx, ry, pool.PoolCoinSupply(), sdk.NewInt(400000), sdk.NewInt(100000))
+	require.True(sdk.IntEq(t, sdk.NewInt(400000), ax))
+	require.True(sdk.IntEq(t, sdk.NewInt(100000), ay))
+	require.True(sdk.IntEq(t, sdk.NewInt(1000000), pc))
+
+	pool = amm.NewWeightedPool(rx.Add(ax), ry.Add(ay), pool.PoolCoinSupply().Add(pc), 80, 20)
+	require.True(sdk.DecEq(t, utils.ParseDec("1"), pool.Price()))
+
+	rx, ry = pool.Balances()
+	x, y := amm.Withdraw(rx, ry, pool.PoolCoinSupply(), pc, sdk.ZeroDec())
+	require.True(sdk.IntEq(t, sdk.NewInt(399999), x))
+	require.True(sdk.IntEq(t, sdk.NewInt(99999), y))
+
+	pool = amm.NewWeightedPool(rx.Sub(x), ry.Sub(y), pool.PoolCoinSupply().Sub(pc), 80, 20)
+	require.True(t, utils.DecApproxEqual(utils.ParseDec("1"), pool.Price()))
+}
+
+func TestWeightedPoolOrders(t *testing.T) {
+	pool := amm.NewWeightedPool(sdk.NewInt(4000000), sdk.NewInt(1000000), sdk.Int{}, 80, 20)
+	lowestPrice, highestPrice := utils.ParseDec("0.9"), utils.ParseDec("1.1")
+	require.Len(t, amm.PoolOrders(pool, amm.DefaultOrderer, lowestPrice, highestPrice, 4), 203)
+}
+
+func newTestConcentratedPool() *amm.ConcentratedPool {
+	return amm.NewConcentratedPool(utils.ParseDec("1.0"), []amm.LiquidityTick{
+		{Price: utils.ParseDec("0.5"), LiquidityNet: utils.ParseDec("10000000")},
+		{Price: utils.ParseDec("2.0"), LiquidityNet: utils.ParseDec("-10000000")},
+	})
+}
+
+func TestConcentratedPool(t *testing.T) {
+	pool := newTestConcentratedPool()
+	rx, ry := pool.Balances()
+	require.True(sdk.IntEq(t, sdk.NewInt(2928932), rx))
+	require.True(sdk.IntEq(t, sdk.NewInt(2928932), ry))
+	require.True(sdk.DecEq(t, utils.ParseDec("1.0"), pool.Price()))
+	require.True(sdk.DecEq(t, utils.ParseDec("10000000"), pool.Liquidity()))
+	require.True(sdk.IntEq(t, sdk.ZeroInt(), pool.PoolCoinSupply()))
+	require.False(t, pool.IsDepleted())
+
+	// A pool with no liquidity is depleted.
+	require.True(t, amm.NewConcentratedPool(utils.ParseDec("1.0"), nil).IsDepleted())
+}
+
+func TestValidateConcentratedLiquidityRange(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		lowerPrice  sdk.Dec
+		upperPrice  sdk.Dec
+		expectedErr string
+	}{
+		{"happy case", utils.ParseDec("0.5"), utils.ParseDec("2.0"), ""},
+		{"too low lower price", utils.ParseDec("0.0000000000000001"), utils.ParseDec("2.0"), "lower price must not be lower than the min price 0.000000000000001000"},
+		{"too high upper price", utils.ParseDec("0.5"), utils.ParseDec("1000000000000000000000"), "upper price must not be greater than the max price 100000000000000000000.000000000000000000"},
+		{"wrong range", utils.ParseDec("2.0"), utils.ParseDec("0.5"), "lower price must be lower than upper price"},
+		{"empty range", utils.ParseDec("1.0"), utils.ParseDec("1.0"), "lower price must be lower than upper price"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := amm.ValidateConcentratedLiquidityRange(tc.lowerPrice, tc.upperPrice)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestConcentratedPool_BuyAmountTo(t *testing.T) {
+	pool := newTestConcentratedPool()
+
+	for _, tc := range []struct {
+		price sdk.Dec
+		amt   sdk.Int
+	}{
+		{utils.ParseDec("1.1"), sdk.ZeroInt()},
+		{utils.ParseDec("1.0"), sdk.ZeroInt()},
+		{utils.ParseDec("0.99"), sdk.NewInt(50378)},
+		{utils.ParseDec("0.9"), sdk.NewInt(540925)},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.True(sdk.IntEq(t, tc.amt, pool.BuyAmountTo(tc.price)))
+		})
+	}
+}
+
+func TestConcentratedPool_SellAmountTo(t *testing.T) {
+	pool := newTestConcentratedPool()
+
+	for _, tc := range []struct {
+		price sdk.Dec
+		amt   sdk.Int
+	}{
+		{utils.ParseDec("0.9"), sdk.ZeroInt()},
+		{utils.ParseDec("1.0"), sdk.ZeroInt()},
+		{utils.ParseDec("1.01"), sdk.NewInt(49628)},
+		{utils.ParseDec("1.1"), sdk.NewInt(465374)},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.True(sdk.IntEq(t, tc.amt, pool.SellAmountTo(tc.price)))
+		})
+	}
+}
+
+func TestConcentratedPool_SameAsRangedPool(t *testing.T) {
+	// A concentrated pool with a single range behaves like a ranged pool
+	// with the same range.
+	pool := newTestConcentratedPool()
+	rx, ry := pool.Balances()
+	rangedPool := amm.NewRangedPool(rx, ry, sdk.Int{}, utils.ParseDec("0.5"), utils.ParseDec("2.0"))
+
+	for _, price := range []sdk.Dec{utils.ParseDec("1.01"), utils.ParseDec("1.1"), utils.ParseDec("1.5")} {
+		require.True(sdk.IntEq(t, rangedPool.SellAmountTo(price), pool.SellAmountTo(price)))
+	}
+}
+
+func TestConcentratedPool_SetBalances(t *testing.T) {
+	pool := newTestConcentratedPool()
+	rx, ry := pool.Balances()
+
+	// Buying y coins from the pool raises the price.
+	amt := pool.SellAmountTo(utils.ParseDec("1.1"))
+	pool.SetBalances(rx, ry.Sub(amt), false)
+	require.True(t, utils.DecApproxEqual(utils.ParseDec("1.1"), pool.Price()))
+
+	// Selling the same amount of y coins to the pool brings the price back.
+	rx, ry = pool.Balances()
+	pool.SetBalances(rx, ry.Add(amt), false)
+	require.True(t, utils.DecApproxEqual(utils.ParseDec("1.0"), pool.Price()))
+}
+
+func TestConcentratedPool_LiquidityGap(t *testing.T) {
+	// There's no liquidity between 0.8 and 1.2.
+	pool := amm.NewConcentratedPool(utils.ParseDec("1.0"), []amm.LiquidityTick{
+		{Price: utils.ParseDec("0.5"), LiquidityNet: utils.ParseDec("10000000")},
+		{Price: utils.ParseDec("0.8"), LiquidityNet: utils.ParseDec("-10000000")},
+		{Price: utils.ParseDec("1.2"), LiquidityNet: utils.ParseDec("10000000")},
+		{Price: utils.ParseDec("2.0"), LiquidityNet: utils.ParseDec("-10000000")},
+	})
+	require.True(sdk.DecEq(t, sdk.ZeroDec(), pool.Liquidity()))
+	require.True(sdk.IntEq(t, sdk.ZeroInt(), pool.BuyAmountOver(utils.ParseDec("0.9"), true)))
+	require.True(sdk.IntEq(t, sdk.NewInt(771946), pool.BuyAmountOver(utils.ParseDec("0.7"), true)))
+
+	// Selling a tiny amount of y coin to the pool makes the price cross the gap.
+	rx, ry := pool.Balances()
+	pool.SetBalances(rx, ry.AddRaw(1), false)
+	require.True(t, utils.DecApproxEqual(utils.ParseDec("0.8"), pool.Price()))
+}
+
+func TestConcentratedLiquidityAmounts(t *testing.T) {
+	lowerPrice, upperPrice := utils.ParseDec("0.5"), utils.ParseDec("2.0")
+	for _, tc := range []struct {
+		price sdk.Dec
+		x, y  sdk.Int
+	}{
+		{utils.ParseDec("1.0"), sdk.NewInt(1000000), sdk.NewInt(1000000)},
+		{utils.ParseDec("0.4"), sdk.ZeroInt(), sdk.NewInt(1000000)},
+		{utils.ParseDec("2.5"), sdk.NewInt(1000000), sdk.ZeroInt()},
+	} {
+		t.Run("", func(t *testing.T) {
+			liquidity := amm.ConcentratedLiquidityForAmounts(tc.x, tc.y, lowerPrice, upperPrice, tc.price)
+			require.True(t, liquidity.IsPositive())
+			x, y := amm.ConcentratedLiquidityAmounts(liquidity, lowerPrice, upperPrice, tc.price)
+			require.True(t, x.LTE(tc.x.ToDec()))
+			require.True(t, y.LTE(tc.y.ToDec()))
+			// At least one of the coins is fully used.
+			require.True(t, x.Ceil().TruncateInt().Equal(tc.x) || y.Ceil().TruncateInt().Equal(tc.y))
+		})
+	}
+}
+
+func TestConcentratedPoolOrders(t *testing.T) {
+	pool := newTestConcentratedPool()
+	lowestPrice, highestPrice := utils.ParseDec("0.9"), utils.ParseDec("1.1")
+	require.Len(t, amm.PoolOrders(pool, amm.DefaultOrderer, lowestPrice, highestPrice, 4), 370)
+}
+
+func TestSingleSidedDepositSwapAmount(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		pool    amm.Pool
+		isQuote bool
+		amt     sdk.Int
+		s       sdk.Int
+	}{
+		{"basic pool, quote coin", amm.NewBasicPool(sdk.NewInt(1000000000), sdk.NewInt(1000000000), sdk.Int{}), true, sdk.NewInt(10000000), sdk.NewInt(4987562)},
+		{"basic pool, base coin", amm.NewBasicPool(sdk.NewInt(1000000000), sdk.NewInt(1000000000), sdk.Int{}), false, sdk.NewInt(10000000), sdk.NewInt(4987562)},
+		{"basic pool, large deposit", amm.NewBasicPool(sdk.NewInt(2000000000), sdk.NewInt(1000000000), sdk.Int{}), true, sdk.NewInt(2000000000), sdk.NewInt(828427124)},
+		// The share of the other coin's reserve in the pool's value.
+		{"weighted pool, quote coin", amm.NewWeightedPool(sdk.NewInt(4000000), sdk.NewInt(1000000), sdk.Int{}, 80, 20), true, sdk.NewInt(1000000), sdk.NewInt(200000)},
+		{"weighted pool, base coin", amm.NewWeightedPool(sdk.NewInt(4000000), sdk.NewInt(1000000), sdk.Int{}, 80, 20), false, sdk.NewInt(1000000), sdk.NewInt(800000)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := amm.SingleSidedDepositSwapAmount(tc.pool, tc.isQuote, tc.amt)
+			require.True(sdk.IntEq(t, tc.s, s))
+		})
+	}
+}
+
 func TestInitialPoolCoinSupply(t *testing.T) {
 	for _, tc := range []struct {
 		x, y sdk.Int
@@ -2,6 +2,7 @@ package amm
 
 import (
 	"fmt"
+	"math/big"
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -161,3 +162,34 @@ func poolOrderPriceGapRatio(poolPrice, currentPrice sdk.Dec) (r sdk.Dec) {
 		return b4
 	}
 }
+
+// gcd returns the greatest common divisor of a and b.
+func gcd(a, b uint32) uint32 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// decPow returns x^(m/n), where x must be greater than or equal to 1.
+// x is split into 2^e * f where 1 <= f < 2, so that the n-th root of f
+// converges fast.
+// x^(m/n) = 2^q * (2^(1/n))^r * (f^(1/n))^m, where e*m = q*n + r
+func decPow(x sdk.Dec, m, n uint64) sdk.Dec {
+	e := x.TruncateInt().BigInt().BitLen() - 1
+	f := x.Quo(sdk.NewDecFromBigInt(new(big.Int).Lsh(big.NewInt(1), uint(e))))
+	q, r := uint64(e)*m/n, uint64(e)*m%n
+	rootF, err := f.ApproxRoot(n)
+	if err != nil {
+		panic(err)
+	}
+	res := rootF.Power(m)
+	if r > 0 {
+		rootTwo, err := sdk.NewDec(2).ApproxRoot(n)
+		if err != nil {
+			panic(err)
+		}
+		res = res.Mul(rootTwo.Power(r))
+	}
+	return res.MulInt(sdk.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), uint(q))))
+}
@@ -7,13 +7,20 @@ import (
 )
 
 const (
-	FlagPairId         = "pair-id"
-	FlagDisabled       = "disabled"
-	FlagPoolCoinDenom  = "pool-coin-denom"
-	FlagReserveAddress = "reserve-address"
-	FlagDenoms         = "denoms"
-	FlagOrderLifespan  = "order-lifespan"
-	FlagNumTicks       = "num-ticks"
+	FlagPairId           = "pair-id"
+	FlagDisabled         = "disabled"
+	FlagPoolCoinDenom    = "pool-coin-denom"
+	FlagReserveAddress   = "reserve-address"
+	FlagDenoms           = "denoms"
+	FlagOrderLifespan    = "order-lifespan"
+	FlagNumTicks         = "num-ticks"
+	FlagPrice            = "price"
+	FlagTimeInForce      = "time-in-force"
+	FlagPostOnly         = "post-only"
+	FlagMaxRouteLength   = "max-route-length"
+	FlagMM               = "mm"
+	FlagAllocationPolicy = "allocation-policy"
+	FlagPoolId           = "pool-id"
 )
 
 func flagSetPools() *flag.FlagSet {
@@ -50,6 +57,14 @@ func flagSetOrders() *flag.FlagSet {
 	return fs
 }
 
+func flagSetPositions() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagPoolId, "", "The pool id")
+
+	return fs
+}
+
 func flagSetOrder() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
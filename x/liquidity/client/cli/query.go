@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -28,15 +29,24 @@ func GetQueryCmd() *cobra.Command {
 		NewQueryParamsCmd(),
 		NewQueryPoolsCmd(),
 		NewQueryPoolCmd(),
+		NewQueryPoolStatsCmd(),
 		NewQueryPairsCmd(),
 		NewQueryPairCmd(),
+		NewQueryTWAPCmd(),
+		NewQueryCandlesCmd(),
 		NewQueryDepositRequestsCmd(),
 		NewQueryDepositRequestCmd(),
+		NewQuerySingleSidedDepositRequestsCmd(),
+		NewQuerySingleSidedDepositRequestCmd(),
 		NewQueryWithdrawRequestsCmd(),
 		NewQueryWithdrawRequestCmd(),
 		NewQueryOrdersCmd(),
 		NewQueryOrderCmd(),
+		NewQueryPositionsCmd(),
+		NewQueryPositionCmd(),
 		NewQueryOrderBooksCmd(),
+		NewQueryBestSwapRoutesCmd(),
+		NewQuerySimulateOrderCmd(),
 	)
 
 	return cmd
@@ -175,6 +185,126 @@ $ %s query %s pair 1
 	return cmd
 }
 
+// NewQueryTWAPCmd implements the twap query command.
+func NewQueryTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [pair-id] [window]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the time-weighted average price of the pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the time-weighted average price of the pair over the window ending now.
+The window is a duration string such as "30m" or "24h".
+The average is calculated from the snapshots of the pair's cumulative price,
+which are taken every minute.
+
+Example:
+$ %s query %s twap 1 1h
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("parse window: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TWAP(
+				cmd.Context(),
+				&types.QueryTWAPRequest{
+					PairId: pairId,
+					Window: window,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryCandlesCmd implements the candles query command.
+func NewQueryCandlesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "candles [pair-id] [interval]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query for the candles of the pair with the interval",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the OHLCV candles of the pair with the interval.
+The interval is a duration string such as "1m" or "1h", and candles are kept
+only for the intervals in the candle-intervals param.
+Candles are returned in ascending order of the open time, use --reverse to
+get the latest candles first.
+
+Example:
+$ %s query %s candles 1 1h
+$ %s query %s candles 1 1m --reverse --limit=60
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			interval, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("parse interval: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Candles(
+				cmd.Context(),
+				&types.QueryCandlesRequest{
+					PairId:     pairId,
+					Interval:   interval,
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "candles")
+
+	return cmd
+}
+
 // NewQueryPoolsCmd implements the pools query command.
 func NewQueryPoolsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -317,6 +447,56 @@ $ %s query %s pool --reserve-address=cre1...
 	return cmd
 }
 
+// NewQueryPoolStatsCmd implements the pool stats query command.
+func NewQueryPoolStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-stats [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the trading volume, fees and the estimated APR of the liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the trading volume, fees and the estimated APR of the liquidity pool.
+The amounts are denominated in the quote coin of the pair.
+The volume and fees for the last 24 hours and 7 days are calculated from
+the snapshots taken hourly, and the APR is estimated from the fees for the
+last 7 days.
+
+Example:
+$ %s query %s pool-stats 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pool id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PoolStats(
+				cmd.Context(),
+				&types.QueryPoolStatsRequest{
+					PoolId: poolId,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewQueryDepositRequestsCmd implements the deposit requests query command.
 func NewQueryDepositRequestsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -422,6 +602,113 @@ $ %s query %s deposit-requests 1 1
 	return cmd
 }
 
+// NewQuerySingleSidedDepositRequestsCmd implements the single-sided deposit
+// requests query command.
+func NewQuerySingleSidedDepositRequestsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "single-sided-deposit-requests [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for all single-sided deposit requests in the pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all single-sided deposit requests in the pool.
+
+Example:
+$ %s query %s single-sided-deposit-requests 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SingleSidedDepositRequests(
+				cmd.Context(),
+				&types.QuerySingleSidedDepositRequestsRequest{
+					PoolId:     poolId,
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "single-sided-deposit-requests")
+
+	return cmd
+}
+
+// NewQuerySingleSidedDepositRequestCmd implements the single-sided deposit
+// request query command.
+func NewQuerySingleSidedDepositRequestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "single-sided-deposit-request [pool-id] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query details of the specific single-sided deposit request",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details of the specific single-sided deposit request.
+
+Example:
+$ %s query %s single-sided-deposit-request 1 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SingleSidedDepositRequest(
+				cmd.Context(),
+				&types.QuerySingleSidedDepositRequestRequest{
+					PoolId: poolId,
+					Id:     id,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewQueryWithdrawRequestsCmd implements the withdraw requests query command.
 func NewQueryWithdrawRequestsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -658,6 +945,137 @@ $ %s query %s order 1 1
 	return cmd
 }
 
+// NewQueryPositionsCmd implements the positions query command.
+func NewQueryPositionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions [owner]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query for all positions in the concentrated pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all positions in the concentrated pool.
+
+Example:
+$ %s query %s positions cre1...
+$ %s query %s positions --pool-id=1 cre1...
+$ %s query %s positions --pool-id=1
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var owner *string
+			if len(args) > 0 {
+				owner = &args[0]
+			}
+
+			var poolId uint64
+			poolIdStr, _ := cmd.Flags().GetString(FlagPoolId)
+			if poolIdStr != "" {
+				poolId, err = strconv.ParseUint(poolIdStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("parse pool id: %w", err)
+				}
+			}
+			if owner == nil && poolId == 0 {
+				return fmt.Errorf("either owner or pool-id must be specified")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var res *types.QueryPositionsResponse
+			if owner == nil {
+				res, err = queryClient.Positions(cmd.Context(), &types.QueryPositionsRequest{
+					PoolId:     poolId,
+					Pagination: pageReq,
+				})
+			} else {
+				res, err = queryClient.PositionsByOwner(
+					cmd.Context(),
+					&types.QueryPositionsByOwnerRequest{
+						Owner:      *owner,
+						PoolId:     poolId,
+						Pagination: pageReq,
+					})
+			}
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetPositions())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "positions")
+
+	return cmd
+}
+
+// NewQueryPositionCmd implements the position query command.
+func NewQueryPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position [pool-id] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query details of the specific position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details of the specific position.
+
+Example:
+$ %s query %s position 1 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Position(
+				cmd.Context(),
+				&types.QueryPositionRequest{
+					PoolId: poolId,
+					Id:     id,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewQueryOrderBooksCmd implements the order books query command.
 func NewQueryOrderBooksCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -714,3 +1132,121 @@ $ %s query %s order-books 2,3
 
 	return cmd
 }
+
+// NewQueryBestSwapRoutesCmd implements the best swap routes query command.
+func NewQueryBestSwapRoutesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "best-swap-routes [offer-coin] [demand-coin-denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the best swap routes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query swap routes from the offer coin to the demand coin denom, ranked by the expected output.
+
+Example:
+$ %s query %s best-swap-routes 1000000uatom stake
+$ %s query %s best-swap-routes 1000000uatom stake --max-route-length=2
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxRouteLen, _ := cmd.Flags().GetUint32(FlagMaxRouteLength)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BestSwapRoutes(
+				cmd.Context(),
+				&types.QueryBestSwapRoutesRequest{
+					OfferCoin:       args[0],
+					DemandCoinDenom: args[1],
+					MaxRouteLength:  maxRouteLen,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagMaxRouteLength, 0, "maximum number of pairs in a route; 3 if not specified")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQuerySimulateOrderCmd implements the simulate order query command.
+func NewQuerySimulateOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-order [pair-id] [direction] [amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Simulate an order in the next batch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate an order in the next batch with the current orders and pools.
+The order is a market order if the price is not specified.
+
+Example:
+$ %s query %s simulate-order 1 buy 1000000
+$ %s query %s simulate-order 1 sell 1000000 --price=1.5
+$ %s query %s simulate-order 1 sell 1000000 --price=1.5 --time-in-force=fok
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			dir, err := parseOrderDirection(args[1])
+			if err != nil {
+				return fmt.Errorf("parse order direction: %w", err)
+			}
+
+			price, _ := cmd.Flags().GetString(FlagPrice)
+
+			tifStr, _ := cmd.Flags().GetString(FlagTimeInForce)
+			timeInForce, err := parseTimeInForce(tifStr)
+			if err != nil {
+				return fmt.Errorf("parse time in force: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateOrder(
+				cmd.Context(),
+				&types.QuerySimulateOrderRequest{
+					PairId:      pairId,
+					Direction:   dir,
+					Price:       price,
+					Amount:      args[2],
+					TimeInForce: timeInForce,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagPrice, "", "The order price; the order is simulated as a market order if not specified")
+	cmd.Flags().String(FlagTimeInForce, "", "Time in force of the order (one of: ioc,fok)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
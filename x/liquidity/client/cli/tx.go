@@ -12,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/crescent-network/crescent/v5/x/liquidity/types"
 )
@@ -30,12 +32,24 @@ func GetTxCmd() *cobra.Command {
 		NewCreatePairCmd(),
 		NewCreatePoolCmd(),
 		NewCreateRangedPoolCmd(),
+		NewCreateStablePoolCmd(),
+		NewCreateWeightedPoolCmd(),
+		NewCreateConcentratedPoolCmd(),
+		NewOpenPositionCmd(),
+		NewClosePositionCmd(),
+		NewCollectFeesCmd(),
 		NewDepositCmd(),
+		NewDepositSingleSidedCmd(),
 		NewWithdrawCmd(),
+		NewWithdrawSingleSidedCmd(),
 		NewLimitOrderCmd(),
 		NewMarketOrderCmd(),
 		NewMMOrderCmd(),
+		NewBatchOrdersCmd(),
+		NewTriggerOrderCmd(),
+		NewSwapExactInCmd(),
 		NewCancelOrderCmd(),
+		NewReplaceOrderCmd(),
 		NewCancelAllOrdersCmd(),
 	)
 
@@ -52,8 +66,10 @@ func NewCreatePairCmd() *cobra.Command {
 
 Example:
 $ %s tx %s create-pair uatom stake --from mykey
+$ %s tx %s create-pair uatom stake --allocation-policy=pro-rata --from mykey
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -65,12 +81,20 @@ $ %s tx %s create-pair uatom stake --from mykey
 			baseCoinDenom := args[0]
 			quoteCoinDenom := args[1]
 
+			policyStr, _ := cmd.Flags().GetString(FlagAllocationPolicy)
+			policy, err := parseAllocationPolicy(policyStr)
+			if err != nil {
+				return fmt.Errorf("parse allocation policy: %w", err)
+			}
+
 			msg := types.NewMsgCreatePair(clientCtx.GetFromAddress(), baseCoinDenom, quoteCoinDenom)
+			msg.AllocationPolicy = policy
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagAllocationPolicy, "", "Policy of allocating the matched amount to the orders at the same price (one of: priority,pro-rata); priority if not specified")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -179,16 +203,17 @@ $ %s tx %s create-ranged-pool 1 1000000000uatom,10000000000stake 1.3 2.5 1.5 --f
 	return cmd
 }
 
-func NewDepositCmd() *cobra.Command {
+func NewCreateStablePoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "deposit [pool-id] [deposit-coins]",
-		Args:  cobra.ExactArgs(2),
-		Short: "Deposit coins to a liquidity pool",
+		Use:   "create-stable-pool [pair-id] [deposit-coins] [amplification]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Create a stable liquidity pool",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Deposit coins to a liquidity pool.
+			fmt.Sprintf(`Create a stable liquidity pool with coins and an amplification coefficient.
+A stable pool is designed for two coins pegged to each other.
 
 Example:
-$ %s tx %s deposit 1 1000000000uatom,50000000000stake --from mykey
+$ %s tx %s create-stable-pool 1 1000000000uusdc,1000000000uusdt 100 --from mykey
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -199,9 +224,9 @@ $ %s tx %s deposit 1 1000000000uatom,50000000000stake --from mykey
 				return err
 			}
 
-			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
-				return fmt.Errorf("invalid pool id: %w", err)
+				return fmt.Errorf("parse pair id: %w", err)
 			}
 
 			depositCoins, err := sdk.ParseCoinsNormalized(args[1])
@@ -209,7 +234,12 @@ $ %s tx %s deposit 1 1000000000uatom,50000000000stake --from mykey
 				return fmt.Errorf("invalid deposit coins: %w", err)
 			}
 
-			msg := types.NewMsgDeposit(clientCtx.GetFromAddress(), poolId, depositCoins)
+			amp, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return fmt.Errorf("parse amplification: %w", err)
+			}
+
+			msg := types.NewMsgCreateStablePool(clientCtx.GetFromAddress(), pairId, depositCoins, uint32(amp))
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -220,16 +250,18 @@ $ %s tx %s deposit 1 1000000000uatom,50000000000stake --from mykey
 	return cmd
 }
 
-func NewWithdrawCmd() *cobra.Command {
+func NewCreateWeightedPoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "withdraw [pool-id] [pool-coin]",
-		Args:  cobra.ExactArgs(2),
-		Short: "Withdraw coins from the specified liquidity pool",
+		Use:   "create-weighted-pool [pair-id] [deposit-coins] [base-coin-weight] [quote-coin-weight]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Create a weighted liquidity pool",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Withdraw coins from the specified liquidity pool.
+			fmt.Sprintf(`Create a weighted liquidity pool with coins and weights of each coin.
+Weights are expressed in percentages and the sum of them must be 100.
+The initial pool price is determined by the deposit coins and weights.
 
 Example:
-$ %s tx %s withdraw 1 10000pool1 --from mykey
+$ %s tx %s create-weighted-pool 1 1000000000uatom,4000000000stake 20 80 --from mykey
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -240,21 +272,28 @@ $ %s tx %s withdraw 1 10000pool1 --from mykey
 				return err
 			}
 
-			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
-				return err
+				return fmt.Errorf("parse pair id: %w", err)
 			}
 
-			poolCoin, err := sdk.ParseCoinNormalized(args[1])
+			depositCoins, err := sdk.ParseCoinsNormalized(args[1])
 			if err != nil {
-				return err
+				return fmt.Errorf("invalid deposit coins: %w", err)
 			}
 
-			msg := types.NewMsgWithdraw(
-				clientCtx.GetFromAddress(),
-				poolId,
-				poolCoin,
-			)
+			baseCoinWeight, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return fmt.Errorf("parse base coin weight: %w", err)
+			}
+
+			quoteCoinWeight, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return fmt.Errorf("parse quote coin weight: %w", err)
+			}
+
+			msg := types.NewMsgCreateWeightedPool(
+				clientCtx.GetFromAddress(), pairId, depositCoins, uint32(baseCoinWeight), uint32(quoteCoinWeight))
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -265,31 +304,19 @@ $ %s tx %s withdraw 1 10000pool1 --from mykey
 	return cmd
 }
 
-func NewLimitOrderCmd() *cobra.Command {
+func NewCreateConcentratedPoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "limit-order [pair-id] [direction] [offer-coin] [demand-coin-denom] [price] [amount]",
-		Args:  cobra.ExactArgs(6),
-		Short: "Make a limit order",
+		Use:   "create-concentrated-pool [pair-id] [initial-price]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Create a concentrated liquidity pool",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Make a limit order.
+			fmt.Sprintf(`Create a concentrated liquidity pool with the initial pool price.
+The pool has no liquidity until positions are opened in it.
 
 Example:
-$ %s tx %s limit-order 1 buy 5000stake uatom 0.5 10000 --from mykey
-$ %s tx %s limit-order 1 b 5000stake uatom 0.5 10000 --from mykey
-$ %s tx %s limit-order 1 sell 10000uatom stake 2.0 10000 --order-lifespan=10m --from mykey
-$ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --from mykey
-
-[pair-id]: pair id to swap with
-[direction]: order direction (one of: buy,b,sell,s)
-[offer-coin]: the amount of offer coin to swap
-[demand-coin-denom]: the denom to exchange with the offer coin
-[price]: the limit order price for the swap; the exchange ratio is X/Y where X is the amount of quote coin and Y is the amount of base coin
-[amount]: the amount of base coin to buy or sell
+$ %s tx %s create-concentrated-pool 1 1.0 --from mykey
 `,
 				version.AppName, types.ModuleName,
-				version.AppName, types.ModuleName,
-				version.AppName, types.ModuleName,
-				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -303,78 +330,36 @@ $ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --fro
 				return fmt.Errorf("parse pair id: %w", err)
 			}
 
-			dir, err := parseOrderDirection(args[1])
-			if err != nil {
-				return fmt.Errorf("parse order direction: %w", err)
-			}
-
-			offerCoin, err := sdk.ParseCoinNormalized(args[2])
-			if err != nil {
-				return fmt.Errorf("invalid offer coin: %w", err)
-			}
-
-			demandCoinDenom := args[3]
-			if err := sdk.ValidateDenom(demandCoinDenom); err != nil {
-				return fmt.Errorf("invalid demand coin denom: %w", err)
-			}
-
-			price, err := sdk.NewDecFromStr(args[4])
+			initialPrice, err := sdk.NewDecFromStr(args[1])
 			if err != nil {
-				return fmt.Errorf("invalid price: %w", err)
-			}
-
-			amt, ok := sdk.NewIntFromString(args[5])
-			if !ok {
-				return fmt.Errorf("invalid amount: %s", args[5])
+				return fmt.Errorf("invalid initial price: %w", err)
 			}
 
-			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
-
-			msg := types.NewMsgLimitOrder(
-				clientCtx.GetFromAddress(),
-				pairId,
-				dir,
-				offerCoin,
-				demandCoinDenom,
-				price,
-				amt,
-				orderLifespan,
-			)
+			msg := types.NewMsgCreateConcentratedPool(clientCtx.GetFromAddress(), pairId, initialPrice)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	cmd.Flags().AddFlagSet(flagSetOrder())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-func NewMarketOrderCmd() *cobra.Command {
+func NewOpenPositionCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "market-order [pair-id] [direction] [offer-coin] [demand-coin-denom] [amount]",
-		Args:  cobra.ExactArgs(5),
-		Short: "Make a market order",
+		Use:   "open-position [pool-id] [lower-price] [upper-price] [deposit-coins]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Open a position in a concentrated liquidity pool",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Make a market order.
+			fmt.Sprintf(`Open a position in a concentrated liquidity pool within the price range.
+Only the coins needed for the position's liquidity at the current pool price
+are taken from the deposit coins.
 
 Example:
-$ %s tx %s market-order 1 buy 5000stake uatom 10000 --from mykey
-$ %s tx %s market-order 1 b 5000stake uatom 10000 --from mykey
-$ %s tx %s market-order 1 sell 10000uatom stake 10000 --order-lifespan=10m --from mykey
-$ %s tx %s market-order 1 s 10000uatom stake 10000 --order-lifespan=10m --from mykey
-
-[pair-id]: pair id to swap with
-[direction]: order direction (one of: buy,b,sell,s)
-[offer-coin]: the amount of offer coin to swap
-[demand-coin-denom]: the denom to exchange with the offer coin
-[amount]: the amount of base coin to buy or sell
+$ %s tx %s open-position 1 0.5 2.0 1000000000uatom,1000000000stake --from mykey
 `,
 				version.AppName, types.ModuleName,
-				version.AppName, types.ModuleName,
-				version.AppName, types.ModuleName,
-				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -383,82 +368,49 @@ $ %s tx %s market-order 1 s 10000uatom stake 10000 --order-lifespan=10m --from m
 				return err
 			}
 
-			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
-				return fmt.Errorf("parse pair id: %w", err)
+				return fmt.Errorf("parse pool id: %w", err)
 			}
 
-			dir, err := parseOrderDirection(args[1])
+			lowerPrice, err := sdk.NewDecFromStr(args[1])
 			if err != nil {
-				return fmt.Errorf("parse order direction: %w", err)
+				return fmt.Errorf("invalid lower price: %w", err)
 			}
 
-			offerCoin, err := sdk.ParseCoinNormalized(args[2])
+			upperPrice, err := sdk.NewDecFromStr(args[2])
 			if err != nil {
-				return fmt.Errorf("invalid offer coin: %w", err)
-			}
-
-			demandCoinDenom := args[3]
-			if err := sdk.ValidateDenom(demandCoinDenom); err != nil {
-				return fmt.Errorf("invalid demand coin denom: %w", err)
+				return fmt.Errorf("invalid upper price: %w", err)
 			}
 
-			amt, ok := sdk.NewIntFromString(args[4])
-			if !ok {
-				return fmt.Errorf("invalid amount: %s", args[4])
+			depositCoins, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid deposit coins: %w", err)
 			}
 
-			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
-
-			msg := types.NewMsgMarketOrder(
-				clientCtx.GetFromAddress(),
-				pairId,
-				dir,
-				offerCoin,
-				demandCoinDenom,
-				amt,
-				orderLifespan,
-			)
+			msg := types.NewMsgOpenPosition(clientCtx.GetFromAddress(), poolId, lowerPrice, upperPrice, depositCoins)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	cmd.Flags().AddFlagSet(flagSetOrder())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-func NewMMOrderCmd() *cobra.Command {
+func NewClosePositionCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "mm-order [pair-id] [direction] [offer-coin] [demand-coin-denom] [price] [amount]",
-		Args:  cobra.ExactArgs(6),
-		Short: "Make a market making order",
+		Use:   "close-position [pool-id] [position-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Close a position",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Make a market making order.
-Market making orders are basically limit orders but has a limit to the number
-of orders an orderer can place per pair.
-This type of order is designed for market makers, so normal users should use
-limit/market orders instead.
+			fmt.Sprintf(`Close a position and withdraw the coins of its liquidity along with the accrued fees.
 
 Example:
-$ %s tx %s mm-order 1 buy 5000stake uatom 0.5 10000 --from mykey
-$ %s tx %s mm-order 1 b 5000stake uatom 0.5 10000 --from mykey
-$ %s tx %s mm-order 1 sell 10000uatom stake 2.0 10000 --order-lifespan=10m --from mykey
-$ %s tx %s mm-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --from mykey
-
-[pair-id]: pair id to swap with
-[direction]: order direction (one of: buy,b,sell,s)
-[offer-coin]: the amount of offer coin to swap
-[demand-coin-denom]: the denom to exchange with the offer coin
-[price]: the limit order price for the swap; the exchange ratio is X/Y where X is the amount of quote coin and Y is the amount of base coin
-[amount]: the amount of base coin to buy or sell
+$ %s tx %s close-position 1 1 --from mykey
 `,
 				version.AppName, types.ModuleName,
-				version.AppName, types.ModuleName,
-				version.AppName, types.ModuleName,
-				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -467,69 +419,37 @@ $ %s tx %s mm-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --from m
 				return err
 			}
 
-			pairId, err := strconv.ParseUint(args[0], 10, 64)
-			if err != nil {
-				return fmt.Errorf("parse pair id: %w", err)
-			}
-
-			dir, err := parseOrderDirection(args[1])
-			if err != nil {
-				return fmt.Errorf("parse order direction: %w", err)
-			}
-
-			offerCoin, err := sdk.ParseCoinNormalized(args[2])
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
-				return fmt.Errorf("invalid offer coin: %w", err)
-			}
-
-			demandCoinDenom := args[3]
-			if err := sdk.ValidateDenom(demandCoinDenom); err != nil {
-				return fmt.Errorf("invalid demand coin denom: %w", err)
+				return fmt.Errorf("parse pool id: %w", err)
 			}
 
-			price, err := sdk.NewDecFromStr(args[4])
+			positionId, err := strconv.ParseUint(args[1], 10, 64)
 			if err != nil {
-				return fmt.Errorf("invalid price: %w", err)
-			}
-
-			amt, ok := sdk.NewIntFromString(args[5])
-			if !ok {
-				return fmt.Errorf("invalid amount: %s", args[5])
+				return fmt.Errorf("parse position id: %w", err)
 			}
 
-			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
-
-			msg := types.NewMsgMMOrder(
-				clientCtx.GetFromAddress(),
-				pairId,
-				dir,
-				offerCoin,
-				demandCoinDenom,
-				price,
-				amt,
-				orderLifespan,
-			)
+			msg := types.NewMsgClosePosition(clientCtx.GetFromAddress(), poolId, positionId)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	cmd.Flags().AddFlagSet(flagSetOrder())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-func NewCancelOrderCmd() *cobra.Command {
+func NewCollectFeesCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "cancel-order [pair-id] [order-id]",
+		Use:   "collect-fees [pool-id] [position-id]",
 		Args:  cobra.ExactArgs(2),
-		Short: "Cancel an order",
+		Short: "Collect fees accrued to a position",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Cancel an order.
+			fmt.Sprintf(`Collect fees accrued to a position.
 
 Example:
-$ %s tx %s cancel-order 1 1 --from mykey
+$ %s tx %s collect-fees 1 1 --from mykey
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -540,21 +460,17 @@ $ %s tx %s cancel-order 1 1 --from mykey
 				return err
 			}
 
-			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
-				return err
+				return fmt.Errorf("parse pool id: %w", err)
 			}
 
-			orderId, err := strconv.ParseUint(args[1], 10, 64)
+			positionId, err := strconv.ParseUint(args[1], 10, 64)
 			if err != nil {
-				return err
+				return fmt.Errorf("parse position id: %w", err)
 			}
 
-			msg := types.NewMsgCancelOrder(
-				clientCtx.GetFromAddress(),
-				pairId,
-				orderId,
-			)
+			msg := types.NewMsgCollectFees(clientCtx.GetFromAddress(), poolId, positionId)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -565,20 +481,18 @@ $ %s tx %s cancel-order 1 1 --from mykey
 	return cmd
 }
 
-func NewCancelAllOrdersCmd() *cobra.Command {
+func NewDepositCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "cancel-all-orders [pair-ids]",
-		Args:  cobra.MaximumNArgs(1),
-		Short: "Cancel all orders",
+		Use:   "deposit [pool-id] [deposit-coins]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Deposit coins to a liquidity pool",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Cancel all orders.
+			fmt.Sprintf(`Deposit coins to a liquidity pool.
 
 Example:
-$ %s tx %s cancel-all-orders --from mykey
-$ %s tx %s cancel-all-orders 1,3 --from mykey
+$ %s tx %s deposit 1 1000000000uatom,50000000000stake --from mykey
 `,
 				version.AppName, types.ModuleName,
-				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -587,16 +501,17 @@ $ %s tx %s cancel-all-orders 1,3 --from mykey
 				return err
 			}
 
-			var pairIds []uint64
-			for _, pairIdStr := range strings.Split(args[0], ",") {
-				pairId, err := strconv.ParseUint(pairIdStr, 10, 64)
-				if err != nil {
-					return fmt.Errorf("parse pair id: %w", err)
-				}
-				pairIds = append(pairIds, pairId)
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pool id: %w", err)
 			}
 
-			msg := types.NewMsgCancelAllOrders(clientCtx.GetFromAddress(), pairIds)
+			depositCoins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid deposit coins: %w", err)
+			}
+
+			msg := types.NewMsgDeposit(clientCtx.GetFromAddress(), poolId, depositCoins)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -606,3 +521,1087 @@ $ %s tx %s cancel-all-orders 1,3 --from mykey
 
 	return cmd
 }
+
+func NewDepositSingleSidedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-single-sided [pool-id] [deposit-coin] [min-minted-pool-coin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Deposit only one coin to a liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit only one coin to a liquidity pool.
+A part of the deposit coin is swapped against the pool in the next batch,
+and the result is deposited to the pool.
+If the minted pool coin is smaller than min-minted-pool-coin, the whole
+deposit coin is refunded.
+
+Example:
+$ %s tx %s deposit-single-sided 1 1000000000uatom 1000000pool1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pool id: %w", err)
+			}
+
+			depositCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid deposit coin: %w", err)
+			}
+
+			minMintedPoolCoin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid min minted pool coin: %w", err)
+			}
+
+			msg := types.NewMsgDepositSingleSided(clientCtx.GetFromAddress(), poolId, depositCoin, minMintedPoolCoin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewWithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw [pool-id] [pool-coin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Withdraw coins from the specified liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw coins from the specified liquidity pool.
+
+Example:
+$ %s tx %s withdraw 1 10000pool1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			poolCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdraw(
+				clientCtx.GetFromAddress(),
+				poolId,
+				poolCoin,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewWithdrawSingleSidedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-single-sided [pool-id] [pool-coin] [min-withdrawn-coin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Withdraw only one coin from the specified liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw only one coin from the specified liquidity pool.
+The denom of min-withdrawn-coin is the denom of the coin to receive.
+The other coin withdrawn from the pool is swapped through the pair in the
+next batch.
+If the coin to receive is smaller than min-withdrawn-coin, the whole pool
+coin is refunded.
+
+Example:
+$ %s tx %s withdraw-single-sided 1 10000pool1 1000000uatom --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pool id: %w", err)
+			}
+
+			poolCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid pool coin: %w", err)
+			}
+
+			minWithdrawnCoin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid min withdrawn coin: %w", err)
+			}
+
+			msg := types.NewMsgWithdrawSingleSided(clientCtx.GetFromAddress(), poolId, poolCoin, minWithdrawnCoin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-order [pair-id] [direction] [offer-coin] [demand-coin-denom] [price] [amount]",
+		Args:  cobra.ExactArgs(6),
+		Short: "Make a limit order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Make a limit order.
+
+Example:
+$ %s tx %s limit-order 1 buy 5000stake uatom 0.5 10000 --from mykey
+$ %s tx %s limit-order 1 b 5000stake uatom 0.5 10000 --from mykey
+$ %s tx %s limit-order 1 sell 10000uatom stake 2.0 10000 --order-lifespan=10m --from mykey
+$ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --from mykey
+$ %s tx %s limit-order 1 b 5000stake uatom 0.5 10000 --time-in-force=ioc --from mykey
+$ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --post-only --from mykey
+
+[pair-id]: pair id to swap with
+[direction]: order direction (one of: buy,b,sell,s)
+[offer-coin]: the amount of offer coin to swap
+[demand-coin-denom]: the denom to exchange with the offer coin
+[price]: the limit order price for the swap; the exchange ratio is X/Y where X is the amount of quote coin and Y is the amount of base coin
+[amount]: the amount of base coin to buy or sell
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			dir, err := parseOrderDirection(args[1])
+			if err != nil {
+				return fmt.Errorf("parse order direction: %w", err)
+			}
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid offer coin: %w", err)
+			}
+
+			demandCoinDenom := args[3]
+			if err := sdk.ValidateDenom(demandCoinDenom); err != nil {
+				return fmt.Errorf("invalid demand coin denom: %w", err)
+			}
+
+			price, err := sdk.NewDecFromStr(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid price: %w", err)
+			}
+
+			amt, ok := sdk.NewIntFromString(args[5])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[5])
+			}
+
+			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
+
+			tifStr, _ := cmd.Flags().GetString(FlagTimeInForce)
+			timeInForce, err := parseTimeInForce(tifStr)
+			if err != nil {
+				return fmt.Errorf("parse time in force: %w", err)
+			}
+
+			msg := types.NewMsgLimitOrder(
+				clientCtx.GetFromAddress(),
+				pairId,
+				dir,
+				offerCoin,
+				demandCoinDenom,
+				price,
+				amt,
+				orderLifespan,
+			)
+			msg.TimeInForce = timeInForce
+			msg.PostOnly, _ = cmd.Flags().GetBool(FlagPostOnly)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetOrder())
+	cmd.Flags().String(FlagTimeInForce, "", "Time in force of the order (one of: ioc,fok); the order remains until it is expired if not specified")
+	cmd.Flags().Bool(FlagPostOnly, false, "Reject the order if it would cross the best opposite price of the pair")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewMarketOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-order [pair-id] [direction] [offer-coin] [demand-coin-denom] [amount]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Make a market order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Make a market order.
+
+Example:
+$ %s tx %s market-order 1 buy 5000stake uatom 10000 --from mykey
+$ %s tx %s market-order 1 b 5000stake uatom 10000 --from mykey
+$ %s tx %s market-order 1 sell 10000uatom stake 10000 --order-lifespan=10m --from mykey
+$ %s tx %s market-order 1 s 10000uatom stake 10000 --order-lifespan=10m --from mykey
+
+[pair-id]: pair id to swap with
+[direction]: order direction (one of: buy,b,sell,s)
+[offer-coin]: the amount of offer coin to swap
+[demand-coin-denom]: the denom to exchange with the offer coin
+[amount]: the amount of base coin to buy or sell
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			dir, err := parseOrderDirection(args[1])
+			if err != nil {
+				return fmt.Errorf("parse order direction: %w", err)
+			}
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid offer coin: %w", err)
+			}
+
+			demandCoinDenom := args[3]
+			if err := sdk.ValidateDenom(demandCoinDenom); err != nil {
+				return fmt.Errorf("invalid demand coin denom: %w", err)
+			}
+
+			amt, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[4])
+			}
+
+			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
+
+			msg := types.NewMsgMarketOrder(
+				clientCtx.GetFromAddress(),
+				pairId,
+				dir,
+				offerCoin,
+				demandCoinDenom,
+				amt,
+				orderLifespan,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetOrder())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewMMOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mm-order [pair-id] [direction] [offer-coin] [demand-coin-denom] [price] [amount]",
+		Args:  cobra.ExactArgs(6),
+		Short: "Make a market making order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Make a market making order.
+Market making orders are basically limit orders but has a limit to the number
+of orders an orderer can place per pair.
+This type of order is designed for market makers, so normal users should use
+limit/market orders instead.
+
+Example:
+$ %s tx %s mm-order 1 buy 5000stake uatom 0.5 10000 --from mykey
+$ %s tx %s mm-order 1 b 5000stake uatom 0.5 10000 --from mykey
+$ %s tx %s mm-order 1 sell 10000uatom stake 2.0 10000 --order-lifespan=10m --from mykey
+$ %s tx %s mm-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --from mykey
+$ %s tx %s mm-order 1 s 10000uatom stake 2.0 10000 --post-only --from mykey
+
+[pair-id]: pair id to swap with
+[direction]: order direction (one of: buy,b,sell,s)
+[offer-coin]: the amount of offer coin to swap
+[demand-coin-denom]: the denom to exchange with the offer coin
+[price]: the limit order price for the swap; the exchange ratio is X/Y where X is the amount of quote coin and Y is the amount of base coin
+[amount]: the amount of base coin to buy or sell
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			dir, err := parseOrderDirection(args[1])
+			if err != nil {
+				return fmt.Errorf("parse order direction: %w", err)
+			}
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid offer coin: %w", err)
+			}
+
+			demandCoinDenom := args[3]
+			if err := sdk.ValidateDenom(demandCoinDenom); err != nil {
+				return fmt.Errorf("invalid demand coin denom: %w", err)
+			}
+
+			price, err := sdk.NewDecFromStr(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid price: %w", err)
+			}
+
+			amt, ok := sdk.NewIntFromString(args[5])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[5])
+			}
+
+			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
+
+			msg := types.NewMsgMMOrder(
+				clientCtx.GetFromAddress(),
+				pairId,
+				dir,
+				offerCoin,
+				demandCoinDenom,
+				price,
+				amt,
+				orderLifespan,
+			)
+			msg.PostOnly, _ = cmd.Flags().GetBool(FlagPostOnly)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetOrder())
+	cmd.Flags().Bool(FlagPostOnly, false, "Reject the order if it would cross the best opposite price of the pair")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewBatchOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-orders [pair-id] [orders]...",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Make multiple limit or MM orders in a pair at once",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Make multiple limit or MM orders in a pair at once.
+Offer coins for the orders are derived from the prices and the amounts.
+
+Example:
+$ %s tx %s batch-orders 1 buy:0.99:10000 buy:0.98:10000 sell:1.01:10000 sell:1.02:10000 --from mykey
+$ %s tx %s batch-orders 1 b:0.99:10000 s:1.01:10000 --mm --order-lifespan=10m --from mykey
+
+[pair-id]: pair id to make orders
+[orders]: orders in the form of direction:price:amount
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			var orders []types.BatchOrderEntry
+			for _, arg := range args[1:] {
+				order, err := parseBatchOrderEntry(arg)
+				if err != nil {
+					return fmt.Errorf("parse order: %w", err)
+				}
+				orders = append(orders, order)
+			}
+
+			typ := types.OrderTypeLimit
+			if mm, _ := cmd.Flags().GetBool(FlagMM); mm {
+				typ = types.OrderTypeMM
+			}
+
+			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
+
+			msg := types.NewMsgBatchOrders(
+				clientCtx.GetFromAddress(),
+				pairId,
+				typ,
+				orders,
+				orderLifespan,
+			)
+			msg.PostOnly, _ = cmd.Flags().GetBool(FlagPostOnly)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetOrder())
+	cmd.Flags().Bool(FlagMM, false, "Make MM orders instead of limit orders")
+	cmd.Flags().Bool(FlagPostOnly, false, "Reject the orders if any of them would cross the best opposite price of the pair")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewTriggerOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trigger-order [pair-id] [direction] [offer-coin] [demand-coin-denom] [trigger-condition] [trigger-price] [amount]",
+		Args:  cobra.ExactArgs(7),
+		Short: "Make a trigger(stop-loss or take-profit) order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Make a trigger(stop-loss or take-profit) order.
+A trigger order stays dormant until the pair's last price meets the trigger condition.
+After being triggered, the order becomes a limit order if --price is specified,
+otherwise it becomes a market order.
+The offer coin is escrowed when the order is made and the order lifespan covers
+the dormant period as well.
+
+Example:
+$ %s tx %s trigger-order 1 sell 10000uatom stake lte 1.8 10000 --from mykey
+$ %s tx %s trigger-order 1 s 10000uatom stake gte 2.5 10000 --price=2.4 --order-lifespan=1h --from mykey
+$ %s tx %s trigger-order 1 buy 5000stake uatom gte 0.6 5000 --price=0.65 --from mykey
+
+[pair-id]: pair id to swap with
+[direction]: order direction (one of: buy,b,sell,s)
+[offer-coin]: the amount of offer coin to swap
+[demand-coin-denom]: the denom to exchange with the offer coin
+[trigger-condition]: the condition to trigger the order (one of: gte,lte)
+[trigger-price]: the price which the pair's last price is compared with
+[amount]: the amount of base coin to buy or sell
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			dir, err := parseOrderDirection(args[1])
+			if err != nil {
+				return fmt.Errorf("parse order direction: %w", err)
+			}
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid offer coin: %w", err)
+			}
+
+			demandCoinDenom := args[3]
+			if err := sdk.ValidateDenom(demandCoinDenom); err != nil {
+				return fmt.Errorf("invalid demand coin denom: %w", err)
+			}
+
+			triggerCond, err := parseTriggerCondition(args[4])
+			if err != nil {
+				return fmt.Errorf("parse trigger condition: %w", err)
+			}
+
+			triggerPrice, err := sdk.NewDecFromStr(args[5])
+			if err != nil {
+				return fmt.Errorf("invalid trigger price: %w", err)
+			}
+
+			amt, ok := sdk.NewIntFromString(args[6])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[6])
+			}
+
+			var price *sdk.Dec
+			priceStr, _ := cmd.Flags().GetString(FlagPrice)
+			if priceStr != "" {
+				p, err := sdk.NewDecFromStr(priceStr)
+				if err != nil {
+					return fmt.Errorf("invalid price: %w", err)
+				}
+				price = &p
+			}
+
+			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
+
+			msg := types.NewMsgTriggerOrder(
+				clientCtx.GetFromAddress(),
+				pairId,
+				dir,
+				offerCoin,
+				demandCoinDenom,
+				price,
+				amt,
+				triggerPrice,
+				triggerCond,
+				orderLifespan,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetOrder())
+	cmd.Flags().String(FlagPrice, "", "The limit order price after the order is triggered; the order becomes a market order if not specified")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSwapExactInCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-in [pair-ids] [offer-coin] [min-out-coin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Swap coins through multiple pairs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap coins through multiple pairs.
+The offer coin is swapped through the pairs in the given order, and the request
+fails if the output is smaller than the min out coin.
+
+Example:
+$ %s tx %s swap-exact-in 1,2 1000000uatom 900000stake --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pairIds []uint64
+			for _, pairIdStr := range strings.Split(args[0], ",") {
+				pairId, err := strconv.ParseUint(pairIdStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("parse pair id: %w", err)
+				}
+				pairIds = append(pairIds, pairId)
+			}
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid offer coin: %w", err)
+			}
+
+			minOutCoin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid min out coin: %w", err)
+			}
+
+			msg := types.NewMsgSwapExactIn(clientCtx.GetFromAddress(), pairIds, offerCoin, minOutCoin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCancelOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-order [pair-id] [order-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel an order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel an order.
+
+Example:
+$ %s tx %s cancel-order 1 1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelOrder(
+				clientCtx.GetFromAddress(),
+				pairId,
+				orderId,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewReplaceOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replace-order [pair-id] [order-id] [price] [amount]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Replace an order with a new one",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel an order and make a new order with the same direction and type atomically.
+Only the difference between the remaining offer coin of the order and the offer coin of the new order is transferred.
+The new order keeps the priority of the order if the price is unchanged and the amount is not increased.
+
+Example:
+$ %s tx %s replace-order 1 1 0.5 5000 --from mykey
+$ %s tx %s replace-order 1 1 0.55 10000 --order-lifespan=10m --from mykey
+
+[pair-id]: pair id of the order
+[order-id]: id of the order to be replaced
+[price]: the new order price
+[amount]: the new amount of base coin to buy or sell
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			orderId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse order id: %w", err)
+			}
+
+			price, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid price: %w", err)
+			}
+
+			amt, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[3])
+			}
+
+			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
+
+			msg := types.NewMsgReplaceOrder(
+				clientCtx.GetFromAddress(),
+				pairId,
+				orderId,
+				price,
+				amt,
+				orderLifespan,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetOrder())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCancelAllOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-all-orders [pair-ids]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Cancel all orders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel all orders.
+
+Example:
+$ %s tx %s cancel-all-orders --from mykey
+$ %s tx %s cancel-all-orders 1,3 --from mykey
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pairIds []uint64
+			for _, pairIdStr := range strings.Split(args[0], ",") {
+				pairId, err := strconv.ParseUint(pairIdStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("parse pair id: %w", err)
+				}
+				pairIds = append(pairIds, pairId)
+			}
+
+			msg := types.NewMsgCancelAllOrders(clientCtx.GetFromAddress(), pairIds)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitPairFeeRatesProposal implements a command handler for submitting
+// a pair fee rates proposal.
+func NewCmdSubmitPairFeeRatesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-fee-rates [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a pair fee rates proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to change the maker and taker fee rates of pairs along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+An omitted fee rate removes the fee.
+
+Example:
+$ %s tx gov submit-proposal pair-fee-rates <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Pair Fee Rates Proposal",
+  "description": "Change the fee rates of pair 1 and remove the fees of pair 2",
+  "changes": [
+    {
+      "pair_id": "1",
+      "maker_fee_rate": "0.001",
+      "taker_fee_rate": "0.003"
+    },
+    {
+      "pair_id": "2"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParsePairFeeRatesProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitStablePoolAmplificationProposal implements a command handler for
+// submitting a stable pool amplification proposal.
+func NewCmdSubmitStablePoolAmplificationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stable-pool-amplification [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a stable pool amplification proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to change the amplification coefficient of stable pools along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal stable-pool-amplification <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Stable Pool Amplification Proposal",
+  "description": "Increase the amplification of pool 1",
+  "changes": [
+    {
+      "pool_id": "1",
+      "amplification": 200
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParseStablePoolAmplificationProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitPoolManagementProposal implements a command handler for
+// submitting a pool management proposal.
+func NewCmdSubmitPoolManagementProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-management [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a pool management proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to pause or resume pairs and pools, disable or re-enable pools, or migrate pool liquidity along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+Pair changes are applied first, then pool changes and then migrations.
+
+Example:
+$ %s tx gov submit-proposal pool-management <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Pool Management Proposal",
+  "description": "Pause orders in pair 1 and migrate pool 1 into pool 2",
+  "pair_changes": [
+    {
+      "pair_id": "1",
+      "deposits_paused": false,
+      "orders_paused": true
+    }
+  ],
+  "pool_changes": [
+    {
+      "pool_id": "3",
+      "deposits_paused": false,
+      "orders_paused": false,
+      "disabled": false
+    }
+  ],
+  "migrations": [
+    {
+      "source_pool_id": "1",
+      "target_pool_id": "2"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParsePoolManagementProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// NewCmdSubmitPairOrderRulesProposal implements a command handler for
+// submitting a pair order rules proposal transaction.
+func NewCmdSubmitPairOrderRulesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-order-rules [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a pair order rules proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to change the tick precision and the minimum order notional of pairs along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+Omit tick_precision or min_order_notional to clear the pair's value, in which case
+the tick precision falls back to the module parameter and no minimum order notional is applied.
+
+Example:
+$ %s tx gov submit-proposal pair-order-rules <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Pair Order Rules Proposal",
+  "description": "Use coarser ticks and a minimum order notional for pair 1",
+  "changes": [
+    {
+      "pair_id": "1",
+      "tick_precision": 2,
+      "min_order_notional": "1000000"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := ParsePairOrderRulesProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := gov.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -2,8 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/crescent-network/crescent/v5/x/liquidity/types"
 )
 
@@ -29,3 +33,130 @@ func parseOrderDirection(s string) (types.OrderDirection, error) {
 	}
 	return 0, fmt.Errorf("invalid order direction: %s", s)
 }
+
+// parseBatchOrderEntry parses batch order entry string in the form of
+// "direction:price:amount" and returns types.BatchOrderEntry.
+func parseBatchOrderEntry(s string) (types.BatchOrderEntry, error) {
+	chunks := strings.Split(s, ":")
+	if len(chunks) != 3 {
+		return types.BatchOrderEntry{}, fmt.Errorf("invalid order: %s", s)
+	}
+	dir, err := parseOrderDirection(chunks[0])
+	if err != nil {
+		return types.BatchOrderEntry{}, err
+	}
+	price, err := sdk.NewDecFromStr(chunks[1])
+	if err != nil {
+		return types.BatchOrderEntry{}, fmt.Errorf("invalid price: %w", err)
+	}
+	amt, ok := sdk.NewIntFromString(chunks[2])
+	if !ok {
+		return types.BatchOrderEntry{}, fmt.Errorf("invalid amount: %s", chunks[2])
+	}
+	return types.NewBatchOrderEntry(dir, price, amt), nil
+}
+
+// parseAllocationPolicy parses allocation policy string and returns
+// types.AllocationPolicy.
+func parseAllocationPolicy(s string) (types.AllocationPolicy, error) {
+	switch strings.ToLower(s) {
+	case "", "priority":
+		return types.AllocationPolicyPriority, nil
+	case "pro-rata", "prorata":
+		return types.AllocationPolicyProRata, nil
+	}
+	return 0, fmt.Errorf("invalid allocation policy: %s", s)
+}
+
+// parseTriggerCondition parses trigger condition string and returns
+// types.TriggerCondition.
+func parseTriggerCondition(s string) (types.TriggerCondition, error) {
+	switch strings.ToLower(s) {
+	case "gte", ">=":
+		return types.TriggerConditionGTE, nil
+	case "lte", "<=":
+		return types.TriggerConditionLTE, nil
+	}
+	return 0, fmt.Errorf("invalid trigger condition: %s", s)
+}
+
+// parseTimeInForce parses time in force string and returns
+// types.TimeInForce.
+func parseTimeInForce(s string) (types.TimeInForce, error) {
+	switch strings.ToLower(s) {
+	case "":
+		return types.TimeInForceUnspecified, nil
+	case "ioc", "immediate-or-cancel":
+		return types.TimeInForceImmediateOrCancel, nil
+	case "fok", "fill-or-kill":
+		return types.TimeInForceFillOrKill, nil
+	}
+	return 0, fmt.Errorf("invalid time in force: %s", s)
+}
+
+// ParsePairFeeRatesProposal reads and parses a PairFeeRatesProposal from a file.
+func ParsePairFeeRatesProposal(cdc codec.JSONCodec, proposalFile string) (types.PairFeeRatesProposal, error) {
+	proposal := types.PairFeeRatesProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseStablePoolAmplificationProposal reads and parses
+// a StablePoolAmplificationProposal from a file.
+func ParseStablePoolAmplificationProposal(cdc codec.JSONCodec, proposalFile string) (types.StablePoolAmplificationProposal, error) {
+	proposal := types.StablePoolAmplificationProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParsePoolManagementProposal reads and parses a PoolManagementProposal from
+// a file.
+func ParsePoolManagementProposal(cdc codec.JSONCodec, proposalFile string) (types.PoolManagementProposal, error) {
+	proposal := types.PoolManagementProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParsePairOrderRulesProposal reads and parses a PairOrderRulesProposal from
+// a file.
+func ParsePairOrderRulesProposal(cdc codec.JSONCodec, proposalFile string) (types.PairOrderRulesProposal, error) {
+	proposal := types.PairOrderRulesProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
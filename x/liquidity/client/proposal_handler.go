@@ -0,0 +1,21 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/crescent-network/crescent/v5/x/liquidity/client/cli"
+	"github.com/crescent-network/crescent/v5/x/liquidity/client/rest"
+)
+
+// ProposalHandler is the pair fee rates proposal command handler,
+// StablePoolAmplificationProposalHandler is the stable pool amplification
+// proposal command handler, PoolManagementProposalHandler is the pool
+// management proposal command handler and PairOrderRulesProposalHandler is the
+// pair order rules proposal command handler.
+// Note that rest.ProposalRESTHandler will be deprecated in the future.
+var (
+	ProposalHandler                        = govclient.NewProposalHandler(cli.NewCmdSubmitPairFeeRatesProposal, rest.ProposalRESTHandler)
+	StablePoolAmplificationProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitStablePoolAmplificationProposal, rest.StablePoolAmplificationProposalRESTHandler)
+	PoolManagementProposalHandler          = govclient.NewProposalHandler(cli.NewCmdSubmitPoolManagementProposal, rest.PoolManagementProposalRESTHandler)
+	PairOrderRulesProposalHandler          = govclient.NewProposalHandler(cli.NewCmdSubmitPairOrderRulesProposal, rest.PairOrderRulesProposalRESTHandler)
+)
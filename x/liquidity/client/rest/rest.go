@@ -0,0 +1,41 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+)
+
+func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pair_fee_rates",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func StablePoolAmplificationProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "stable_pool_amplification",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func PoolManagementProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pool_management",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func PairOrderRulesProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pair_order_rules",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func postProposalHandlerFn(_ client.Context) http.HandlerFunc {
+	return func(_ http.ResponseWriter, _ *http.Request) {
+	}
+}
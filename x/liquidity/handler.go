@@ -3,6 +3,7 @@ package liquidity
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/crescent-network/crescent/v5/x/liquidity/keeper"
 	"github.com/crescent-network/crescent/v5/x/liquidity/types"
@@ -25,12 +26,36 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgCreateRangedPool:
 			res, err := msgServer.CreateRangedPool(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreateStablePool:
+			res, err := msgServer.CreateStablePool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreateWeightedPool:
+			res, err := msgServer.CreateWeightedPool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreateConcentratedPool:
+			res, err := msgServer.CreateConcentratedPool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgOpenPosition:
+			res, err := msgServer.OpenPosition(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClosePosition:
+			res, err := msgServer.ClosePosition(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCollectFees:
+			res, err := msgServer.CollectFees(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgDeposit:
 			res, err := msgServer.Deposit(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDepositSingleSided:
+			res, err := msgServer.DepositSingleSided(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgWithdraw:
 			res, err := msgServer.Withdraw(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWithdrawSingleSided:
+			res, err := msgServer.WithdrawSingleSided(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgLimitOrder:
 			res, err := msgServer.LimitOrder(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -40,9 +65,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgMMOrder:
 			res, err := msgServer.MMOrder(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBatchOrders:
+			res, err := msgServer.BatchOrders(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTriggerOrder:
+			res, err := msgServer.TriggerOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSwapExactIn:
+			res, err := msgServer.SwapExactIn(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgCancelOrder:
 			res, err := msgServer.CancelOrder(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgReplaceOrder:
+			res, err := msgServer.ReplaceOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgCancelAllOrders:
 			res, err := msgServer.CancelAllOrders(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -51,3 +88,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		}
 	}
 }
+
+// NewProposalHandler returns a new governance proposal handler.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.PairFeeRatesProposal:
+			return keeper.HandlePairFeeRatesProposal(ctx, k, c)
+		case *types.StablePoolAmplificationProposal:
+			return keeper.HandleStablePoolAmplificationProposal(ctx, k, c)
+		case *types.PoolManagementProposal:
+			return keeper.HandlePoolManagementProposal(ctx, k, c)
+		case *types.PairOrderRulesProposal:
+			return keeper.HandlePairOrderRulesProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized liquidity proposal content type: %T", c)
+		}
+	}
+}
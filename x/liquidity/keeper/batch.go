@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"runtime"
+	"sync"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/crescent-network/crescent/v5/x/liquidity/types"
@@ -9,44 +12,51 @@ import (
 // ExecuteRequests executes all orders, deposit requests and withdraw requests.
 // ExecuteRequests also handles order expiration.
 func (k Keeper) ExecuteRequests(ctx sdk.Context) {
-	if err := k.IterateAllPairs(ctx, func(pair types.Pair) (stop bool, err error) {
-		if err := k.ExecuteMatching(ctx, pair); err != nil {
+	// The price limits of swaps are based on the pairs' last prices before
+	// the batch matching, so that the swaps in the block can't move the
+	// prices further than the batch matching could.
+	lastPrices := map[uint64]sdk.Dec{}
+	_ = k.IterateAllPairs(ctx, func(pair types.Pair) (stop bool, err error) {
+		if pair.LastPrice != nil {
+			lastPrices[pair.Id] = *pair.LastPrice
+		}
+		return false, nil
+	})
+	if err := k.executeMatchings(ctx); err != nil {
+		panic(err)
+	}
+	if err := k.ExpireOrders(ctx); err != nil {
+		panic(err)
+	}
+	if err := k.IterateDepositRequestsByStatus(ctx, types.RequestStatusNotExecuted, func(req types.DepositRequest) (stop bool, err error) {
+		if err := k.ExecuteDepositRequest(ctx, req); err != nil {
 			return false, err
 		}
 		return false, nil
 	}); err != nil {
 		panic(err)
 	}
-	if err := k.IterateAllOrders(ctx, func(order types.Order) (stop bool, err error) {
-		if order.Status.CanBeExpired() && order.ExpiredAt(ctx.BlockTime()) {
-			if err := k.FinishOrder(ctx, order, types.OrderStatusExpired); err != nil {
-				return false, err
-			}
-		} else if types.IsTooSmallOrderAmount(order.OpenAmount, order.Price) {
-			// TODO: should we introduce new order status for this type of expiration?
-			if err := k.FinishOrder(ctx, order, types.OrderStatusExpired); err != nil {
-				return false, err
-			}
+	if err := k.IterateSingleSidedDepositRequestsByStatus(ctx, types.RequestStatusNotExecuted, func(req types.SingleSidedDepositRequest) (stop bool, err error) {
+		if err := k.ExecuteSingleSidedDepositRequest(ctx, req); err != nil {
+			return false, err
 		}
 		return false, nil
 	}); err != nil {
 		panic(err)
 	}
-	if err := k.IterateAllDepositRequests(ctx, func(req types.DepositRequest) (stop bool, err error) {
-		if req.Status == types.RequestStatusNotExecuted {
-			if err := k.ExecuteDepositRequest(ctx, req); err != nil {
-				return false, err
-			}
+	if err := k.IterateWithdrawRequestsByStatus(ctx, types.RequestStatusNotExecuted, func(req types.WithdrawRequest) (stop bool, err error) {
+		if err := k.ExecuteWithdrawRequest(ctx, req); err != nil {
+			return false, err
 		}
 		return false, nil
 	}); err != nil {
 		panic(err)
 	}
-	if err := k.IterateAllWithdrawRequests(ctx, func(req types.WithdrawRequest) (stop bool, err error) {
-		if req.Status == types.RequestStatusNotExecuted {
-			if err := k.ExecuteWithdrawRequest(ctx, req); err != nil {
-				return false, err
-			}
+	// Swap requests are executed after the batch matching, so that each
+	// swap is matched around the price of the batch.
+	if err := k.IterateSwapRequestsByStatus(ctx, types.RequestStatusNotExecuted, func(req types.SwapRequest) (stop bool, err error) {
+		if err := k.ExecuteSwapRequest(ctx, req, lastPrices); err != nil {
+			return false, err
 		}
 		return false, nil
 	}); err != nil {
@@ -54,25 +64,134 @@ func (k Keeper) ExecuteRequests(ctx sdk.Context) {
 	}
 }
 
-// DeleteOutdatedRequests deletes outdated(should be deleted) requests.
-// Determining if a request should be deleted is based on its status.
-func (k Keeper) DeleteOutdatedRequests(ctx sdk.Context) {
-	_ = k.IterateAllDepositRequests(ctx, func(req types.DepositRequest) (stop bool, err error) {
-		if req.Status.ShouldBeDeleted() {
-			k.DeleteDepositRequest(ctx, req)
+// executeMatchings executes the matching of every pair.
+// When the parallel matching is enabled, the orders and pools of the pairs
+// are collected first, and the matching of the pairs is computed
+// concurrently.
+// Then the results are applied in the order of the pair ids.
+// prepareMatching doesn't write to the store and reads only the orders and
+// pools of the pair, which are not changed by applying the matching of other
+// pairs, so the matching of each pair sees the same state, and the state
+// changes and events are made in the same order as in the serial matching.
+func (k Keeper) executeMatchings(ctx sdk.Context) error {
+	var pairs []types.Pair
+	_ = k.IterateAllPairs(ctx, func(pair types.Pair) (stop bool, err error) {
+		// Orders in a paused pair are kept in the order book, but they are
+		// not matched until the pair is resumed.
+		if !pair.OrdersPaused {
+			pairs = append(pairs, pair)
 		}
 		return false, nil
 	})
-	_ = k.IterateAllWithdrawRequests(ctx, func(req types.WithdrawRequest) (stop bool, err error) {
-		if req.Status.ShouldBeDeleted() {
-			k.DeleteWithdrawRequest(ctx, req)
+
+	if !k.config.ParallelMatching {
+		for _, pair := range pairs {
+			if err := k.ExecuteMatching(ctx, pair); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	ms := make([]*pairMatching, len(pairs))
+	for i, pair := range pairs {
+		m, err := k.prepareMatching(ctx, pair)
+		if err != nil {
+			return err
+		}
+		ms[i] = m
+	}
+	// A panic during the matching is recovered and raised again when
+	// applying the result, so that it is raised in the caller's goroutine
+	// for the pair with the lowest id, as in the serial matching.
+	panics := make([]interface{}, len(ms))
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	var wg sync.WaitGroup
+	for i, m := range ms {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, m *pairMatching) {
+			defer func() {
+				panics[i] = recover()
+				<-sem
+				wg.Done()
+			}()
+			m.match()
+		}(i, m)
+	}
+	wg.Wait()
+	for i, m := range ms {
+		if panics[i] != nil {
+			panic(panics[i])
+		}
+		if err := k.applyMatching(ctx, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExpireOrders finishes orders expired at the current block time and
+// partially matched orders whose open amount became too small, either for
+// the module or for the pair's minimum order notional.
+func (k Keeper) ExpireOrders(ctx sdk.Context) error {
+	if err := k.IterateOrdersExpiredAt(ctx, ctx.BlockTime(), func(order types.Order) (stop bool, err error) {
+		if order.Status.CanBeExpired() {
+			if err := k.FinishOrder(ctx, order, types.OrderStatusExpired); err != nil {
+				return false, err
+			}
 		}
 		return false, nil
-	})
-	_ = k.IterateAllOrders(ctx, func(order types.Order) (stop bool, err error) {
-		if order.Status.ShouldBeDeleted() {
-			k.DeleteOrder(ctx, order)
+	}); err != nil {
+		return err
+	}
+	// The amount of an order is validated when the order is placed,
+	// so only partially matched orders can have too small open amount.
+	pairCache := map[uint64]types.Pair{}
+	return k.IterateOrdersByStatus(ctx, types.OrderStatusPartiallyMatched, func(order types.Order) (stop bool, err error) {
+		pair, ok := pairCache[order.PairId]
+		if !ok {
+			pair, _ = k.GetPair(ctx, order.PairId)
+			pairCache[order.PairId] = pair
+		}
+		if types.IsTooSmallOrderAmount(order.OpenAmount, order.Price) ||
+			pair.IsTooSmallOrderNotional(order.OpenAmount, order.Price) {
+			// TODO: should we introduce new order status for this type of expiration?
+			if err := k.FinishOrder(ctx, order, types.OrderStatusExpired); err != nil {
+				return false, err
+			}
 		}
 		return false, nil
 	})
 }
+
+// DeleteOutdatedRequests deletes outdated(should be deleted) requests.
+// Determining if a request should be deleted is based on its status.
+// Requests and orders are looked up by their status, so requests and
+// orders which should be kept are not visited.
+func (k Keeper) DeleteOutdatedRequests(ctx sdk.Context) {
+	for _, status := range []types.RequestStatus{types.RequestStatusSucceeded, types.RequestStatusFailed} {
+		_ = k.IterateDepositRequestsByStatus(ctx, status, func(req types.DepositRequest) (stop bool, err error) {
+			k.DeleteDepositRequest(ctx, req)
+			return false, nil
+		})
+		_ = k.IterateSingleSidedDepositRequestsByStatus(ctx, status, func(req types.SingleSidedDepositRequest) (stop bool, err error) {
+			k.DeleteSingleSidedDepositRequest(ctx, req)
+			return false, nil
+		})
+		_ = k.IterateWithdrawRequestsByStatus(ctx, status, func(req types.WithdrawRequest) (stop bool, err error) {
+			k.DeleteWithdrawRequest(ctx, req)
+			return false, nil
+		})
+		_ = k.IterateSwapRequestsByStatus(ctx, status, func(req types.SwapRequest) (stop bool, err error) {
+			k.DeleteSwapRequest(ctx, req)
+			return false, nil
+		})
+	}
+	for _, status := range []types.OrderStatus{types.OrderStatusCompleted, types.OrderStatusCanceled, types.OrderStatusExpired} {
+		_ = k.IterateOrdersByStatus(ctx, status, func(order types.Order) (stop bool, err error) {
+			k.DeleteOrder(ctx, order)
+			return false, nil
+		})
+	}
+}
@@ -1,12 +1,15 @@
 package keeper_test
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	utils "github.com/crescent-network/crescent/v5/types"
 	"github.com/crescent-network/crescent/v5/x/liquidity"
+	"github.com/crescent-network/crescent/v5/x/liquidity/keeper"
 	"github.com/crescent-network/crescent/v5/x/liquidity/types"
 
 	_ "github.com/stretchr/testify/suite"
@@ -44,3 +47,138 @@ func (s *KeeperTestSuite) TestOrderExpiration() {
 	_, found = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
 	s.Require().False(found) // The order is gone.
 }
+
+func (s *KeeperTestSuite) TestOrderAndRequestIndexes() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	ordersByStatus := func(status types.OrderStatus) (orderIds []uint64) {
+		_ = s.keeper.IterateOrdersByStatus(s.ctx, status, func(order types.Order) (stop bool, err error) {
+			orderIds = append(orderIds, order.Id)
+			return false, nil
+		})
+		return
+	}
+	ordersExpiredAt := func(t time.Time) (orderIds []uint64) {
+		_ = s.keeper.IterateOrdersExpiredAt(s.ctx, t, func(order types.Order) (stop bool, err error) {
+			orderIds = append(orderIds, order.Id)
+			return false, nil
+		})
+		return
+	}
+	depositReqsByStatus := func(status types.RequestStatus) (reqIds []uint64) {
+		_ = s.keeper.IterateDepositRequestsByStatus(s.ctx, status, func(req types.DepositRequest) (stop bool, err error) {
+			reqIds = append(reqIds, req.Id)
+			return false, nil
+		})
+		return
+	}
+
+	t0 := utils.ParseTime("2022-03-01T12:00:00Z")
+	s.ctx = s.ctx.WithBlockTime(t0)
+	order1 := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.5"), sdk.NewInt(10000), 10*time.Second, true)
+	order2 := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.5"), sdk.NewInt(10000), 20*time.Second, true)
+	req := s.deposit(s.addr(2), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.Require().Equal([]uint64{order1.Id, order2.Id}, ordersByStatus(types.OrderStatusNotExecuted))
+	s.Require().Equal([]uint64{req.Id}, depositReqsByStatus(types.RequestStatusNotExecuted))
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	s.Require().Empty(ordersByStatus(types.OrderStatusNotExecuted))
+	s.Require().Equal([]uint64{order1.Id, order2.Id}, ordersByStatus(types.OrderStatusNotMatched))
+	s.Require().Empty(depositReqsByStatus(types.RequestStatusNotExecuted))
+	s.Require().Equal([]uint64{req.Id}, depositReqsByStatus(types.RequestStatusSucceeded))
+	s.Require().Empty(ordersExpiredAt(t0))
+	s.Require().Equal([]uint64{order1.Id}, ordersExpiredAt(t0.Add(10*time.Second)))
+	s.Require().Equal([]uint64{order1.Id, order2.Id}, ordersExpiredAt(t0.Add(20*time.Second)))
+
+	s.ctx = s.ctx.WithBlockTime(t0.Add(10 * time.Second))
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	_, found := s.keeper.GetDepositRequest(s.ctx, req.PoolId, req.Id)
+	s.Require().False(found)
+	s.Require().Empty(depositReqsByStatus(types.RequestStatusSucceeded))
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// Only the first order is expired.
+	s.Require().Equal([]uint64{order1.Id}, ordersByStatus(types.OrderStatusExpired))
+	s.Require().Equal([]uint64{order2.Id}, ordersByStatus(types.OrderStatusNotMatched))
+
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	_, found = s.keeper.GetOrder(s.ctx, pair.Id, order1.Id)
+	s.Require().False(found)
+	s.Require().Empty(ordersByStatus(types.OrderStatusExpired))
+	s.Require().Equal([]uint64{order2.Id}, ordersExpiredAt(t0.Add(time.Hour)))
+}
+
+func (s *KeeperTestSuite) TestParallelMatching() {
+	for i := 0; i < 10; i++ {
+		pair := s.createPair(s.addr(0), fmt.Sprintf("base%d", i), fmt.Sprintf("quote%d", i), true)
+		s.createPool(s.addr(0), pair.Id, sdk.NewCoins(
+			sdk.NewInt64Coin(pair.BaseCoinDenom, 1000_000000),
+			sdk.NewInt64Coin(pair.QuoteCoinDenom, 1000_000000)), true)
+		s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.02"), sdk.NewInt(1000000), time.Hour, true)
+		s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.99"), sdk.NewInt(3000000), time.Hour, true)
+		// This order is expired in the next batch.
+		s.sellLimitOrder(s.addr(5), pair.Id, utils.ParseDec("1.08"), sdk.NewInt(1000000), time.Second, true)
+	}
+	// Pairs without last price are matched only by orders.
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	s.nextBlock()
+	for i := 0; i < 10; i++ {
+		pairId := uint64(i + 1)
+		s.buyLimitOrder(s.addr(3), pairId, utils.ParseDec("1.05"), sdk.NewInt(2000000), time.Hour, true)
+		s.sellLimitOrder(s.addr(4), pairId, utils.ParseDec("0.97"), sdk.NewInt(1000000), time.Hour, true)
+		s.timeInForceLimitOrder(
+			s.addr(6), pairId, types.OrderDirectionBuy, utils.ParseDec("1.05"), sdk.NewInt(1000_000000),
+			types.TimeInForceFillOrKill)
+		s.timeInForceLimitOrder(
+			s.addr(7), pairId, types.OrderDirectionSell, utils.ParseDec("0.98"), sdk.NewInt(1000000),
+			types.TimeInForceImmediateOrCancel)
+	}
+	// The pool of the third pair is depleted, so it is disabled in the batch.
+	pool, _ := s.keeper.GetPool(s.ctx, 3)
+	s.sendCoins(pool.GetReserveAddress(), s.addr(8), s.getBalances(pool.GetReserveAddress()))
+
+	parallelKeeper := keeper.NewKeeper(
+		s.app.AppCodec(), s.app.GetKey(types.StoreKey), s.app.GetSubspace(types.ModuleName),
+		s.app.AccountKeeper, s.app.BankKeeper, types.Config{ParallelMatching: true})
+
+	serialCtx, _ := s.ctx.CacheContext()
+	serialCtx = serialCtx.WithEventManager(sdk.NewEventManager())
+	s.keeper.ExecuteRequests(serialCtx)
+	parallelCtx, _ := s.ctx.CacheContext()
+	parallelCtx = parallelCtx.WithEventManager(sdk.NewEventManager())
+	parallelKeeper.ExecuteRequests(parallelCtx)
+
+	for _, storeKey := range []string{types.StoreKey, banktypes.StoreKey} {
+		serialIter := serialCtx.KVStore(s.app.GetKey(storeKey)).Iterator(nil, nil)
+		parallelIter := parallelCtx.KVStore(s.app.GetKey(storeKey)).Iterator(nil, nil)
+		for ; serialIter.Valid(); serialIter.Next() {
+			s.Require().True(parallelIter.Valid())
+			s.Require().Equal(serialIter.Key(), parallelIter.Key())
+			s.Require().Equal(serialIter.Value(), parallelIter.Value())
+			parallelIter.Next()
+		}
+		s.Require().False(parallelIter.Valid())
+		serialIter.Close()
+		parallelIter.Close()
+	}
+	// The events are emitted in the same order.
+	s.Require().NotEmpty(serialCtx.EventManager().Events())
+	s.Require().Equal(serialCtx.EventManager().Events(), parallelCtx.EventManager().Events())
+
+	// Orders are matched in every pair.
+	for i := 0; i < 10; i++ {
+		s.Require().True(s.app.BankKeeper.GetBalance(parallelCtx, s.addr(3), fmt.Sprintf("base%d", i)).IsPositive())
+	}
+	_ = parallelKeeper.IterateAllOrders(parallelCtx, func(order types.Order) (stop bool, err error) {
+		switch order.Orderer {
+		case s.addr(5).String():
+			s.Require().Equal(types.OrderStatusExpired, order.Status)
+		case s.addr(6).String():
+			s.Require().Equal(types.OrderStatusCanceled, order.Status)
+		}
+		return false, nil
+	})
+	pool, _ = parallelKeeper.GetPool(parallelCtx, 3)
+	s.Require().True(pool.Disabled)
+}
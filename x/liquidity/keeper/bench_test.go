@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -66,3 +67,136 @@ func BenchmarkMatching(b *testing.B) {
 		liquidity.EndBlocker(cacheCtx, keeper)
 	}
 }
+
+// BenchmarkOrderExpiration compares expiring and deleting orders through the
+// expiration time and status indexes against scanning all orders, with 100k
+// resting orders and 100 orders to be expired in the store.
+func BenchmarkOrderExpiration(b *testing.B) {
+	app := chain.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: utils.ParseTime("2022-01-01T00:00:00Z")})
+	keeper := app.LiquidityKeeper
+
+	require.NoError(b, chain.FundAccount(
+		app.BankKeeper, ctx, utils.TestAddress(0), utils.ParseCoins("9999999999999999stake")))
+	pair, err := keeper.CreatePair(ctx, types.NewMsgCreatePair(utils.TestAddress(0), "denom1", "denom2"))
+	require.NoError(b, err)
+
+	const numRestingOrders, numDueOrders = 100_000, 100
+	offerCoin := utils.ParseCoin("1000000denom1")
+	require.NoError(b, chain.FundAccount(
+		app.BankKeeper, ctx, pair.GetEscrowAddress(),
+		sdk.NewCoins(sdk.NewCoin(offerCoin.Denom, offerCoin.Amount.MulRaw(numDueOrders)))))
+	for i := 0; i < numRestingOrders+numDueOrders; i++ {
+		expireAt := ctx.BlockTime().Add(time.Hour)
+		if i < numDueOrders {
+			expireAt = ctx.BlockTime()
+		}
+		order := types.NewOrder(
+			types.OrderTypeLimit, uint64(i+1), pair, utils.TestAddress(1+i%100), offerCoin,
+			utils.ParseDec("1.0"), offerCoin.Amount, expireAt, 0)
+		order.SetStatus(types.OrderStatusNotMatched)
+		keeper.SetOrder(ctx, order)
+		keeper.SetOrderIndex(ctx, order)
+	}
+	pair.LastOrderId = numRestingOrders + numDueOrders
+	keeper.SetPair(ctx, pair)
+	ctx.MultiStore().(sdk.CacheMultiStore).Write()
+
+	b.Run("indexed", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			cacheCtx, _ := ctx.CacheContext()
+			require.NoError(b, keeper.ExpireOrders(cacheCtx))
+			keeper.DeleteOutdatedRequests(cacheCtx)
+		}
+	})
+	b.Run("full scan", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			cacheCtx, _ := ctx.CacheContext()
+			require.NoError(b, keeper.IterateAllOrders(cacheCtx, func(order types.Order) (stop bool, err error) {
+				if (order.Status.CanBeExpired() && order.ExpiredAt(cacheCtx.BlockTime())) ||
+					types.IsTooSmallOrderAmount(order.OpenAmount, order.Price) {
+					if err := keeper.FinishOrder(cacheCtx, order, types.OrderStatusExpired); err != nil {
+						return false, err
+					}
+				}
+				return false, nil
+			}))
+			_ = keeper.IterateAllOrders(cacheCtx, func(order types.Order) (stop bool, err error) {
+				if order.Status.ShouldBeDeleted() {
+					keeper.DeleteOrder(cacheCtx, order)
+				}
+				return false, nil
+			})
+		}
+	})
+}
+
+// BenchmarkExecuteMatching compares the matching with orders streamed from
+// the price index against matching the order book built from all orders,
+// with 100k resting orders beyond the price limits and 100 orders within
+// the price limits in the store.
+// Note that the latter doesn't even apply the match result.
+func BenchmarkExecuteMatching(b *testing.B) {
+	app := chain.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: utils.ParseTime("2022-01-01T00:00:00Z")})
+	keeper := app.LiquidityKeeper
+
+	require.NoError(b, chain.FundAccount(
+		app.BankKeeper, ctx, utils.TestAddress(0), utils.ParseCoins("9999999999999999stake")))
+	pair, err := keeper.CreatePair(ctx, types.NewMsgCreatePair(utils.TestAddress(0), "denom1", "denom2"))
+	require.NoError(b, err)
+
+	const numRestingOrders, numMatchableOrders = 100_000, 100
+	amt := sdk.NewInt(1000000)
+	escrowedCoins := sdk.NewCoins()
+	for i := 0; i < numRestingOrders+numMatchableOrders; i++ {
+		var price sdk.Dec
+		dir := amm.Buy
+		if i%2 == 1 {
+			dir = amm.Sell
+		}
+		switch {
+		case i < numMatchableOrders && dir == amm.Buy:
+			price = utils.ParseDec("1.01")
+		case i < numMatchableOrders:
+			price = utils.ParseDec("0.99")
+		case dir == amm.Buy:
+			price = utils.ParseDec("0.5").Add(utils.ParseDec("0.00001").MulInt64(int64(i % 10000)))
+		default:
+			price = utils.ParseDec("1.5").Add(utils.ParseDec("0.00001").MulInt64(int64(i % 10000)))
+		}
+		var offerCoin sdk.Coin
+		if dir == amm.Buy {
+			offerCoin = sdk.NewCoin("denom2", amm.OfferCoinAmount(amm.Buy, price, amt))
+		} else {
+			offerCoin = sdk.NewCoin("denom1", amt)
+		}
+		order := types.NewOrder(
+			types.OrderTypeLimit, uint64(i+1), pair, utils.TestAddress(1+i%100), offerCoin,
+			price, amt, ctx.BlockTime().Add(time.Hour), 0)
+		order.SetStatus(types.OrderStatusNotMatched)
+		keeper.SetOrder(ctx, order)
+		keeper.SetOrderIndex(ctx, order)
+		if i < numMatchableOrders {
+			escrowedCoins = escrowedCoins.Add(offerCoin)
+		}
+	}
+	require.NoError(b, chain.FundAccount(app.BankKeeper, ctx, pair.GetEscrowAddress(), escrowedCoins))
+	pair.LastOrderId = numRestingOrders + numMatchableOrders
+	pair.LastPrice = utils.ParseDecP("1.0")
+	keeper.SetPair(ctx, pair)
+	ctx.MultiStore().(sdk.CacheMultiStore).Write()
+
+	b.Run("indexed", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			cacheCtx, _ := ctx.CacheContext()
+			require.NoError(b, keeper.ExecuteMatching(cacheCtx, pair))
+		}
+	})
+	b.Run("all orders", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			cacheCtx, _ := ctx.CacheContext()
+			matchWithAllOrders(cacheCtx, keeper, pair)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v5/x/liquidity/amm"
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+// UpdateCandles updates the pair's candles of every interval in the
+// CandleIntervals param with the match price and the matched amounts of
+// the batch.
+// When a new candle is opened, candles older than MaxNumCandles intervals
+// are pruned.
+func (k Keeper) UpdateCandles(ctx sdk.Context, pair types.Pair, matchPrice sdk.Dec, baseVolume, quoteVolume sdk.Int) {
+	maxNumCandles := int64(k.GetMaxNumCandles(ctx))
+	for _, interval := range k.GetCandleIntervals(ctx) {
+		openTime := types.CandleOpenTime(ctx.BlockTime(), interval)
+		candle, found := k.GetCandle(ctx, pair.Id, interval, openTime)
+		if found {
+			candle.Update(matchPrice, baseVolume, quoteVolume)
+		} else {
+			candle = types.NewCandle(pair.Id, interval, openTime, matchPrice, baseVolume, quoteVolume)
+			k.pruneCandles(ctx, pair.Id, interval, openTime.Add(-time.Duration(maxNumCandles-1)*interval))
+		}
+		k.SetCandle(ctx, candle)
+	}
+}
+
+// pruneCandles deletes the candles of the pair with the interval opened
+// before the given time.
+func (k Keeper) pruneCandles(ctx sdk.Context, pairId uint64, interval time.Duration, before time.Time) {
+	var outdated []types.Candle
+	_ = k.IterateCandlesByPairAndInterval(ctx, pairId, interval, func(candle types.Candle) (stop bool, err error) {
+		if !candle.OpenTime.Before(before) {
+			return true, nil
+		}
+		outdated = append(outdated, candle)
+		return false, nil
+	})
+	for _, candle := range outdated {
+		k.DeleteCandle(ctx, candle)
+	}
+}
+
+// matchedVolumes returns the matched amounts of base coin and quote coin in
+// the batch, which are calculated from the matched buy orders.
+func matchedVolumes(orders []amm.Order) (baseVolume, quoteVolume sdk.Int) {
+	baseVolume, quoteVolume = sdk.ZeroInt(), sdk.ZeroInt()
+	for _, order := range orders {
+		if order.GetDirection() != amm.Buy || !order.IsMatched() {
+			continue
+		}
+		baseVolume = baseVolume.Add(order.GetReceivedDemandCoinAmount())
+		quoteVolume = quoteVolume.Add(order.GetPaidOfferCoinAmount())
+	}
+	return
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/liquidity"
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestCandles() {
+	s.keeper.SetCandleIntervals(s.ctx, []time.Duration{time.Minute, time.Hour})
+	s.keeper.SetMaxNumCandles(s.ctx, 3)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+
+	buy := true
+	trade := func(t time.Time) (matchPrice sdk.Dec, baseVolume, quoteVolume sdk.Int) {
+		s.ctx = s.ctx.WithBlockTime(t)
+		liquidity.BeginBlocker(s.ctx, s.keeper) // Deletes the completed orders.
+		reserveQuoteBefore := s.getBalance(pool.GetReserveAddress(), "denom2").Amount
+		var order types.Order
+		if buy {
+			order = s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.05"), sdk.NewInt(1000000), 0, true)
+		} else {
+			order = s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.95"), sdk.NewInt(1000000), 0, true)
+		}
+		buy = !buy
+		liquidity.EndBlocker(s.ctx, s.keeper)
+		order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+		s.Require().Equal(types.OrderStatusCompleted, order.Status)
+		pair, _ := s.keeper.GetPair(s.ctx, pair.Id)
+		paid := order.OfferCoin.Sub(order.RemainingOfferCoin).Amount
+		if order.Direction == types.OrderDirectionBuy {
+			return *pair.LastPrice, order.Amount, paid
+		}
+		// The pool is the buyer, so the volume is what the pool paid.
+		reserveQuoteAfter := s.getBalance(pool.GetReserveAddress(), "denom2").Amount
+		return *pair.LastPrice, order.Amount, reserveQuoteBefore.Sub(reserveQuoteAfter)
+	}
+
+	t0 := utils.ParseTime("2022-01-01T00:00:00Z")
+	price1, base1, quote1 := trade(t0.Add(10 * time.Second))
+	price2, base2, quote2 := trade(t0.Add(30 * time.Second))
+	s.Require().True(price2.LT(price1))
+
+	candle, found := s.keeper.GetCandle(s.ctx, pair.Id, time.Minute, t0)
+	s.Require().True(found)
+	s.Require().Equal(price1, candle.Open)
+	s.Require().Equal(price1, candle.High)
+	s.Require().Equal(price2, candle.Low)
+	s.Require().Equal(price2, candle.Close)
+	s.Require().Equal(base1.Add(base2), candle.BaseVolume)
+	s.Require().Equal(quote1.Add(quote2), candle.QuoteVolume)
+
+	for i := 1; i <= 3; i++ {
+		trade(t0.Add(time.Duration(i) * time.Minute))
+	}
+
+	// Candles older than MaxNumCandles intervals are pruned.
+	_, found = s.keeper.GetCandle(s.ctx, pair.Id, time.Minute, t0)
+	s.Require().False(found)
+
+	resp, err := s.querier.Candles(sdk.WrapSDKContext(s.ctx), &types.QueryCandlesRequest{
+		PairId:   pair.Id,
+		Interval: time.Minute,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Candles, 3)
+	s.Require().Equal(t0.Add(time.Minute), resp.Candles[0].OpenTime)
+
+	resp, err = s.querier.Candles(sdk.WrapSDKContext(s.ctx), &types.QueryCandlesRequest{
+		PairId:     pair.Id,
+		Interval:   time.Minute,
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Candles, 1)
+	s.Require().Equal(t0.Add(3*time.Minute), resp.Candles[0].OpenTime)
+
+	// The hourly candle has all the trades.
+	resp, err = s.querier.Candles(sdk.WrapSDKContext(s.ctx), &types.QueryCandlesRequest{
+		PairId:   pair.Id,
+		Interval: time.Hour,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Candles, 1)
+	s.Require().Equal(t0, resp.Candles[0].OpenTime)
+	s.Require().Equal(price1, resp.Candles[0].Open)
+	s.Require().Equal(sdk.NewInt(5000000), resp.Candles[0].BaseVolume)
+
+	_, err = s.querier.Candles(sdk.WrapSDKContext(s.ctx), &types.QueryCandlesRequest{PairId: pair.Id})
+	s.Require().EqualError(err, "rpc error: code = InvalidArgument desc = interval must be positive")
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/crescent-network/crescent/v5/x/liquidity/types"
@@ -15,6 +17,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 	k.SetLastPairId(ctx, genState.LastPairId)
 	k.SetLastPoolId(ctx, genState.LastPoolId)
+	k.SetLastSwapRequestId(ctx, genState.LastSwapRequestId)
 	for _, pair := range genState.Pairs {
 		k.SetPair(ctx, pair)
 		k.SetPairIndex(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom, pair.Id)
@@ -30,6 +33,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		k.SetDepositRequest(ctx, req)
 		k.SetDepositRequestIndex(ctx, req)
 	}
+	for _, req := range genState.SingleSidedDepositRequests {
+		k.SetSingleSidedDepositRequest(ctx, req)
+		k.SetSingleSidedDepositRequestIndex(ctx, req)
+	}
 	for _, req := range genState.WithdrawRequests {
 		k.SetWithdrawRequest(ctx, req)
 		k.SetWithdrawRequestIndex(ctx, req)
@@ -42,6 +49,31 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		ordererAddr := sdk.MustAccAddressFromBech32(record.Orderer)
 		k.SetNumMMOrders(ctx, ordererAddr, record.PairId, record.NumMarketMakingOrders)
 	}
+	for _, req := range genState.SwapRequests {
+		k.SetSwapRequest(ctx, req)
+	}
+	for _, position := range genState.Positions {
+		k.SetPosition(ctx, position)
+		k.SetPositionIndex(ctx, position)
+	}
+	for _, accrual := range genState.PoolAccruals {
+		k.SetPoolAccrual(ctx, accrual)
+	}
+	for _, snapshot := range genState.PoolAccrualSnapshots {
+		k.SetPoolAccrualSnapshot(ctx, snapshot)
+	}
+	if genState.LastPoolAccrualSnapshotTime != nil {
+		k.SetLastPoolAccrualSnapshotTime(ctx, *genState.LastPoolAccrualSnapshotTime)
+	}
+	for _, acc := range genState.TWAPAccumulators {
+		k.SetTWAPAccumulator(ctx, acc)
+	}
+	for _, snapshot := range genState.TWAPSnapshots {
+		k.SetTWAPSnapshot(ctx, snapshot)
+	}
+	for _, candle := range genState.Candles {
+		k.SetCandle(ctx, candle)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -55,6 +87,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		})
 		return false
 	})
+	var lastPoolAccrualSnapshotTime *time.Time
+	if t, found := k.GetLastPoolAccrualSnapshotTime(ctx); found {
+		lastPoolAccrualSnapshotTime = &t
+	}
 	return &types.GenesisState{
 		Params:                       k.GetParams(ctx),
 		LastPairId:                   k.GetLastPairId(ctx),
@@ -65,5 +101,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		WithdrawRequests:             k.GetAllWithdrawRequests(ctx),
 		Orders:                       k.GetAllOrders(ctx),
 		NumMarketMakingOrdersRecords: numMMOrdersRecords,
+		LastSwapRequestId:            k.GetLastSwapRequestId(ctx),
+		SwapRequests:                 k.GetAllSwapRequests(ctx),
+		Positions:                    k.GetAllPositions(ctx),
+		SingleSidedDepositRequests:   k.GetAllSingleSidedDepositRequests(ctx),
+		PoolAccruals:                 k.GetAllPoolAccruals(ctx),
+		PoolAccrualSnapshots:         k.GetAllPoolAccrualSnapshots(ctx),
+		LastPoolAccrualSnapshotTime:  lastPoolAccrualSnapshotTime,
+		TWAPAccumulators:             k.GetAllTWAPAccumulators(ctx),
+		TWAPSnapshots:                k.GetAllTWAPSnapshots(ctx),
+		Candles:                      k.GetAllCandles(ctx),
 	}
 }
@@ -32,13 +32,21 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	s.withdraw(s.addr(1), pool.Id, poolCoin)
 	s.nextBlock()
 
-	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), newInt(10000), 0, true)
+	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.01"), newInt(10000), 0, true)
 	s.nextBlock()
 
 	depositReq := s.deposit(s.addr(3), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
-	withdrawReq := s.withdraw(s.addr(1), pool.Id, poolCoin)
+	singleSidedDepositReq := s.depositSingleSided(
+		s.addr(3), pool.Id, utils.ParseCoin("1000000denom2"), sdk.NewInt64Coin(pool.PoolCoinDenom, 1), true)
+	withdrawReq := s.withdraw(s.addr(1), pool.Id, sdk.NewCoin(poolCoin.Denom, poolCoin.Amount.QuoRaw(2)))
+	singleSidedWithdrawReq := s.withdrawSingleSided(
+		s.addr(1), pool.Id, sdk.NewCoin(poolCoin.Denom, poolCoin.Amount.QuoRaw(2)), utils.ParseCoin("1denom1"))
 	order := s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), newInt(1000), 0, true)
 
+	clPool := s.createConcentratedPool(s.addr(0), pair.Id, utils.ParseDec("1.0"), true)
+	position := s.openPosition(
+		s.addr(4), clPool.Id, utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
 	genState := s.keeper.ExportGenesis(s.ctx)
 
 	bz := s.app.AppCodec().MustMarshalJSON(genState)
@@ -56,12 +64,28 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	depositReq2, found := s.keeper.GetDepositRequest(s.ctx, depositReq.PoolId, depositReq.Id)
 	s.Require().True(found)
 	s.Require().Equal(depositReq, depositReq2)
+	singleSidedDepositReq2, found := s.keeper.GetSingleSidedDepositRequest(
+		s.ctx, singleSidedDepositReq.PoolId, singleSidedDepositReq.Id)
+	s.Require().True(found)
+	s.Require().Equal(singleSidedDepositReq, singleSidedDepositReq2)
 	withdrawReq2, found := s.keeper.GetWithdrawRequest(s.ctx, withdrawReq.PoolId, withdrawReq.Id)
 	s.Require().True(found)
 	s.Require().Equal(withdrawReq, withdrawReq2)
+	singleSidedWithdrawReq2, found := s.keeper.GetWithdrawRequest(
+		s.ctx, singleSidedWithdrawReq.PoolId, singleSidedWithdrawReq.Id)
+	s.Require().True(found)
+	s.Require().Equal(singleSidedWithdrawReq, singleSidedWithdrawReq2)
 	order2, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
 	s.Require().True(found)
 	s.Require().Equal(order, order2)
+	position2, found := s.keeper.GetPosition(s.ctx, position.PoolId, position.Id)
+	s.Require().True(found)
+	s.Require().Equal(position, position2)
+	s.Require().Len(s.keeper.GetPositionsByOwner(s.ctx, s.addr(4)), 1)
+	s.Require().NotEmpty(genState.PoolAccruals)
+	s.Require().NotEmpty(genState.TWAPAccumulators)
+	s.Require().NotEmpty(genState.Candles)
+	s.Require().NotNil(genState.LastPoolAccrualSnapshotTime)
 }
 
 func (s *KeeperTestSuite) TestImportExportGenesisEmpty() {
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"sort"
 	"strconv"
 
 	"google.golang.org/grpc/codes"
@@ -329,6 +330,69 @@ func (k Querier) DepositRequest(c context.Context, req *types.QueryDepositReques
 	return &types.QueryDepositRequestResponse{DepositRequest: dq}, nil
 }
 
+// SingleSidedDepositRequests queries all single-sided deposit requests.
+func (k Querier) SingleSidedDepositRequests(c context.Context, req *types.QuerySingleSidedDepositRequestsRequest) (*types.QuerySingleSidedDepositRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	drsStore := prefix.NewStore(store, types.SingleSidedDepositRequestKeyPrefix)
+
+	var drs []types.SingleSidedDepositRequest
+	pageRes, err := query.FilteredPaginate(drsStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		dr, err := types.UnmarshalSingleSidedDepositRequest(k.cdc, value)
+		if err != nil {
+			return false, err
+		}
+
+		if dr.PoolId != req.PoolId {
+			return false, nil
+		}
+
+		if accumulate {
+			drs = append(drs, dr)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySingleSidedDepositRequestsResponse{SingleSidedDepositRequests: drs, Pagination: pageRes}, nil
+}
+
+// SingleSidedDepositRequest queries the specific single-sided deposit request.
+func (k Querier) SingleSidedDepositRequest(c context.Context, req *types.QuerySingleSidedDepositRequestRequest) (*types.QuerySingleSidedDepositRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id cannot be 0")
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	dq, found := k.GetSingleSidedDepositRequest(ctx, req.PoolId, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "single-sided deposit request of pool id %d and request id %d doesn't exist or deleted", req.PoolId, req.Id)
+	}
+
+	return &types.QuerySingleSidedDepositRequestResponse{SingleSidedDepositRequest: dq}, nil
+}
+
 // WithdrawRequests queries all withdraw requests.
 func (k Querier) WithdrawRequests(c context.Context, req *types.QueryWithdrawRequestsRequest) (*types.QueryWithdrawRequestsResponse, error) {
 	if req == nil {
@@ -493,6 +557,185 @@ func (k Querier) OrdersByOrderer(c context.Context, req *types.QueryOrdersByOrde
 	return &types.QueryOrdersResponse{Orders: orders, Pagination: pageRes}, nil
 }
 
+// Positions queries all positions in the concentrated pool.
+func (k Querier) Positions(c context.Context, req *types.QueryPositionsRequest) (*types.QueryPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	positionStore := prefix.NewStore(store, types.GetPositionsByPoolKeyPrefix(req.PoolId))
+
+	var positions []types.Position
+	pageRes, err := query.Paginate(positionStore, req.Pagination, func(key, value []byte) error {
+		position, err := types.UnmarshalPosition(k.cdc, value)
+		if err != nil {
+			return err
+		}
+		positions = append(positions, position)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPositionsResponse{Positions: positions, Pagination: pageRes}, nil
+}
+
+// Position queries the specific position.
+func (k Querier) Position(c context.Context, req *types.QueryPositionRequest) (*types.QueryPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id cannot be 0")
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	position, found := k.GetPosition(ctx, req.PoolId, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "position %d in pool %d not found", req.Id, req.PoolId)
+	}
+
+	return &types.QueryPositionResponse{Position: position}, nil
+}
+
+// PoolStats queries the trading volume, fees and the estimated APR of the pool.
+func (k Querier) PoolStats(c context.Context, req *types.QueryPoolStatsRequest) (*types.QueryPoolStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pool %d doesn't exist", req.PoolId)
+	}
+
+	return &types.QueryPoolStatsResponse{Stats: k.Keeper.PoolStats(ctx, pool)}, nil
+}
+
+// TWAP queries the time-weighted average price of the pair over the window.
+func (k Querier) TWAP(c context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	if req.Window <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "window must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetPair(ctx, req.PairId); !found {
+		return nil, status.Errorf(codes.NotFound, "pair %d doesn't exist", req.PairId)
+	}
+
+	twap, err := k.Keeper.TWAP(ctx, req.PairId, req.Window)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QueryTWAPResponse{TWAP: twap}, nil
+}
+
+// Candles queries the candles of the pair with the interval.
+func (k Querier) Candles(c context.Context, req *types.QueryCandlesRequest) (*types.QueryCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	if req.Interval <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "interval must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetPair(ctx, req.PairId); !found {
+		return nil, status.Errorf(codes.NotFound, "pair %d doesn't exist", req.PairId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	candleStore := prefix.NewStore(store, types.GetCandlesByPairAndIntervalKeyPrefix(req.PairId, req.Interval))
+
+	var candles []types.Candle
+	pageRes, err := query.Paginate(candleStore, req.Pagination, func(key, value []byte) error {
+		candle, err := types.UnmarshalCandle(k.cdc, value)
+		if err != nil {
+			return err
+		}
+		candles = append(candles, candle)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCandlesResponse{Candles: candles, Pagination: pageRes}, nil
+}
+
+// PositionsByOwner returns positions owned by an owner.
+func (k Querier) PositionsByOwner(c context.Context, req *types.QueryPositionsByOwnerRequest) (*types.QueryPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "owner address %s is invalid", req.Owner)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	keyPrefix := types.GetPositionIndexKeyPrefix(owner)
+	positionStore := prefix.NewStore(store, keyPrefix)
+	var positions []types.Position
+	pageRes, err := query.FilteredPaginate(positionStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		_, poolId, positionId := types.ParsePositionIndexKey(append(keyPrefix, key...))
+		if req.PoolId != 0 && poolId != req.PoolId {
+			return false, nil
+		}
+
+		position, _ := k.GetPosition(ctx, poolId, positionId)
+
+		if accumulate {
+			positions = append(positions, position)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPositionsResponse{Positions: positions, Pagination: pageRes}, nil
+}
+
 // OrderBooks queries virtual order books from user orders and pools.
 func (k Querier) OrderBooks(c context.Context, req *types.QueryOrderBooksRequest) (*types.QueryOrderBooksResponse, error) {
 	if req == nil {
@@ -529,8 +772,6 @@ func (k Querier) OrderBooks(c context.Context, req *types.QueryOrderBooksRequest
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	tickPrec := k.GetTickPrecision(ctx)
-
 	var pairs []types.OrderBookPairResponse
 	for _, pairId := range req.PairIds {
 		pair, found := k.GetPair(ctx, pairId)
@@ -553,14 +794,15 @@ func (k Querier) OrderBooks(c context.Context, req *types.QueryOrderBooksRequest
 			return false, nil
 		})
 
-		lowestPrice, highestPrice := k.PriceLimits(ctx, *pair.LastPrice)
+		tickPrec := k.GetPairTickPrecision(ctx, pair)
+		lowestPrice, highestPrice := k.PriceLimits(ctx, pair, *pair.LastPrice)
 		_ = k.IteratePoolsByPair(ctx, pairId, func(pool types.Pool) (stop bool, err error) {
-			if pool.Disabled {
+			if pool.Disabled || pool.OrdersPaused {
 				return false, nil
 			}
 			rx, ry := k.getPoolBalances(ctx, pool, pair)
 			ammPool := pool.AMMPool(rx.Amount, ry.Amount, sdk.Int{})
-			ob.AddOrder(amm.PoolOrders(ammPool, amm.DefaultOrderer, lowestPrice, highestPrice, int(tickPrec))...)
+			ob.AddOrder(amm.PoolOrders(ammPool, amm.DefaultOrderer, lowestPrice, highestPrice, tickPrec)...)
 			return false, nil
 		})
 
@@ -577,7 +819,7 @@ func (k Querier) OrderBooks(c context.Context, req *types.QueryOrderBooksRequest
 
 		pairs = append(
 			pairs, types.MakeOrderBookPairResponse(
-				pair.Id, ov, lowestPrice, highestPrice, int(tickPrec), configs...))
+				pair.Id, ov, lowestPrice, highestPrice, tickPrec, configs...))
 	}
 
 	return &types.QueryOrderBooksResponse{
@@ -606,3 +848,142 @@ func (k Querier) NumMMOrders(c context.Context, req *types.QueryNumMMOrdersReque
 
 	return &types.QueryNumMMOrdersResponse{NumMarketMakingOrders: numMMOrders}, nil
 }
+
+// BestSwapRoutes queries swap routes from the offer coin to the demand coin denom,
+// ranked by the expected output.
+func (k Querier) BestSwapRoutes(c context.Context, req *types.QueryBestSwapRoutesRequest) (*types.QueryBestSwapRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	offerCoin, err := sdk.ParseCoinNormalized(req.OfferCoin)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid offer coin: %v", err)
+	}
+
+	if err := sdk.ValidateDenom(req.DemandCoinDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid demand coin denom: %v", err)
+	}
+
+	if offerCoin.Denom == req.DemandCoinDenom {
+		return nil, status.Error(codes.InvalidArgument, "offer coin denom and demand coin denom must not be same")
+	}
+
+	maxRouteLen := int(req.MaxRouteLength)
+	if maxRouteLen == 0 {
+		maxRouteLen = types.DefaultQueryMaxSwapRouteLength
+	}
+	if maxRouteLen > types.MaxSwapRouteLength {
+		return nil, status.Errorf(codes.InvalidArgument, "max route length must not be greater than %d", types.MaxSwapRouteLength)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	routes := []types.SwapRouteResponse{}
+	for _, pairIds := range k.SwapRoutes(ctx, offerCoin.Denom, req.DemandCoinDenom, maxRouteLen) {
+		outCoin, priceImpact, fees, err := k.SimulateSwapRoute(ctx, pairIds, offerCoin)
+		if err != nil { // The route is not available now.
+			continue
+		}
+		routes = append(routes, types.SwapRouteResponse{
+			PairIds:     pairIds,
+			OutCoin:     outCoin,
+			PriceImpact: priceImpact,
+			Fees:        fees,
+		})
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		if !routes[i].OutCoin.Amount.Equal(routes[j].OutCoin.Amount) {
+			return routes[i].OutCoin.Amount.GT(routes[j].OutCoin.Amount)
+		}
+		return len(routes[i].PairIds) < len(routes[j].PairIds)
+	})
+
+	return &types.QueryBestSwapRoutesResponse{Routes: routes}, nil
+}
+
+// SimulateOrder queries the expected result of an order in the next batch.
+func (k Querier) SimulateOrder(c context.Context, req *types.QuerySimulateOrderRequest) (*types.QuerySimulateOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	if req.Direction != types.OrderDirectionBuy && req.Direction != types.OrderDirectionSell {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order direction: %s", req.Direction)
+	}
+
+	amt, ok := sdk.NewIntFromString(req.Amount)
+	if !ok || !amt.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %s", req.Amount)
+	}
+
+	typ := types.OrderTypeMarket
+	var price *sdk.Dec
+	if req.Price != "" {
+		p, err := sdk.NewDecFromStr(req.Price)
+		if err != nil || !p.IsPositive() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid price: %s", req.Price)
+		}
+		typ = types.OrderTypeLimit
+		price = &p
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, found := k.GetPair(ctx, req.PairId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pair %d doesn't exist", req.PairId)
+	}
+
+	orderPrice, err := k.orderPrice(ctx, pair, typ, req.Direction, price)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validateOrderSize(pair, amt, orderPrice); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var offerCoin sdk.Coin
+	switch req.Direction {
+	case types.OrderDirectionBuy:
+		offerCoin = sdk.NewCoin(pair.QuoteCoinDenom, amm.OfferCoinAmount(amm.Buy, orderPrice, amt))
+	case types.OrderDirectionSell:
+		offerCoin = sdk.NewCoin(pair.BaseCoinDenom, amt)
+	}
+	// The simulated order gets the next order id, so that it comes after
+	// the orders already placed at the same price.
+	// The orderer doesn't matter for the matching.
+	order := types.NewOrder(
+		typ, pair.LastOrderId+1, pair, types.GlobalEscrowAddress, offerCoin, orderPrice, amt,
+		ctx.BlockTime(), ctx.BlockHeight())
+	order.TimeInForce = req.TimeInForce
+
+	userOrder, matchPrice, matched, err := k.Keeper.SimulateOrder(ctx, pair, order)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	offerCoinDenom, demandCoinDenom := userOrder.OfferCoinDenom, userOrder.DemandCoinDenom
+
+	// The simulated order is matched in the batch it's placed, so it's a taker.
+	_, takerFeeRate := k.GetPairFeeRates(pair)
+	feeAmt := takerFeeRate.MulInt(userOrder.ReceivedDemandCoinAmount).TruncateInt()
+
+	resp := &types.QuerySimulateOrderResponse{
+		Matched:       matched,
+		MatchedAmount: userOrder.Amount.Sub(userOrder.GetOpenAmount()),
+		PaidCoin:      sdk.NewCoin(offerCoinDenom, userOrder.PaidOfferCoinAmount),
+		ReceivedCoin:  sdk.NewCoin(demandCoinDenom, userOrder.ReceivedDemandCoinAmount.Sub(feeAmt)),
+		LastPrice:     pair.LastPrice,
+		Fee:           sdk.NewCoin(demandCoinDenom, feeAmt),
+	}
+	if matched {
+		resp.MatchPrice = &matchPrice
+		resp.LastPrice = &matchPrice
+	}
+
+	return resp, nil
+}
@@ -1143,3 +1143,279 @@ func (s *KeeperTestSuite) TestGRPCQueryNumMMOrders() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCBestSwapRoutes() {
+	pair1 := s.createPairWithLastPrice("denom1", "denom2", utils.ParseDec("1.0"))
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair2 := s.createPairWithLastPrice("denom3", "denom2", utils.ParseDec("2.0"))
+	s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom3,2000000000denom2"), true)
+	// The direct pair has much less liquidity.
+	pair3 := s.createPairWithLastPrice("denom1", "denom3", utils.ParseDec("0.5"))
+	s.createPool(s.addr(0), pair3.Id, utils.ParseCoins("20000000denom1,10000000denom3"), true)
+
+	takerFeeRate := utils.ParseDec("0.003")
+	s.handleProposal(types.NewPairFeeRatesProposal(
+		"Change pair fee rates", "Description",
+		[]types.PairFeeRatesChange{
+			types.NewPairFeeRatesChange(pair1.Id, nil, &takerFeeRate),
+			types.NewPairFeeRatesChange(pair2.Id, nil, &takerFeeRate),
+			types.NewPairFeeRatesChange(pair3.Id, nil, &takerFeeRate),
+		}))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryBestSwapRoutesRequest
+		expectErr bool
+		postRun   func(resp *types.QueryBestSwapRoutesResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid offer coin",
+			&types.QueryBestSwapRoutesRequest{
+				OfferCoin:       "invalid",
+				DemandCoinDenom: "denom3",
+			},
+			true,
+			nil,
+		},
+		{
+			"same denoms",
+			&types.QueryBestSwapRoutesRequest{
+				OfferCoin:       "1000000denom1",
+				DemandCoinDenom: "denom1",
+			},
+			true,
+			nil,
+		},
+		{
+			"too long max route length",
+			&types.QueryBestSwapRoutesRequest{
+				OfferCoin:       "1000000denom1",
+				DemandCoinDenom: "denom3",
+				MaxRouteLength:  6,
+			},
+			true,
+			nil,
+		},
+		{
+			"happy case",
+			&types.QueryBestSwapRoutesRequest{
+				OfferCoin:       "1000000denom1",
+				DemandCoinDenom: "denom3",
+			},
+			false,
+			func(resp *types.QueryBestSwapRoutesResponse) {
+				s.Require().Len(resp.Routes, 2)
+				s.Require().Equal([]uint64{pair1.Id, pair2.Id}, resp.Routes[0].PairIds)
+				s.Require().Equal([]uint64{pair3.Id}, resp.Routes[1].PairIds)
+				s.Require().True(resp.Routes[0].OutCoin.Amount.GT(resp.Routes[1].OutCoin.Amount))
+				s.Require().True(resp.Routes[0].PriceImpact.LT(resp.Routes[1].PriceImpact))
+				s.Require().True(coinsEq(utils.ParseCoins("2996denom2,1492denom3"), resp.Routes[0].Fees))
+				s.Require().True(coinsEq(utils.ParseCoins("1428denom3"), resp.Routes[1].Fees))
+				s.Require().True(coinEq(utils.ParseCoin("496025denom3"), resp.Routes[0].OutCoin))
+			},
+		},
+		{
+			"single hop only",
+			&types.QueryBestSwapRoutesRequest{
+				OfferCoin:       "1000000denom1",
+				DemandCoinDenom: "denom3",
+				MaxRouteLength:  1,
+			},
+			false,
+			func(resp *types.QueryBestSwapRoutesResponse) {
+				s.Require().Len(resp.Routes, 1)
+				s.Require().Equal([]uint64{pair3.Id}, resp.Routes[0].PairIds)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.BestSwapRoutes(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCSimulateOrder() {
+	pair := s.createPairWithLastPrice("denom1", "denom2", utils.ParseDec("1.0"))
+	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.001"), sdk.NewInt(100000), time.Hour, true)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QuerySimulateOrderRequest
+		expectErr bool
+		postRun   func(resp *types.QuerySimulateOrderResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid direction",
+			&types.QuerySimulateOrderRequest{
+				PairId: pair.Id,
+				Amount: "1000000",
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid amount",
+			&types.QuerySimulateOrderRequest{
+				PairId:    pair.Id,
+				Direction: types.OrderDirectionBuy,
+				Amount:    "-1",
+			},
+			true,
+			nil,
+		},
+		{
+			"pair not found",
+			&types.QuerySimulateOrderRequest{
+				PairId:    10,
+				Direction: types.OrderDirectionBuy,
+				Amount:    "1000000",
+			},
+			true,
+			nil,
+		},
+		{
+			"price out of range",
+			&types.QuerySimulateOrderRequest{
+				PairId:    pair.Id,
+				Direction: types.OrderDirectionBuy,
+				Price:     "2.0",
+				Amount:    "1000000",
+			},
+			true,
+			nil,
+		},
+		{
+			"not matched",
+			&types.QuerySimulateOrderRequest{
+				PairId:    pair.Id,
+				Direction: types.OrderDirectionBuy,
+				Price:     "0.99",
+				Amount:    "1000000",
+			},
+			false,
+			func(resp *types.QuerySimulateOrderResponse) {
+				s.Require().False(resp.Matched)
+				s.Require().Nil(resp.MatchPrice)
+				s.Require().True(resp.MatchedAmount.IsZero())
+				s.Require().True(decEq(utils.ParseDec("1.0"), *resp.LastPrice))
+			},
+		},
+		{
+			"happy case",
+			&types.QuerySimulateOrderRequest{
+				PairId:    pair.Id,
+				Direction: types.OrderDirectionBuy,
+				Amount:    "1000000",
+			},
+			false,
+			func(resp *types.QuerySimulateOrderResponse) {
+				s.Require().True(resp.Matched)
+				s.Require().True(intEq(sdk.NewInt(1000000), resp.MatchedAmount))
+				s.Require().True(coinEq(utils.ParseCoin("1000000denom1"), resp.ReceivedCoin))
+				s.Require().True(resp.MatchPrice.GT(utils.ParseDec("1.0")))
+				s.Require().True(decEq(*resp.MatchPrice, *resp.LastPrice))
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.SimulateOrder(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+
+	// Nothing has been written to the state.
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(decEq(utils.ParseDec("1.0"), *pair.LastPrice))
+	s.Require().Len(s.keeper.GetAllOrders(s.ctx), 1)
+
+	// The simulation result is the same as the actual result.
+	resp, err := s.querier.SimulateOrder(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateOrderRequest{
+		PairId:    pair.Id,
+		Direction: types.OrderDirectionBuy,
+		Amount:    "1000000",
+	})
+	s.Require().NoError(err)
+	order := s.buyMarketOrder(s.addr(2), pair.Id, sdk.NewInt(1000000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().True(coinEq(resp.ReceivedCoin, order.ReceivedCoin))
+	s.Require().True(coinEq(resp.PaidCoin, order.OfferCoin.Sub(order.RemainingOfferCoin)))
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(decEq(*resp.LastPrice, *pair.LastPrice))
+}
+
+func (s *KeeperTestSuite) TestGRPCSimulateOrder_OrdersFirst() {
+	pair := s.createPairWithLastPrice("denom1", "denom2", utils.ParseDec("1.0"))
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10001), time.Hour, true)
+
+	// The simulated order comes after the orders already placed at the same
+	// price, so the dust amount goes to the buy order placed first.
+	resp, err := s.querier.SimulateOrder(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateOrderRequest{
+		PairId:    pair.Id,
+		Direction: types.OrderDirectionBuy,
+		Price:     "1.0",
+		Amount:    "10000",
+	})
+	s.Require().NoError(err)
+	s.Require().True(resp.Matched)
+	s.Require().True(intEq(sdk.NewInt(5000), resp.MatchedAmount))
+
+	// A fill-or-kill order which can't be fully matched isn't matched at all.
+	pair2 := s.createPairWithLastPrice("denom3", "denom2", utils.ParseDec("1.0"))
+	s.sellLimitOrder(s.addr(2), pair2.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour, true)
+	resp, err = s.querier.SimulateOrder(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateOrderRequest{
+		PairId:    pair2.Id,
+		Direction: types.OrderDirectionBuy,
+		Price:     "1.0",
+		Amount:    "20000",
+	})
+	s.Require().NoError(err)
+	s.Require().True(intEq(sdk.NewInt(10000), resp.MatchedAmount))
+	resp, err = s.querier.SimulateOrder(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateOrderRequest{
+		PairId:      pair2.Id,
+		Direction:   types.OrderDirectionBuy,
+		Price:       "1.0",
+		Amount:      "20000",
+		TimeInForce: types.TimeInForceFillOrKill,
+	})
+	s.Require().NoError(err)
+	s.Require().False(resp.Matched)
+	s.Require().True(resp.MatchedAmount.IsZero())
+	s.Require().True(resp.PaidCoin.IsZero())
+
+	// Orders can't be simulated while the pair's orders are paused.
+	s.handleProposal(types.NewPoolManagementProposal(
+		"Pause pair", "Description",
+		[]types.PairStatusChange{types.NewPairStatusChange(pair.Id, false, true)}, nil, nil))
+	_, err = s.querier.SimulateOrder(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateOrderRequest{
+		PairId:    pair.Id,
+		Direction: types.OrderDirectionBuy,
+		Amount:    "10000",
+	})
+	s.Require().Error(err)
+}
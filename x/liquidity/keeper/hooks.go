@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+var _ types.LiquidityHooks = Keeper{}
+
+// AfterPoolCreated - call hook if registered
+func (k Keeper) AfterPoolCreated(ctx sdk.Context, pool types.Pool) {
+	if k.hooks != nil {
+		k.hooks.AfterPoolCreated(ctx, pool)
+	}
+}
+
+// AfterOrderPlaced - call hook if registered
+func (k Keeper) AfterOrderPlaced(ctx sdk.Context, order types.Order) {
+	if k.hooks != nil {
+		k.hooks.AfterOrderPlaced(ctx, order)
+	}
+}
+
+// AfterOrderMatched - call hook if registered
+func (k Keeper) AfterOrderMatched(ctx sdk.Context, order types.Order, paidCoin, receivedCoin sdk.Coin) {
+	if k.hooks != nil {
+		k.hooks.AfterOrderMatched(ctx, order, paidCoin, receivedCoin)
+	}
+}
+
+// AfterDepositExecuted - call hook if registered
+func (k Keeper) AfterDepositExecuted(ctx sdk.Context, req types.DepositRequest) {
+	if k.hooks != nil {
+		k.hooks.AfterDepositExecuted(ctx, req)
+	}
+}
+
+// AfterWithdrawExecuted - call hook if registered
+func (k Keeper) AfterWithdrawExecuted(ctx sdk.Context, req types.WithdrawRequest) {
+	if k.hooks != nil {
+		k.hooks.AfterWithdrawExecuted(ctx, req)
+	}
+}
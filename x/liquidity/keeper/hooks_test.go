@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/liquidity/keeper"
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+var _ types.LiquidityHooks = &mockLiquidityHooks{}
+
+type mockLiquidityHooks struct {
+	createdPoolIds   []uint64
+	placedOrderIds   []uint64
+	matchedOrders    []types.Order
+	depositRequests  []types.DepositRequest
+	withdrawRequests []types.WithdrawRequest
+}
+
+func (h *mockLiquidityHooks) AfterPoolCreated(_ sdk.Context, pool types.Pool) {
+	h.createdPoolIds = append(h.createdPoolIds, pool.Id)
+}
+
+func (h *mockLiquidityHooks) AfterOrderPlaced(_ sdk.Context, order types.Order) {
+	h.placedOrderIds = append(h.placedOrderIds, order.Id)
+}
+
+func (h *mockLiquidityHooks) AfterOrderMatched(_ sdk.Context, order types.Order, paidCoin, receivedCoin sdk.Coin) {
+	if !paidCoin.IsPositive() || !receivedCoin.IsPositive() {
+		panic("non-positive matched coins")
+	}
+	h.matchedOrders = append(h.matchedOrders, order)
+}
+
+func (h *mockLiquidityHooks) AfterDepositExecuted(_ sdk.Context, req types.DepositRequest) {
+	h.depositRequests = append(h.depositRequests, req)
+}
+
+func (h *mockLiquidityHooks) AfterWithdrawExecuted(_ sdk.Context, req types.WithdrawRequest) {
+	h.withdrawRequests = append(h.withdrawRequests, req)
+}
+
+// setMockHooks replaces the suite's keeper with a keeper which has
+// mock hooks registered, and returns the hooks.
+func (s *KeeperTestSuite) setMockHooks() *mockLiquidityHooks {
+	hooks := &mockLiquidityHooks{}
+	k := keeper.NewKeeper(
+		s.app.AppCodec(), s.app.GetKey(types.StoreKey), s.app.GetSubspace(types.ModuleName),
+		s.app.AccountKeeper, s.app.BankKeeper, types.DefaultConfig())
+	k.SetHooks(types.NewMultiLiquidityHooks(hooks))
+	s.keeper = k
+	return hooks
+}
+
+func (s *KeeperTestSuite) TestHooks() {
+	hooks := s.setMockHooks()
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.Require().Equal([]uint64{pool.Id}, hooks.createdPoolIds)
+
+	s.deposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	order1 := s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour, true)
+	order2 := s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour, true)
+	s.Require().Equal([]uint64{order1.Id, order2.Id}, hooks.placedOrderIds)
+
+	s.keeper.ExecuteRequests(s.ctx)
+	s.Require().Len(hooks.matchedOrders, 2)
+	for _, order := range hooks.matchedOrders {
+		s.Require().Equal(types.OrderStatusCompleted, order.Status)
+	}
+	s.Require().Len(hooks.depositRequests, 1)
+	s.Require().Equal(types.RequestStatusSucceeded, hooks.depositRequests[0].Status)
+	s.Require().True(hooks.depositRequests[0].MintedPoolCoin.IsPositive())
+
+	poolCoin := s.getBalance(s.addr(1), pool.PoolCoinDenom)
+	s.withdraw(s.addr(1), pool.Id, poolCoin)
+	s.keeper.ExecuteRequests(s.ctx)
+	s.Require().Len(hooks.withdrawRequests, 1)
+	s.Require().Equal(types.RequestStatusSucceeded, hooks.withdrawRequests[0].Status)
+	s.Require().True(hooks.withdrawRequests[0].WithdrawnCoins.IsAllPositive())
+}
+
+func (s *KeeperTestSuite) TestHooks_BatchOrders() {
+	hooks := s.setMockHooks()
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.fundAddr(s.addr(1), utils.ParseCoins("1000000denom1,1000000denom2"))
+	orders, err := s.keeper.BatchOrders(s.ctx, types.NewMsgBatchOrders(
+		s.addr(1), pair.Id, types.OrderTypeLimit, []types.BatchOrderEntry{
+			types.NewBatchOrderEntry(types.OrderDirectionBuy, utils.ParseDec("0.99"), sdk.NewInt(10000)),
+			types.NewBatchOrderEntry(types.OrderDirectionBuy, utils.ParseDec("0.98"), sdk.NewInt(10000)),
+			types.NewBatchOrderEntry(types.OrderDirectionSell, utils.ParseDec("1.01"), sdk.NewInt(10000)),
+		}, time.Hour))
+	s.Require().NoError(err)
+
+	// The hook is called once per order.
+	s.Require().Len(hooks.placedOrderIds, len(orders))
+	for i, order := range orders {
+		s.Require().Equal(order.Id, hooks.placedOrderIds[i])
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/crescent-network/crescent/v5/x/liquidity/amm"
 	"github.com/crescent-network/crescent/v5/x/liquidity/types"
 )
 
@@ -14,7 +15,9 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "pool-coin-escrow", PoolCoinEscrowInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "remaining-offer-coin-escrow", RemainingOfferCoinEscrowInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "pool-status", PoolStatusInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pool-weights", PoolWeightsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "num-mm-orders", NumMMOrdersInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "swap-coins-escrow", SwapCoinsEscrowInvariant(k))
 }
 
 // AllInvariants returns a combined invariant of the liquidity module.
@@ -25,7 +28,9 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			PoolCoinEscrowInvariant,
 			RemainingOfferCoinEscrowInvariant,
 			PoolStatusInvariant,
+			PoolWeightsInvariant,
 			NumMMOrdersInvariant,
+			SwapCoinsEscrowInvariant,
 		} {
 			res, stop := inv(k)(ctx)
 			if stop {
@@ -38,7 +43,7 @@ func AllInvariants(k Keeper) sdk.Invariant {
 
 // DepositCoinsEscrowInvariant checks that the amount of coins in the global
 // escrow address is greater or equal than remaining deposit coins in all
-// deposit requests.
+// deposit requests and single-sided deposit requests.
 func DepositCoinsEscrowInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		escrowDepositCoins := sdk.Coins{}
@@ -48,6 +53,12 @@ func DepositCoinsEscrowInvariant(k Keeper) sdk.Invariant {
 			}
 			return false, nil
 		})
+		_ = k.IterateAllSingleSidedDepositRequests(ctx, func(req types.SingleSidedDepositRequest) (stop bool, err error) {
+			if req.Status == types.RequestStatusNotExecuted {
+				escrowDepositCoins = escrowDepositCoins.Add(req.DepositCoin)
+			}
+			return false, nil
+		})
 		balances := k.bankKeeper.SpendableCoins(ctx, types.GlobalEscrowAddress)
 		broken := !balances.IsAllGTE(escrowDepositCoins)
 		return sdk.FormatInvariant(
@@ -78,6 +89,27 @@ func PoolCoinEscrowInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// SwapCoinsEscrowInvariant checks that the amount of coins in the global
+// escrow address is greater or equal than offer coins in all swap requests
+// not executed yet.
+func SwapCoinsEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		escrowOfferCoins := sdk.Coins{}
+		_ = k.IterateAllSwapRequests(ctx, func(req types.SwapRequest) (stop bool, err error) {
+			if req.Status == types.RequestStatusNotExecuted {
+				escrowOfferCoins = escrowOfferCoins.Add(req.OfferCoin)
+			}
+			return false, nil
+		})
+		balances := k.bankKeeper.SpendableCoins(ctx, types.GlobalEscrowAddress)
+		broken := !balances.IsAllGTE(escrowOfferCoins)
+		return sdk.FormatInvariant(
+			types.ModuleName, "swap-coins-escrow",
+			fmt.Sprintf("escrow amount %s is smaller than expected %s", balances, escrowOfferCoins),
+		), broken
+	}
+}
+
 // RemainingOfferCoinEscrowInvariant checks that the amount of coins in each pair's
 // escrow address is greater or equal than remaining offer coins in the pair's
 // orders.
@@ -111,7 +143,7 @@ func RemainingOfferCoinEscrowInvariant(k Keeper) sdk.Invariant {
 }
 
 // PoolStatusInvariant checks that the pools with zero pool coin supply have
-// been marked as disabled.
+// been marked as disabled, except for concentrated pools.
 func PoolStatusInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		var (
@@ -119,7 +151,8 @@ func PoolStatusInvariant(k Keeper) sdk.Invariant {
 			msg   string
 		)
 		_ = k.IterateAllPools(ctx, func(pool types.Pool) (stop bool, err error) {
-			if !pool.Disabled {
+			// Concentrated pools have no pool coin.
+			if !pool.Disabled && pool.Type != types.PoolTypeConcentrated {
 				ps := k.GetPoolCoinSupply(ctx, pool)
 				if ps.IsZero() {
 					count++
@@ -136,6 +169,34 @@ func PoolStatusInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// PoolWeightsInvariant checks that the weighted pools have valid coin weights
+// and the other pools have no coin weights.
+func PoolWeightsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			count int
+			msg   string
+		)
+		_ = k.IterateAllPools(ctx, func(pool types.Pool) (stop bool, err error) {
+			if pool.Type == types.PoolTypeWeighted {
+				if err := amm.ValidateWeightedPoolWeights(pool.QuoteCoinWeight, pool.BaseCoinWeight); err != nil {
+					count++
+					msg += fmt.Sprintf("\tpool %d has invalid weights: %v\n", pool.Id, err)
+				}
+			} else if pool.BaseCoinWeight != 0 || pool.QuoteCoinWeight != 0 {
+				count++
+				msg += fmt.Sprintf("\tpool %d is not a weighted pool, but has weights\n", pool.Id)
+			}
+			return false, nil
+		})
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "pool-weights",
+			fmt.Sprintf("%d pool(s) with wrong weights found\n%s", count, msg),
+		), broken
+	}
+}
+
 // NumMMOrdersInvariant checks the actual number of mm orders with NumMMOrders
 // records.
 func NumMMOrdersInvariant(k Keeper) sdk.Invariant {
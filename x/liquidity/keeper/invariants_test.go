@@ -31,6 +31,28 @@ func (s *KeeperTestSuite) TestDepositCoinsEscrowInvariant() {
 	s.Require().False(broken)
 }
 
+func (s *KeeperTestSuite) TestSwapCoinsEscrowInvariant() {
+	pair := s.createPairWithLastPrice("denom1", "denom2", utils.ParseDec("1.0"))
+	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+
+	req := s.swapExactIn(
+		s.addr(1), []uint64{pair.Id}, utils.ParseCoin("1000000denom1"), utils.ParseCoin("1denom2"), true)
+	_, broken := keeper.SwapCoinsEscrowInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	oldReq := req
+	req.OfferCoin = utils.ParseCoin("2000000denom1")
+	s.keeper.SetSwapRequest(s.ctx, req)
+	_, broken = keeper.SwapCoinsEscrowInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+
+	req = oldReq
+	s.keeper.SetSwapRequest(s.ctx, req)
+	s.nextBlock()
+	_, broken = keeper.SwapCoinsEscrowInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+}
+
 func (s *KeeperTestSuite) TestPoolCoinEscrowInvariant() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
@@ -95,6 +117,28 @@ func (s *KeeperTestSuite) TestPoolStatusInvariant() {
 	s.Require().True(broken)
 }
 
+func (s *KeeperTestSuite) TestPoolWeightsInvariant() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	basicPool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	weightedPool := s.createWeightedPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,4000000denom2"), 20, 80, true)
+
+	_, broken := keeper.PoolWeightsInvariant(s.keeper)(s.ctx)
+	s.Require().False(broken)
+
+	weightedPool.QuoteCoinWeight = 30
+	s.keeper.SetPool(s.ctx, weightedPool)
+	_, broken = keeper.PoolWeightsInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+
+	weightedPool.QuoteCoinWeight = 80
+	s.keeper.SetPool(s.ctx, weightedPool)
+	basicPool.BaseCoinWeight = 50
+	basicPool.QuoteCoinWeight = 50
+	s.keeper.SetPool(s.ctx, basicPool)
+	_, broken = keeper.PoolWeightsInvariant(s.keeper)(s.ctx)
+	s.Require().True(broken)
+}
+
 func (s *KeeperTestSuite) TestNumMMOrdersInvariant() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 
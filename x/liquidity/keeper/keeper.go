@@ -19,6 +19,9 @@ type Keeper struct {
 
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
+
+	config types.Config
+	hooks  types.LiquidityHooks
 }
 
 // NewKeeper creates a new liquidity Keeper instance.
@@ -28,6 +31,7 @@ func NewKeeper(
 	paramSpace paramstypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	config types.Config,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -39,7 +43,17 @@ func NewKeeper(
 		paramSpace:    paramSpace,
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
+		config:        config,
+	}
+}
+
+// SetHooks sets the liquidity hooks.
+func (k *Keeper) SetHooks(lh types.LiquidityHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set liquidity hooks twice")
 	}
+	k.hooks = lh
+	return k
 }
 
 // Logger returns a module-specific logger.
@@ -9,10 +9,12 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	chain "github.com/crescent-network/crescent/v5/app"
 	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/liquidity"
 	"github.com/crescent-network/crescent/v5/x/liquidity/amm"
 	"github.com/crescent-network/crescent/v5/x/liquidity/keeper"
 	"github.com/crescent-network/crescent/v5/x/liquidity/types"
@@ -21,11 +23,12 @@ import (
 type KeeperTestSuite struct {
 	suite.Suite
 
-	app       *chain.App
-	ctx       sdk.Context
-	keeper    keeper.Keeper
-	querier   keeper.Querier
-	msgServer types.MsgServer
+	app        *chain.App
+	ctx        sdk.Context
+	keeper     keeper.Keeper
+	querier    keeper.Querier
+	msgServer  types.MsgServer
+	govHandler govtypes.Handler
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -44,6 +47,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.keeper = s.app.LiquidityKeeper
 	s.querier = keeper.Querier{Keeper: s.keeper}
 	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.govHandler = liquidity.NewProposalHandler(s.keeper)
 }
 
 // Below are just shortcuts to frequently-used functions.
@@ -125,6 +129,54 @@ func (s *KeeperTestSuite) createRangedPool(creator sdk.AccAddress, pairId uint64
 	return pool
 }
 
+func (s *KeeperTestSuite) createStablePool(creator sdk.AccAddress, pairId uint64, depositCoins sdk.Coins, amp uint32, fund bool) types.Pool {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(creator, depositCoins.Add(s.keeper.GetPoolCreationFee(s.ctx)...))
+	}
+	msg := types.NewMsgCreateStablePool(creator, pairId, depositCoins, amp)
+	s.Require().NoError(msg.ValidateBasic())
+	pool, err := s.keeper.CreateStablePool(s.ctx, msg)
+	s.Require().NoError(err)
+	return pool
+}
+
+func (s *KeeperTestSuite) createWeightedPool(creator sdk.AccAddress, pairId uint64, depositCoins sdk.Coins, baseCoinWeight, quoteCoinWeight uint32, fund bool) types.Pool {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(creator, depositCoins.Add(s.keeper.GetPoolCreationFee(s.ctx)...))
+	}
+	msg := types.NewMsgCreateWeightedPool(creator, pairId, depositCoins, baseCoinWeight, quoteCoinWeight)
+	s.Require().NoError(msg.ValidateBasic())
+	pool, err := s.keeper.CreateWeightedPool(s.ctx, msg)
+	s.Require().NoError(err)
+	return pool
+}
+
+func (s *KeeperTestSuite) createConcentratedPool(creator sdk.AccAddress, pairId uint64, initialPrice sdk.Dec, fund bool) types.Pool {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(creator, s.keeper.GetPoolCreationFee(s.ctx))
+	}
+	msg := types.NewMsgCreateConcentratedPool(creator, pairId, initialPrice)
+	s.Require().NoError(msg.ValidateBasic())
+	pool, err := s.keeper.CreateConcentratedPool(s.ctx, msg)
+	s.Require().NoError(err)
+	return pool
+}
+
+func (s *KeeperTestSuite) openPosition(owner sdk.AccAddress, poolId uint64, lowerPrice, upperPrice sdk.Dec, depositCoins sdk.Coins, fund bool) types.Position {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(owner, depositCoins)
+	}
+	msg := types.NewMsgOpenPosition(owner, poolId, lowerPrice, upperPrice, depositCoins)
+	s.Require().NoError(msg.ValidateBasic())
+	position, err := s.keeper.OpenPosition(s.ctx, msg)
+	s.Require().NoError(err)
+	return position
+}
+
 func (s *KeeperTestSuite) deposit(depositor sdk.AccAddress, poolId uint64, depositCoins sdk.Coins, fund bool) types.DepositRequest {
 	s.T().Helper()
 	if fund {
@@ -135,6 +187,17 @@ func (s *KeeperTestSuite) deposit(depositor sdk.AccAddress, poolId uint64, depos
 	return req
 }
 
+func (s *KeeperTestSuite) depositSingleSided(
+	depositor sdk.AccAddress, poolId uint64, depositCoin, minMintedPoolCoin sdk.Coin, fund bool) types.SingleSidedDepositRequest {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(depositor, sdk.NewCoins(depositCoin))
+	}
+	req, err := s.keeper.DepositSingleSided(s.ctx, types.NewMsgDepositSingleSided(depositor, poolId, depositCoin, minMintedPoolCoin))
+	s.Require().NoError(err)
+	return req
+}
+
 func (s *KeeperTestSuite) withdraw(withdrawer sdk.AccAddress, poolId uint64, poolCoin sdk.Coin) types.WithdrawRequest {
 	s.T().Helper()
 	req, err := s.keeper.Withdraw(s.ctx, types.NewMsgWithdraw(withdrawer, poolId, poolCoin))
@@ -142,6 +205,14 @@ func (s *KeeperTestSuite) withdraw(withdrawer sdk.AccAddress, poolId uint64, poo
 	return req
 }
 
+func (s *KeeperTestSuite) withdrawSingleSided(
+	withdrawer sdk.AccAddress, poolId uint64, poolCoin, minWithdrawnCoin sdk.Coin) types.WithdrawRequest {
+	s.T().Helper()
+	req, err := s.keeper.WithdrawSingleSided(s.ctx, types.NewMsgWithdrawSingleSided(withdrawer, poolId, poolCoin, minWithdrawnCoin))
+	s.Require().NoError(err)
+	return req
+}
+
 func (s *KeeperTestSuite) limitOrder(
 	orderer sdk.AccAddress, pairId uint64, dir types.OrderDirection,
 	price sdk.Dec, amt sdk.Int, orderLifespan time.Duration, fund bool) types.Order {
@@ -263,6 +334,19 @@ func (s *KeeperTestSuite) mmOrder(
 	return req
 }
 
+func (s *KeeperTestSuite) swapExactIn(
+	orderer sdk.AccAddress, pairIds []uint64, offerCoin, minOutCoin sdk.Coin, fund bool) types.SwapRequest {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(orderer, sdk.NewCoins(offerCoin))
+	}
+	msg := types.NewMsgSwapExactIn(orderer, pairIds, offerCoin, minOutCoin)
+	s.Require().NoError(msg.ValidateBasic())
+	req, err := s.keeper.SwapExactIn(s.ctx, msg)
+	s.Require().NoError(err)
+	return req
+}
+
 // nolint
 func (s *KeeperTestSuite) cancelOrder(orderer sdk.AccAddress, pairId, orderId uint64) {
 	s.T().Helper()
@@ -276,6 +360,12 @@ func (s *KeeperTestSuite) cancelAllOrders(orderer sdk.AccAddress, pairIds []uint
 	s.Require().NoError(err)
 }
 
+func (s *KeeperTestSuite) handleProposal(content govtypes.Content) {
+	s.T().Helper()
+	s.Require().NoError(content.ValidateBasic())
+	s.Require().NoError(s.govHandler(s.ctx, content))
+}
+
 func coinEq(exp, got sdk.Coin) (bool, string, string, string) {
 	return exp.IsEqual(got), "expected:\t%v\ngot:\t\t%v", exp.String(), got.String()
 }
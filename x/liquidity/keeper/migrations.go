@@ -6,6 +6,8 @@ import (
 	v2 "github.com/crescent-network/crescent/v5/x/liquidity/legacy/v2"
 	v3 "github.com/crescent-network/crescent/v5/x/liquidity/legacy/v3"
 	v4 "github.com/crescent-network/crescent/v5/x/liquidity/legacy/v4"
+	v5 "github.com/crescent-network/crescent/v5/x/liquidity/legacy/v5"
+	v6 "github.com/crescent-network/crescent/v5/x/liquidity/legacy/v6"
 )
 
 type Migrator struct {
@@ -27,3 +29,11 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.paramSpace)
 }
+
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -52,6 +52,73 @@ func (m msgServer) CreateRangedPool(goCtx context.Context, msg *types.MsgCreateR
 	return &types.MsgCreateRangedPoolResponse{}, nil
 }
 
+// CreateStablePool defines a method to create a stable pool.
+func (m msgServer) CreateStablePool(goCtx context.Context, msg *types.MsgCreateStablePool) (*types.MsgCreateStablePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.CreateStablePool(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateStablePoolResponse{}, nil
+}
+
+// CreateWeightedPool defines a method to create a weighted pool.
+func (m msgServer) CreateWeightedPool(goCtx context.Context, msg *types.MsgCreateWeightedPool) (*types.MsgCreateWeightedPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.CreateWeightedPool(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateWeightedPoolResponse{}, nil
+}
+
+// CreateConcentratedPool defines a method to create a concentrated liquidity pool.
+func (m msgServer) CreateConcentratedPool(goCtx context.Context, msg *types.MsgCreateConcentratedPool) (*types.MsgCreateConcentratedPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.CreateConcentratedPool(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateConcentratedPoolResponse{}, nil
+}
+
+// OpenPosition defines a method to open a position in a concentrated pool.
+func (m msgServer) OpenPosition(goCtx context.Context, msg *types.MsgOpenPosition) (*types.MsgOpenPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	position, err := m.Keeper.OpenPosition(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgOpenPositionResponse{PositionId: position.Id}, nil
+}
+
+// ClosePosition defines a method to close a position.
+func (m msgServer) ClosePosition(goCtx context.Context, msg *types.MsgClosePosition) (*types.MsgClosePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.ClosePosition(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClosePositionResponse{}, nil
+}
+
+// CollectFees defines a method to collect fees accrued to a position.
+func (m msgServer) CollectFees(goCtx context.Context, msg *types.MsgCollectFees) (*types.MsgCollectFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.CollectFees(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCollectFeesResponse{}, nil
+}
+
 // Deposit defines a method to deposit coins to the pool.
 func (m msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types.MsgDepositResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -63,6 +130,17 @@ func (m msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 	return &types.MsgDepositResponse{}, nil
 }
 
+// DepositSingleSided defines a method to deposit only one coin to the pool.
+func (m msgServer) DepositSingleSided(goCtx context.Context, msg *types.MsgDepositSingleSided) (*types.MsgDepositSingleSidedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.DepositSingleSided(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositSingleSidedResponse{}, nil
+}
+
 // Withdraw defines a method to withdraw pool coin from the pool.
 func (m msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*types.MsgWithdrawResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -74,6 +152,18 @@ func (m msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 	return &types.MsgWithdrawResponse{}, nil
 }
 
+// WithdrawSingleSided defines a method to withdraw pool coin from the pool
+// and receive only one coin.
+func (m msgServer) WithdrawSingleSided(goCtx context.Context, msg *types.MsgWithdrawSingleSided) (*types.MsgWithdrawSingleSidedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.WithdrawSingleSided(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawSingleSidedResponse{}, nil
+}
+
 // LimitOrder defines a method to make a limit order.
 func (m msgServer) LimitOrder(goCtx context.Context, msg *types.MsgLimitOrder) (*types.MsgLimitOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -107,6 +197,39 @@ func (m msgServer) MMOrder(goCtx context.Context, msg *types.MsgMMOrder) (*types
 	return &types.MsgMMOrderResponse{}, nil
 }
 
+// BatchOrders defines a method for making multiple limit or MM orders at once.
+func (m msgServer) BatchOrders(goCtx context.Context, msg *types.MsgBatchOrders) (*types.MsgBatchOrdersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.BatchOrders(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBatchOrdersResponse{}, nil
+}
+
+// TriggerOrder defines a method to make a trigger order.
+func (m msgServer) TriggerOrder(goCtx context.Context, msg *types.MsgTriggerOrder) (*types.MsgTriggerOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.TriggerOrder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTriggerOrderResponse{}, nil
+}
+
+// SwapExactIn defines a method to swap an exact amount of coin through multiple pairs.
+func (m msgServer) SwapExactIn(goCtx context.Context, msg *types.MsgSwapExactIn) (*types.MsgSwapExactInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.SwapExactIn(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapExactInResponse{}, nil
+}
+
 // CancelOrder defines a method to cancel an order.
 func (m msgServer) CancelOrder(goCtx context.Context, msg *types.MsgCancelOrder) (*types.MsgCancelOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -118,6 +241,17 @@ func (m msgServer) CancelOrder(goCtx context.Context, msg *types.MsgCancelOrder)
 	return &types.MsgCancelOrderResponse{}, nil
 }
 
+// ReplaceOrder defines a method to replace an order with a new one.
+func (m msgServer) ReplaceOrder(goCtx context.Context, msg *types.MsgReplaceOrder) (*types.MsgReplaceOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.ReplaceOrder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReplaceOrderResponse{}, nil
+}
+
 // CancelAllOrders defines a method to cancel all orders.
 func (m msgServer) CancelAllOrders(goCtx context.Context, msg *types.MsgCancelAllOrders) (*types.MsgCancelAllOrdersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
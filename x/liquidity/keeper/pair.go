@@ -24,6 +24,15 @@ func (k Keeper) getNextOrderIdWithUpdate(ctx sdk.Context, pair types.Pair) uint6
 	return id
 }
 
+// getNextOrderIdRangeWithUpdate reserves n contiguous order ids for the pair
+// and returns the first id of the range.
+func (k Keeper) getNextOrderIdRangeWithUpdate(ctx sdk.Context, pair types.Pair, n uint64) uint64 {
+	id := pair.LastOrderId + 1
+	pair.LastOrderId += n
+	k.SetPair(ctx, pair)
+	return id
+}
+
 // ValidateMsgCreatePair validates types.MsgCreatePair.
 func (k Keeper) ValidateMsgCreatePair(ctx sdk.Context, msg *types.MsgCreatePair) error {
 	if _, found := k.GetPairByDenoms(ctx, msg.BaseCoinDenom, msg.QuoteCoinDenom); found {
@@ -48,6 +57,7 @@ func (k Keeper) CreatePair(ctx sdk.Context, msg *types.MsgCreatePair) (types.Pai
 
 	id := k.getNextPairIdWithUpdate(ctx)
 	pair := types.NewPair(id, msg.BaseCoinDenom, msg.QuoteCoinDenom)
+	pair.AllocationPolicy = msg.AllocationPolicy
 	k.SetPair(ctx, pair)
 	k.SetPairIndex(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom, pair.Id)
 	k.SetPairLookupIndex(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom, pair.Id)
@@ -61,8 +71,126 @@ func (k Keeper) CreatePair(ctx sdk.Context, msg *types.MsgCreatePair) (types.Pai
 			sdk.NewAttribute(types.AttributeKeyQuoteCoinDenom, msg.QuoteCoinDenom),
 			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
 			sdk.NewAttribute(types.AttributeKeyEscrowAddress, pair.EscrowAddress),
+			sdk.NewAttribute(types.AttributeKeyAllocationPolicy, pair.AllocationPolicy.String()),
 		),
 	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreatePair{
+		Creator:          msg.Creator,
+		PairId:           pair.Id,
+		BaseCoinDenom:    msg.BaseCoinDenom,
+		QuoteCoinDenom:   msg.QuoteCoinDenom,
+		EscrowAddress:    pair.EscrowAddress,
+		AllocationPolicy: pair.AllocationPolicy,
+	}); err != nil {
+		return types.Pair{}, err
+	}
 
 	return pair, nil
 }
+
+// GetPairFeeRates returns the maker and taker fee rates of the pair.
+// The rates which are not set in the pair are zero.
+func (k Keeper) GetPairFeeRates(pair types.Pair) (makerFeeRate, takerFeeRate sdk.Dec) {
+	makerFeeRate, takerFeeRate = sdk.ZeroDec(), sdk.ZeroDec()
+	if pair.MakerFeeRate != nil {
+		makerFeeRate = *pair.MakerFeeRate
+	}
+	if pair.TakerFeeRate != nil {
+		takerFeeRate = *pair.TakerFeeRate
+	}
+	return
+}
+
+// GetPairTickPrecision returns the tick precision of the pair.
+// The tick precision param is returned if the pair has no tick precision set.
+func (k Keeper) GetPairTickPrecision(ctx sdk.Context, pair types.Pair) int {
+	if pair.TickPrecision != nil {
+		return int(*pair.TickPrecision)
+	}
+	return int(k.GetTickPrecision(ctx))
+}
+
+// ChangePairFeeRates sets the maker and taker fee rates of the pair.
+// A nil rate removes the fee.
+func (k Keeper) ChangePairFeeRates(ctx sdk.Context, pair types.Pair, makerFeeRate, takerFeeRate *sdk.Dec) error {
+	pair.MakerFeeRate = makerFeeRate
+	pair.TakerFeeRate = takerFeeRate
+	k.SetPair(ctx, pair)
+
+	newMakerFeeRate, newTakerFeeRate := k.GetPairFeeRates(pair)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePairFeeRates,
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyMakerFeeRate, newMakerFeeRate.String()),
+			sdk.NewAttribute(types.AttributeKeyTakerFeeRate, newTakerFeeRate.String()),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPairFeeRates{
+		PairId:       pair.Id,
+		MakerFeeRate: newMakerFeeRate,
+		TakerFeeRate: newTakerFeeRate,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ChangePairStatus pauses or resumes deposits to the pools of the pair and
+// orders in the pair.
+func (k Keeper) ChangePairStatus(ctx sdk.Context, pair types.Pair, depositsPaused, ordersPaused bool) error {
+	pair.DepositsPaused = depositsPaused
+	pair.OrdersPaused = ordersPaused
+	k.SetPair(ctx, pair)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePairStatus,
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositsPaused, strconv.FormatBool(depositsPaused)),
+			sdk.NewAttribute(types.AttributeKeyOrdersPaused, strconv.FormatBool(ordersPaused)),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPairStatus{
+		PairId:         pair.Id,
+		DepositsPaused: depositsPaused,
+		OrdersPaused:   ordersPaused,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ChangePairOrderRules sets the tick precision and the minimum order notional
+// of the pair.
+// A nil tick precision makes the pair use the tick precision param, and
+// a nil minimum order notional removes the minimum.
+func (k Keeper) ChangePairOrderRules(ctx sdk.Context, pair types.Pair, tickPrec *uint32, minOrderNotional *sdk.Int) error {
+	pair.TickPrecision = tickPrec
+	pair.MinOrderNotional = minOrderNotional
+	k.SetPair(ctx, pair)
+
+	minOrderNotionalStr := ""
+	if minOrderNotional != nil {
+		minOrderNotionalStr = minOrderNotional.String()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePairOrderRules,
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyTickPrecision, strconv.Itoa(k.GetPairTickPrecision(ctx, pair))),
+			sdk.NewAttribute(types.AttributeKeyMinOrderNotional, minOrderNotionalStr),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPairOrderRules{
+		PairId:           pair.Id,
+		TickPrecision:    uint32(k.GetPairTickPrecision(ctx, pair)),
+		MinOrderNotional: minOrderNotional,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -141,3 +141,40 @@ func (k Keeper) GetMaxNumActivePoolsPerPair(ctx sdk.Context) (i uint32) {
 func (k Keeper) SetMaxNumActivePoolsPerPair(ctx sdk.Context, i uint32) {
 	k.paramSpace.Set(ctx, types.KeyMaxNumActivePoolsPerPair, i)
 }
+
+// GetMaxNumTWAPSnapshots returns the current maximum number of twap
+// snapshots kept per pair.
+func (k Keeper) GetMaxNumTWAPSnapshots(ctx sdk.Context) (i uint32) {
+	k.paramSpace.Get(ctx, types.KeyMaxNumTWAPSnapshots, &i)
+	return
+}
+
+// SetMaxNumTWAPSnapshots sets the maximum number of twap snapshots kept per
+// pair.
+func (k Keeper) SetMaxNumTWAPSnapshots(ctx sdk.Context, i uint32) {
+	k.paramSpace.Set(ctx, types.KeyMaxNumTWAPSnapshots, i)
+}
+
+// GetCandleIntervals returns the current candle intervals parameter.
+func (k Keeper) GetCandleIntervals(ctx sdk.Context) (intervals []time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyCandleIntervals, &intervals)
+	return
+}
+
+// SetCandleIntervals sets the candle intervals parameter.
+func (k Keeper) SetCandleIntervals(ctx sdk.Context, intervals []time.Duration) {
+	k.paramSpace.Set(ctx, types.KeyCandleIntervals, intervals)
+}
+
+// GetMaxNumCandles returns the current maximum number of candles kept per
+// pair and interval.
+func (k Keeper) GetMaxNumCandles(ctx sdk.Context) (i uint32) {
+	k.paramSpace.Get(ctx, types.KeyMaxNumCandles, &i)
+	return
+}
+
+// SetMaxNumCandles sets the maximum number of candles kept per pair and
+// interval.
+func (k Keeper) SetMaxNumCandles(ctx sdk.Context, i uint32) {
+	k.paramSpace.Set(ctx, types.KeyMaxNumCandles, i)
+}
@@ -71,3 +71,15 @@ func (s *KeeperTestSuite) TestGetOrderExtraGas() {
 func (s *KeeperTestSuite) TestGetMaxNumActivePoolsPerPair() {
 	s.Require().EqualValues(types.DefaultMaxNumActivePoolsPerPair, s.keeper.GetMaxNumActivePoolsPerPair(s.ctx))
 }
+
+func (s *KeeperTestSuite) TestGetMaxNumTWAPSnapshots() {
+	s.Require().EqualValues(types.DefaultMaxNumTWAPSnapshots, s.keeper.GetMaxNumTWAPSnapshots(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetCandleIntervals() {
+	s.Require().EqualValues(types.DefaultCandleIntervals, s.keeper.GetCandleIntervals(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetMaxNumCandles() {
+	s.Require().EqualValues(types.DefaultMaxNumCandles, s.keeper.GetMaxNumCandles(s.ctx))
+}
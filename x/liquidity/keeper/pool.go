@@ -61,6 +61,18 @@ func (k Keeper) GetPoolCoinSupply(ctx sdk.Context, pool types.Pool) sdk.Int {
 	return k.bankKeeper.GetSupply(ctx, pool.PoolCoinDenom).Amount
 }
 
+// depositsPaused returns whether deposits to the pool are paused by
+// governance, either for the pool itself or for the whole pair.
+func depositsPaused(pair types.Pair, pool types.Pool) bool {
+	return pair.DepositsPaused || pool.DepositsPaused
+}
+
+// ordersPaused returns whether swaps against the pool are paused by
+// governance, either for the pool itself or for the whole pair.
+func ordersPaused(pair types.Pair, pool types.Pool) bool {
+	return pair.OrdersPaused || pool.OrdersPaused
+}
+
 // MarkPoolAsDisabled marks a pool as disabled.
 func (k Keeper) MarkPoolAsDisabled(ctx sdk.Context, pool types.Pool) {
 	pool.Disabled = true
@@ -165,33 +177,45 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg *types.MsgCreatePool) (types.Poo
 			sdk.NewAttribute(types.AttributeKeyMintedPoolCoin, poolCoin.String()),
 		),
 	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreatePool{
+		Creator:        msg.Creator,
+		PairId:         msg.PairId,
+		DepositCoins:   msg.DepositCoins,
+		PoolId:         pool.Id,
+		ReserveAddress: pool.ReserveAddress,
+		MintedPoolCoin: poolCoin,
+	}); err != nil {
+		return types.Pool{}, err
+	}
+
+	k.AfterPoolCreated(ctx, pool)
 
 	return pool, nil
 }
 
 // ValidateMsgCreateRangedPool validates types.MsgCreateRangedPool.
 func (k Keeper) ValidateMsgCreateRangedPool(ctx sdk.Context, msg *types.MsgCreateRangedPool) error {
-	tickPrec := k.GetTickPrecision(ctx)
-	if !amm.PriceToDownTick(msg.MinPrice, int(tickPrec)).Equal(msg.MinPrice) {
+	pair, found := k.GetPair(ctx, msg.PairId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
+	}
+
+	tickPrec := k.GetPairTickPrecision(ctx, pair)
+	if !amm.PriceToDownTick(msg.MinPrice, tickPrec).Equal(msg.MinPrice) {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min price is not on ticks")
 	}
-	if !amm.PriceToDownTick(msg.MaxPrice, int(tickPrec)).Equal(msg.MaxPrice) {
+	if !amm.PriceToDownTick(msg.MaxPrice, tickPrec).Equal(msg.MaxPrice) {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max price is not on ticks")
 	}
-	if !amm.PriceToDownTick(msg.InitialPrice, int(tickPrec)).Equal(msg.InitialPrice) {
+	if !amm.PriceToDownTick(msg.InitialPrice, tickPrec).Equal(msg.InitialPrice) {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "initial price is not on ticks")
 	}
 
-	lowestTick := amm.LowestTick(int(tickPrec))
+	lowestTick := amm.LowestTick(tickPrec)
 	if msg.MinPrice.LT(lowestTick) {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min price must not be less than %s", lowestTick)
 	}
 
-	pair, found := k.GetPair(ctx, msg.PairId)
-	if !found {
-		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
-	}
-
 	for _, coin := range msg.DepositCoins {
 		if coin.Denom != pair.BaseCoinDenom && coin.Denom != pair.QuoteCoinDenom {
 			return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", coin.Denom)
@@ -276,172 +300,838 @@ func (k Keeper) CreateRangedPool(ctx sdk.Context, msg *types.MsgCreateRangedPool
 			sdk.NewAttribute(types.AttributeKeyMintedPoolCoin, poolCoin.String()),
 		),
 	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateRangedPool{
+		Creator:        msg.Creator,
+		PairId:         msg.PairId,
+		DepositCoins:   msg.DepositCoins,
+		MinPrice:       msg.MinPrice,
+		MaxPrice:       msg.MaxPrice,
+		InitialPrice:   msg.InitialPrice,
+		PoolId:         pool.Id,
+		ReserveAddress: pool.ReserveAddress,
+		MintedPoolCoin: poolCoin,
+	}); err != nil {
+		return types.Pool{}, err
+	}
+
+	k.AfterPoolCreated(ctx, pool)
 
 	return pool, nil
 }
 
-// ValidateMsgDeposit validates types.MsgDeposit.
-func (k Keeper) ValidateMsgDeposit(ctx sdk.Context, msg *types.MsgDeposit) error {
-	pool, found := k.GetPool(ctx, msg.PoolId)
+// ValidateMsgCreateStablePool validates types.MsgCreateStablePool.
+func (k Keeper) ValidateMsgCreateStablePool(ctx sdk.Context, msg *types.MsgCreateStablePool) error {
+	pair, found := k.GetPair(ctx, msg.PairId)
 	if !found {
-		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", msg.PoolId)
-	}
-	if pool.Disabled {
-		return types.ErrDisabledPool
-	}
-	if pool.Type == types.PoolTypeBasic && len(msg.DepositCoins) != 2 {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "wrong number of deposit coins: %d", len(msg.DepositCoins))
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
 	}
 
-	pair, _ := k.GetPair(ctx, pool.PairId)
-
+	minInitDepositAmt := k.GetMinInitialDepositAmount(ctx)
 	for _, coin := range msg.DepositCoins {
 		if coin.Denom != pair.BaseCoinDenom && coin.Denom != pair.QuoteCoinDenom {
 			return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", coin.Denom)
 		}
+		minDepositCoin := sdk.NewCoin(coin.Denom, minInitDepositAmt)
+		if coin.IsLT(minDepositCoin) {
+			return sdkerrors.Wrapf(
+				types.ErrInsufficientDepositAmount, "%s is smaller than %s", coin, minDepositCoin)
+		}
 	}
 
-	rx, ry := k.getPoolBalances(ctx, pool, pair)
-	if rx.Amount.Add(msg.DepositCoins.AmountOf(rx.Denom)).GT(amm.MaxCoinAmount) {
-		return types.ErrTooLargePool
-	}
-	if ry.Amount.Add(msg.DepositCoins.AmountOf(ry.Denom)).GT(amm.MaxCoinAmount) {
-		return types.ErrTooLargePool
+	numActivePools := 0
+	_ = k.IteratePoolsByPair(ctx, pair.Id, func(pool types.Pool) (stop bool, err error) {
+		if !pool.Disabled {
+			numActivePools++
+		}
+		return false, nil
+	})
+	if uint32(numActivePools) >= k.GetMaxNumActivePoolsPerPair(ctx) {
+		return types.ErrTooManyPools
 	}
 
 	return nil
 }
 
-// Deposit handles types.MsgDeposit and stores the request.
-func (k Keeper) Deposit(ctx sdk.Context, msg *types.MsgDeposit) (types.DepositRequest, error) {
-	if err := k.ValidateMsgDeposit(ctx, msg); err != nil {
-		return types.DepositRequest{}, err
+// CreateStablePool handles types.MsgCreateStablePool and creates a stable pool.
+func (k Keeper) CreateStablePool(ctx sdk.Context, msg *types.MsgCreateStablePool) (types.Pool, error) {
+	if err := k.ValidateMsgCreateStablePool(ctx, msg); err != nil {
+		return types.Pool{}, err
 	}
 
-	if err := k.bankKeeper.SendCoins(ctx, msg.GetDepositor(), types.GlobalEscrowAddress, msg.DepositCoins); err != nil {
-		return types.DepositRequest{}, err
+	pair, _ := k.GetPair(ctx, msg.PairId)
+
+	x, y := msg.DepositCoins.AmountOf(pair.QuoteCoinDenom), msg.DepositCoins.AmountOf(pair.BaseCoinDenom)
+	ammPool, err := amm.CreateStablePool(x, y, msg.Amplification)
+	if err != nil {
+		return types.Pool{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	pool, _ := k.GetPool(ctx, msg.PoolId)
-	requestId := k.getNextDepositRequestIdWithUpdate(ctx, pool)
-	req := types.NewDepositRequest(msg, pool, requestId, ctx.BlockHeight())
-	k.SetDepositRequest(ctx, req)
-	k.SetDepositRequestIndex(ctx, req)
+	// Create and save the new pool object.
+	poolId := k.getNextPoolIdWithUpdate(ctx)
+	pool := types.NewStablePool(poolId, pair.Id, msg.GetCreator(), msg.Amplification)
+	k.SetPool(ctx, pool)
+	k.SetPoolByReserveIndex(ctx, pool)
+	k.SetPoolsByPairIndex(ctx, pool)
 
-	ctx.GasMeter().ConsumeGas(k.GetDepositExtraGas(ctx), "DepositExtraGas")
+	// Send deposit coins to the pool's reserve account.
+	creator := msg.GetCreator()
+	if err := k.bankKeeper.SendCoins(ctx, creator, pool.GetReserveAddress(), msg.DepositCoins); err != nil {
+		return types.Pool{}, err
+	}
+
+	// Send the pool creation fee to the fee collector.
+	if err := k.bankKeeper.SendCoins(ctx, creator, k.GetFeeCollector(ctx), k.GetPoolCreationFee(ctx)); err != nil {
+		return types.Pool{}, sdkerrors.Wrap(err, "insufficient pool creation fee")
+	}
+
+	// Mint and send pool coin to the creator.
+	// Minimum minting amount is params.MinInitialPoolCoinSupply.
+	ps := sdk.MaxInt(ammPool.PoolCoinSupply(), k.GetMinInitialPoolCoinSupply(ctx))
+	poolCoin := sdk.NewCoin(pool.PoolCoinDenom, ps)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(poolCoin)); err != nil {
+		return types.Pool{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, sdk.NewCoins(poolCoin)); err != nil {
+		return types.Pool{}, err
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeDeposit,
-			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
-			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			types.EventTypeCreateStablePool,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
 			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmplification, strconv.FormatUint(uint64(msg.Amplification), 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyReserveAddress, pool.ReserveAddress),
+			sdk.NewAttribute(types.AttributeKeyMintedPoolCoin, poolCoin.String()),
 		),
 	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateStablePool{
+		Creator:        msg.Creator,
+		PairId:         msg.PairId,
+		DepositCoins:   msg.DepositCoins,
+		Amplification:  msg.Amplification,
+		PoolId:         pool.Id,
+		ReserveAddress: pool.ReserveAddress,
+		MintedPoolCoin: poolCoin,
+	}); err != nil {
+		return types.Pool{}, err
+	}
 
-	return req, nil
+	k.AfterPoolCreated(ctx, pool)
+
+	return pool, nil
 }
 
-// ValidateMsgWithdraw validates types.MsgWithdraw.
-func (k Keeper) ValidateMsgWithdraw(ctx sdk.Context, msg *types.MsgWithdraw) error {
-	pool, found := k.GetPool(ctx, msg.PoolId)
+// ValidateMsgCreateWeightedPool validates types.MsgCreateWeightedPool.
+func (k Keeper) ValidateMsgCreateWeightedPool(ctx sdk.Context, msg *types.MsgCreateWeightedPool) error {
+	pair, found := k.GetPair(ctx, msg.PairId)
 	if !found {
-		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", msg.PoolId)
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
 	}
-	if pool.Disabled {
-		return types.ErrDisabledPool
+
+	minInitDepositAmt := k.GetMinInitialDepositAmount(ctx)
+	for _, coin := range msg.DepositCoins {
+		if coin.Denom != pair.BaseCoinDenom && coin.Denom != pair.QuoteCoinDenom {
+			return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", coin.Denom)
+		}
+		minDepositCoin := sdk.NewCoin(coin.Denom, minInitDepositAmt)
+		if coin.IsLT(minDepositCoin) {
+			return sdkerrors.Wrapf(
+				types.ErrInsufficientDepositAmount, "%s is smaller than %s", coin, minDepositCoin)
+		}
 	}
 
-	if msg.PoolCoin.Denom != pool.PoolCoinDenom {
-		return types.ErrWrongPoolCoinDenom
+	numActivePools := 0
+	_ = k.IteratePoolsByPair(ctx, pair.Id, func(pool types.Pool) (stop bool, err error) {
+		if !pool.Disabled {
+			numActivePools++
+		}
+		return false, nil
+	})
+	if uint32(numActivePools) >= k.GetMaxNumActivePoolsPerPair(ctx) {
+		return types.ErrTooManyPools
 	}
 
 	return nil
 }
 
-// Withdraw handles types.MsgWithdraw and stores the request.
-func (k Keeper) Withdraw(ctx sdk.Context, msg *types.MsgWithdraw) (types.WithdrawRequest, error) {
-	if err := k.ValidateMsgWithdraw(ctx, msg); err != nil {
-		return types.WithdrawRequest{}, err
+// CreateWeightedPool handles types.MsgCreateWeightedPool and creates a weighted pool.
+func (k Keeper) CreateWeightedPool(ctx sdk.Context, msg *types.MsgCreateWeightedPool) (types.Pool, error) {
+	if err := k.ValidateMsgCreateWeightedPool(ctx, msg); err != nil {
+		return types.Pool{}, err
 	}
 
-	pool, _ := k.GetPool(ctx, msg.PoolId)
-	if err := k.bankKeeper.SendCoins(ctx, msg.GetWithdrawer(), types.GlobalEscrowAddress, sdk.NewCoins(msg.PoolCoin)); err != nil {
-		return types.WithdrawRequest{}, err
+	pair, _ := k.GetPair(ctx, msg.PairId)
+
+	x, y := msg.DepositCoins.AmountOf(pair.QuoteCoinDenom), msg.DepositCoins.AmountOf(pair.BaseCoinDenom)
+	ammPool, err := amm.CreateWeightedPool(x, y, msg.QuoteCoinWeight, msg.BaseCoinWeight)
+	if err != nil {
+		return types.Pool{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	requestId := k.getNextWithdrawRequestIdWithUpdate(ctx, pool)
-	req := types.NewWithdrawRequest(msg, requestId, ctx.BlockHeight())
-	k.SetWithdrawRequest(ctx, req)
-	k.SetWithdrawRequestIndex(ctx, req)
+	// Create and save the new pool object.
+	poolId := k.getNextPoolIdWithUpdate(ctx)
+	pool := types.NewWeightedPool(poolId, pair.Id, msg.GetCreator(), msg.BaseCoinWeight, msg.QuoteCoinWeight)
+	k.SetPool(ctx, pool)
+	k.SetPoolByReserveIndex(ctx, pool)
+	k.SetPoolsByPairIndex(ctx, pool)
 
-	ctx.GasMeter().ConsumeGas(k.GetWithdrawExtraGas(ctx), "WithdrawExtraGas")
+	// Send deposit coins to the pool's reserve account.
+	creator := msg.GetCreator()
+	if err := k.bankKeeper.SendCoins(ctx, creator, pool.GetReserveAddress(), msg.DepositCoins); err != nil {
+		return types.Pool{}, err
+	}
+
+	// Send the pool creation fee to the fee collector.
+	if err := k.bankKeeper.SendCoins(ctx, creator, k.GetFeeCollector(ctx), k.GetPoolCreationFee(ctx)); err != nil {
+		return types.Pool{}, sdkerrors.Wrap(err, "insufficient pool creation fee")
+	}
+
+	// Mint and send pool coin to the creator.
+	// Minimum minting amount is params.MinInitialPoolCoinSupply.
+	ps := sdk.MaxInt(ammPool.PoolCoinSupply(), k.GetMinInitialPoolCoinSupply(ctx))
+	poolCoin := sdk.NewCoin(pool.PoolCoinDenom, ps)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(poolCoin)); err != nil {
+		return types.Pool{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, sdk.NewCoins(poolCoin)); err != nil {
+		return types.Pool{}, err
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeWithdraw,
-			sdk.NewAttribute(types.AttributeKeyWithdrawer, msg.Withdrawer),
+			types.EventTypeCreateWeightedPool,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyBaseCoinWeight, strconv.FormatUint(uint64(msg.BaseCoinWeight), 10)),
+			sdk.NewAttribute(types.AttributeKeyQuoteCoinWeight, strconv.FormatUint(uint64(msg.QuoteCoinWeight), 10)),
 			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyPoolCoin, msg.PoolCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyReserveAddress, pool.ReserveAddress),
+			sdk.NewAttribute(types.AttributeKeyMintedPoolCoin, poolCoin.String()),
 		),
 	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateWeightedPool{
+		Creator:         msg.Creator,
+		PairId:          msg.PairId,
+		DepositCoins:    msg.DepositCoins,
+		BaseCoinWeight:  msg.BaseCoinWeight,
+		QuoteCoinWeight: msg.QuoteCoinWeight,
+		PoolId:          pool.Id,
+		ReserveAddress:  pool.ReserveAddress,
+		MintedPoolCoin:  poolCoin,
+	}); err != nil {
+		return types.Pool{}, err
+	}
 
-	return req, nil
+	k.AfterPoolCreated(ctx, pool)
+
+	return pool, nil
 }
 
-// ExecuteDepositRequest executes a deposit request.
-func (k Keeper) ExecuteDepositRequest(ctx sdk.Context, req types.DepositRequest) error {
-	pool, _ := k.GetPool(ctx, req.PoolId)
-	if pool.Disabled {
-		if err := k.FinishDepositRequest(ctx, req, types.RequestStatusFailed); err != nil {
-			return fmt.Errorf("refund deposit request: %w", err)
-		}
-		return nil
+// ValidateMsgCreateConcentratedPool validates types.MsgCreateConcentratedPool.
+func (k Keeper) ValidateMsgCreateConcentratedPool(ctx sdk.Context, msg *types.MsgCreateConcentratedPool) error {
+	pair, found := k.GetPair(ctx, msg.PairId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
 	}
 
-	pair, _ := k.GetPair(ctx, pool.PairId)
-	rx, ry := k.getPoolBalances(ctx, pool, pair)
-	ps := k.GetPoolCoinSupply(ctx, pool)
-	ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
-	if ammPool.IsDepleted() {
-		k.MarkPoolAsDisabled(ctx, pool)
-		if err := k.FinishDepositRequest(ctx, req, types.RequestStatusFailed); err != nil {
-			return err
-		}
-		return nil
+	tickPrec := k.GetPairTickPrecision(ctx, pair)
+	if !amm.PriceToDownTick(msg.InitialPrice, tickPrec).Equal(msg.InitialPrice) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "initial price is not on ticks")
 	}
 
-	ax, ay, pc := amm.Deposit(rx.Amount, ry.Amount, ps, req.DepositCoins.AmountOf(pair.QuoteCoinDenom), req.DepositCoins.AmountOf(pair.BaseCoinDenom))
-
-	if pc.IsZero() {
-		if err := k.FinishDepositRequest(ctx, req, types.RequestStatusFailed); err != nil {
-			return err
+	numActivePools := 0
+	_ = k.IteratePoolsByPair(ctx, pair.Id, func(pool types.Pool) (stop bool, err error) {
+		if !pool.Disabled {
+			numActivePools++
 		}
-		return nil
+		return false, nil
+	})
+	if uint32(numActivePools) >= k.GetMaxNumActivePoolsPerPair(ctx) {
+		return types.ErrTooManyPools
 	}
 
-	mintedPoolCoin := sdk.NewCoin(pool.PoolCoinDenom, pc)
-	mintingCoins := sdk.NewCoins(mintedPoolCoin)
+	return nil
+}
 
-	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, mintingCoins); err != nil {
-		return err
+// CreateConcentratedPool handles types.MsgCreateConcentratedPool and creates
+// a concentrated liquidity pool.
+// The pool has no liquidity until positions are opened in it.
+func (k Keeper) CreateConcentratedPool(ctx sdk.Context, msg *types.MsgCreateConcentratedPool) (types.Pool, error) {
+	if err := k.ValidateMsgCreateConcentratedPool(ctx, msg); err != nil {
+		return types.Pool{}, err
 	}
 
-	acceptedCoins := sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, ax), sdk.NewCoin(pair.BaseCoinDenom, ay))
-	bulkOp := types.NewBulkSendCoinsOperation()
-	bulkOp.QueueSendCoins(types.GlobalEscrowAddress, pool.GetReserveAddress(), acceptedCoins)
-	bulkOp.QueueSendCoins(k.accountKeeper.GetModuleAddress(types.ModuleName), req.GetDepositor(), mintingCoins)
-	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
-		return err
-	}
+	pair, _ := k.GetPair(ctx, msg.PairId)
 
-	req.AcceptedCoins = acceptedCoins
-	req.MintedPoolCoin = mintedPoolCoin
-	if err := k.FinishDepositRequest(ctx, req, types.RequestStatusSucceeded); err != nil {
-		return err
-	}
+	// Create and save the new pool object.
+	poolId := k.getNextPoolIdWithUpdate(ctx)
+	pool := types.NewConcentratedPool(poolId, pair.Id, msg.GetCreator(), msg.InitialPrice)
+	k.SetPool(ctx, pool)
+	k.SetPoolByReserveIndex(ctx, pool)
+	k.SetPoolsByPairIndex(ctx, pool)
+
+	// Send the pool creation fee to the fee collector.
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetCreator(), k.GetFeeCollector(ctx), k.GetPoolCreationFee(ctx)); err != nil {
+		return types.Pool{}, sdkerrors.Wrap(err, "insufficient pool creation fee")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateConcentratedPool,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.InitialPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyReserveAddress, pool.ReserveAddress),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateConcentratedPool{
+		Creator:        msg.Creator,
+		PairId:         msg.PairId,
+		InitialPrice:   msg.InitialPrice,
+		PoolId:         pool.Id,
+		ReserveAddress: pool.ReserveAddress,
+	}); err != nil {
+		return types.Pool{}, err
+	}
+
+	k.AfterPoolCreated(ctx, pool)
+
+	return pool, nil
+}
+
+// ChangeStablePoolAmplification changes the amplification coefficient of
+// a stable pool.
+func (k Keeper) ChangeStablePoolAmplification(ctx sdk.Context, pool types.Pool, amplification uint32) error {
+	pool.Amplification = amplification
+	k.SetPool(ctx, pool)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePoolAmplification,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmplification, strconv.FormatUint(uint64(amplification), 10)),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPoolAmplification{
+		PoolId:        pool.Id,
+		Amplification: amplification,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ChangePoolStatus pauses or resumes deposits to the pool and the pool's
+// participation in matching, and disables or re-enables the pool.
+// A migrated pool or a depleted pool cannot be re-enabled.
+func (k Keeper) ChangePoolStatus(ctx sdk.Context, pool types.Pool, depositsPaused, ordersPaused, disabled bool) error {
+	if pool.Disabled && !disabled {
+		if err := k.validatePoolReenabling(ctx, pool); err != nil {
+			return err
+		}
+	}
+
+	pool.DepositsPaused = depositsPaused
+	pool.OrdersPaused = ordersPaused
+	pool.Disabled = disabled
+	k.SetPool(ctx, pool)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePoolStatus,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositsPaused, strconv.FormatBool(depositsPaused)),
+			sdk.NewAttribute(types.AttributeKeyOrdersPaused, strconv.FormatBool(ordersPaused)),
+			sdk.NewAttribute(types.AttributeKeyDisabled, strconv.FormatBool(disabled)),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPoolStatus{
+		PoolId:         pool.Id,
+		DepositsPaused: depositsPaused,
+		OrdersPaused:   ordersPaused,
+		Disabled:       disabled,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validatePoolReenabling validates that the disabled pool can be re-enabled.
+// The same restrictions as the pool creation are applied on the number of
+// active pools in the pair.
+func (k Keeper) validatePoolReenabling(ctx sdk.Context, pool types.Pool) error {
+	if pool.MigratedToPoolId != 0 {
+		return sdkerrors.Wrapf(
+			types.ErrDisabledPool, "pool %d has been migrated to pool %d", pool.Id, pool.MigratedToPoolId)
+	}
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	// A concentrated pool with no liquidity is not depleted in the sense
+	// that positions can be opened in it.
+	if pool.Type != types.PoolTypeConcentrated && k.getPoolOrderer(ctx, pool, pair).IsDepleted() {
+		return sdkerrors.Wrapf(types.ErrDisabledPool, "pool %d is depleted", pool.Id)
+	}
+
+	duplicate := false
+	numActivePools := 0
+	_ = k.IteratePoolsByPair(ctx, pair.Id, func(other types.Pool) (stop bool, err error) {
+		if other.Disabled {
+			return false, nil
+		}
+		if pool.Type == types.PoolTypeBasic && other.Type == types.PoolTypeBasic {
+			duplicate = true
+			return true, nil
+		}
+		numActivePools++
+		return false, nil
+	})
+	if duplicate {
+		return sdkerrors.Wrapf(types.ErrPoolAlreadyExists, "pair %d already has an active basic pool", pair.Id)
+	}
+	if uint32(numActivePools) >= k.GetMaxNumActivePoolsPerPair(ctx) {
+		return types.ErrTooManyPools
+	}
+	return nil
+}
+
+// MigratePool migrates the liquidity of the source pool into the target pool
+// in the same pair.
+// The source pool's reserve coins are deposited to the target pool and the
+// minted pool coin is kept in the source pool's reserve, so that the source
+// pool's LPs keep their pro-rata share by withdrawing from the source pool.
+// Reserve coins not accepted by the deposit are left in the source pool's
+// reserve and withdrawn along with the target pool's pool coin.
+// The source pool is disabled after the migration.
+func (k Keeper) MigratePool(ctx sdk.Context, sourcePool, targetPool types.Pool) error {
+	if sourcePool.PairId != targetPool.PairId {
+		return sdkerrors.Wrapf(
+			types.ErrWrongPair, "pool %d and pool %d are not in the same pair", sourcePool.Id, targetPool.Id)
+	}
+	if sourcePool.Type == types.PoolTypeConcentrated || targetPool.Type == types.PoolTypeConcentrated {
+		return sdkerrors.Wrap(types.ErrWrongPoolType, "cannot migrate liquidity from or to a concentrated pool")
+	}
+	if sourcePool.Disabled {
+		return sdkerrors.Wrapf(types.ErrDisabledPool, "pool %d", sourcePool.Id)
+	}
+	if targetPool.Disabled {
+		return sdkerrors.Wrapf(types.ErrDisabledPool, "pool %d", targetPool.Id)
+	}
+
+	pair, _ := k.GetPair(ctx, sourcePool.PairId)
+	rx, ry := k.getPoolBalances(ctx, sourcePool, pair)
+	ps := k.GetPoolCoinSupply(ctx, sourcePool)
+	if sourcePool.AMMPool(rx.Amount, ry.Amount, ps).IsDepleted() {
+		return sdkerrors.Wrapf(types.ErrDisabledPool, "pool %d is depleted", sourcePool.Id)
+	}
+	trx, try := k.getPoolBalances(ctx, targetPool, pair)
+	tps := k.GetPoolCoinSupply(ctx, targetPool)
+	if targetPool.AMMPool(trx.Amount, try.Amount, tps).IsDepleted() {
+		return sdkerrors.Wrapf(types.ErrDisabledPool, "pool %d is depleted", targetPool.Id)
+	}
+	if trx.Amount.Add(rx.Amount).GT(amm.MaxCoinAmount) || try.Amount.Add(ry.Amount).GT(amm.MaxCoinAmount) {
+		return types.ErrTooLargePool
+	}
+
+	ax, ay, pc := amm.Deposit(trx.Amount, try.Amount, tps, rx.Amount, ry.Amount)
+	if pc.IsZero() {
+		return sdkerrors.Wrap(types.ErrInsufficientDepositAmount, "no pool coin is minted")
+	}
+
+	mintedPoolCoin := sdk.NewCoin(targetPool.PoolCoinDenom, pc)
+	mintingCoins := sdk.NewCoins(mintedPoolCoin)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, mintingCoins); err != nil {
+		return err
+	}
+
+	migratedCoins := sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, ax), sdk.NewCoin(pair.BaseCoinDenom, ay))
+	bulkOp := types.NewBulkSendCoinsOperation()
+	bulkOp.QueueSendCoins(sourcePool.GetReserveAddress(), targetPool.GetReserveAddress(), migratedCoins)
+	bulkOp.QueueSendCoins(k.accountKeeper.GetModuleAddress(types.ModuleName), sourcePool.GetReserveAddress(), mintingCoins)
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return err
+	}
+
+	sourcePool.Disabled = true
+	sourcePool.MigratedToPoolId = targetPool.Id
+	k.SetPool(ctx, sourcePool)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMigratePool,
+			sdk.NewAttribute(types.AttributeKeySourcePoolId, strconv.FormatUint(sourcePool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyTargetPoolId, strconv.FormatUint(targetPool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyMigratedCoins, migratedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyMintedPoolCoin, mintedPoolCoin.String()),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMigratePool{
+		SourcePoolId:   sourcePool.Id,
+		TargetPoolId:   targetPool.Id,
+		MigratedCoins:  migratedCoins,
+		MintedPoolCoin: mintedPoolCoin,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateMsgDeposit validates types.MsgDeposit.
+func (k Keeper) ValidateMsgDeposit(ctx sdk.Context, msg *types.MsgDeposit) error {
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", msg.PoolId)
+	}
+	if pool.Disabled {
+		return types.ErrDisabledPool
+	}
+	if pool.Type == types.PoolTypeConcentrated {
+		return sdkerrors.Wrap(types.ErrWrongPoolType, "cannot deposit to a concentrated pool; open a position instead")
+	}
+	if pool.Type != types.PoolTypeRanged && len(msg.DepositCoins) != 2 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "wrong number of deposit coins: %d", len(msg.DepositCoins))
+	}
+
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	if depositsPaused(pair, pool) {
+		return sdkerrors.Wrapf(types.ErrDepositsPaused, "pool %d", pool.Id)
+	}
+
+	for _, coin := range msg.DepositCoins {
+		if coin.Denom != pair.BaseCoinDenom && coin.Denom != pair.QuoteCoinDenom {
+			return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", coin.Denom)
+		}
+	}
+
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	if rx.Amount.Add(msg.DepositCoins.AmountOf(rx.Denom)).GT(amm.MaxCoinAmount) {
+		return types.ErrTooLargePool
+	}
+	if ry.Amount.Add(msg.DepositCoins.AmountOf(ry.Denom)).GT(amm.MaxCoinAmount) {
+		return types.ErrTooLargePool
+	}
+
+	return nil
+}
+
+// Deposit handles types.MsgDeposit and stores the request.
+func (k Keeper) Deposit(ctx sdk.Context, msg *types.MsgDeposit) (types.DepositRequest, error) {
+	if err := k.ValidateMsgDeposit(ctx, msg); err != nil {
+		return types.DepositRequest{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetDepositor(), types.GlobalEscrowAddress, msg.DepositCoins); err != nil {
+		return types.DepositRequest{}, err
+	}
+
+	pool, _ := k.GetPool(ctx, msg.PoolId)
+	requestId := k.getNextDepositRequestIdWithUpdate(ctx, pool)
+	req := types.NewDepositRequest(msg, pool, requestId, ctx.BlockHeight())
+	k.SetDepositRequest(ctx, req)
+	k.SetDepositRequestIndex(ctx, req)
+
+	ctx.GasMeter().ConsumeGas(k.GetDepositExtraGas(ctx), "DepositExtraGas")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeposit,
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDeposit{
+		Depositor:    msg.Depositor,
+		PoolId:       pool.Id,
+		DepositCoins: msg.DepositCoins,
+		RequestId:    req.Id,
+	}); err != nil {
+		return types.DepositRequest{}, err
+	}
+
+	return req, nil
+}
+
+// ValidateMsgDepositSingleSided validates types.MsgDepositSingleSided.
+func (k Keeper) ValidateMsgDepositSingleSided(ctx sdk.Context, msg *types.MsgDepositSingleSided) error {
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", msg.PoolId)
+	}
+	if pool.Disabled {
+		return types.ErrDisabledPool
+	}
+	if pool.Type == types.PoolTypeConcentrated {
+		return sdkerrors.Wrap(types.ErrWrongPoolType, "cannot deposit to a concentrated pool; open a position instead")
+	}
+	if msg.MinMintedPoolCoin.Denom != pool.PoolCoinDenom {
+		return types.ErrWrongPoolCoinDenom
+	}
+
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	if depositsPaused(pair, pool) {
+		return sdkerrors.Wrapf(types.ErrDepositsPaused, "pool %d", pool.Id)
+	}
+	// A single-sided deposit swaps against the pool.
+	if ordersPaused(pair, pool) {
+		return sdkerrors.Wrapf(types.ErrOrdersPaused, "pool %d", pool.Id)
+	}
+
+	if msg.DepositCoin.Denom != pair.BaseCoinDenom && msg.DepositCoin.Denom != pair.QuoteCoinDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", msg.DepositCoin.Denom)
+	}
+
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	if rx.Amount.Add(sdk.NewCoins(msg.DepositCoin).AmountOf(rx.Denom)).GT(amm.MaxCoinAmount) {
+		return types.ErrTooLargePool
+	}
+	if ry.Amount.Add(sdk.NewCoins(msg.DepositCoin).AmountOf(ry.Denom)).GT(amm.MaxCoinAmount) {
+		return types.ErrTooLargePool
+	}
+
+	return nil
+}
+
+// DepositSingleSided handles types.MsgDepositSingleSided and stores the request.
+func (k Keeper) DepositSingleSided(ctx sdk.Context, msg *types.MsgDepositSingleSided) (types.SingleSidedDepositRequest, error) {
+	if err := k.ValidateMsgDepositSingleSided(ctx, msg); err != nil {
+		return types.SingleSidedDepositRequest{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetDepositor(), types.GlobalEscrowAddress, sdk.NewCoins(msg.DepositCoin)); err != nil {
+		return types.SingleSidedDepositRequest{}, err
+	}
+
+	pool, _ := k.GetPool(ctx, msg.PoolId)
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	requestId := k.getNextDepositRequestIdWithUpdate(ctx, pool)
+	req := types.NewSingleSidedDepositRequest(msg, pool, pair, requestId, ctx.BlockHeight())
+	k.SetSingleSidedDepositRequest(ctx, req)
+	k.SetSingleSidedDepositRequestIndex(ctx, req)
+
+	// A single-sided deposit is a swap followed by a deposit.
+	ctx.GasMeter().ConsumeGas(k.GetOrderExtraGas(ctx)+k.GetDepositExtraGas(ctx), "DepositSingleSidedExtraGas")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDepositSingleSided,
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositCoin, msg.DepositCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyMinMintedPoolCoin, msg.MinMintedPoolCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDepositSingleSided{
+		Depositor:         msg.Depositor,
+		PoolId:            pool.Id,
+		DepositCoin:       msg.DepositCoin,
+		MinMintedPoolCoin: msg.MinMintedPoolCoin,
+		RequestId:         req.Id,
+	}); err != nil {
+		return types.SingleSidedDepositRequest{}, err
+	}
+
+	return req, nil
+}
+
+// ValidateMsgWithdraw validates types.MsgWithdraw.
+func (k Keeper) ValidateMsgWithdraw(ctx sdk.Context, msg *types.MsgWithdraw) error {
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", msg.PoolId)
+	}
+	if pool.Type == types.PoolTypeConcentrated {
+		return sdkerrors.Wrap(types.ErrWrongPoolType, "cannot withdraw from a concentrated pool; close a position instead")
+	}
+	// LPs of a migrated pool withdraw their share of the migrated liquidity
+	// from the pool, and LPs of a pool disabled by governance withdraw their
+	// share of the pool's reserve.
+	// Only a depleted pool has nothing left to withdraw.
+	if pool.Disabled && pool.MigratedToPoolId == 0 {
+		pair, _ := k.GetPair(ctx, pool.PairId)
+		if k.getPoolOrderer(ctx, pool, pair).IsDepleted() {
+			return types.ErrDisabledPool
+		}
+	}
+
+	if msg.PoolCoin.Denom != pool.PoolCoinDenom {
+		return types.ErrWrongPoolCoinDenom
+	}
+
+	return nil
+}
+
+// Withdraw handles types.MsgWithdraw and stores the request.
+func (k Keeper) Withdraw(ctx sdk.Context, msg *types.MsgWithdraw) (types.WithdrawRequest, error) {
+	if err := k.ValidateMsgWithdraw(ctx, msg); err != nil {
+		return types.WithdrawRequest{}, err
+	}
+
+	pool, _ := k.GetPool(ctx, msg.PoolId)
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetWithdrawer(), types.GlobalEscrowAddress, sdk.NewCoins(msg.PoolCoin)); err != nil {
+		return types.WithdrawRequest{}, err
+	}
+
+	requestId := k.getNextWithdrawRequestIdWithUpdate(ctx, pool)
+	req := types.NewWithdrawRequest(msg, requestId, ctx.BlockHeight())
+	k.SetWithdrawRequest(ctx, req)
+	k.SetWithdrawRequestIndex(ctx, req)
+
+	ctx.GasMeter().ConsumeGas(k.GetWithdrawExtraGas(ctx), "WithdrawExtraGas")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdraw,
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, msg.Withdrawer),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolCoin, msg.PoolCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWithdraw{
+		Withdrawer: msg.Withdrawer,
+		PoolId:     pool.Id,
+		PoolCoin:   msg.PoolCoin,
+		RequestId:  req.Id,
+	}); err != nil {
+		return types.WithdrawRequest{}, err
+	}
+
+	return req, nil
+}
+
+// ValidateMsgWithdrawSingleSided validates types.MsgWithdrawSingleSided.
+func (k Keeper) ValidateMsgWithdrawSingleSided(ctx sdk.Context, msg *types.MsgWithdrawSingleSided) error {
+	if err := k.ValidateMsgWithdraw(ctx, types.NewMsgWithdraw(msg.GetWithdrawer(), msg.PoolId, msg.PoolCoin)); err != nil {
+		return err
+	}
+
+	pool, _ := k.GetPool(ctx, msg.PoolId)
+	if pool.MigratedToPoolId != 0 {
+		return sdkerrors.Wrapf(
+			types.ErrDisabledPool, "pool %d has been migrated to pool %d", pool.Id, pool.MigratedToPoolId)
+	}
+	// A single-sided withdrawal swaps against the pool, which is not
+	// available while the pool is disabled.
+	if pool.Disabled {
+		return types.ErrDisabledPool
+	}
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	// A single-sided withdrawal swaps through the pair.
+	if pair.OrdersPaused {
+		return sdkerrors.Wrapf(types.ErrOrdersPaused, "pair %d", pair.Id)
+	}
+
+	if msg.MinWithdrawnCoin.Denom != pair.BaseCoinDenom && msg.MinWithdrawnCoin.Denom != pair.QuoteCoinDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", msg.MinWithdrawnCoin.Denom)
+	}
+
+	return nil
+}
+
+// WithdrawSingleSided handles types.MsgWithdrawSingleSided and stores the
+// request as a WithdrawRequest.
+func (k Keeper) WithdrawSingleSided(ctx sdk.Context, msg *types.MsgWithdrawSingleSided) (types.WithdrawRequest, error) {
+	if err := k.ValidateMsgWithdrawSingleSided(ctx, msg); err != nil {
+		return types.WithdrawRequest{}, err
+	}
+
+	pool, _ := k.GetPool(ctx, msg.PoolId)
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetWithdrawer(), types.GlobalEscrowAddress, sdk.NewCoins(msg.PoolCoin)); err != nil {
+		return types.WithdrawRequest{}, err
+	}
+
+	requestId := k.getNextWithdrawRequestIdWithUpdate(ctx, pool)
+	req := types.NewSingleSidedWithdrawRequest(msg, pair, requestId, ctx.BlockHeight())
+	k.SetWithdrawRequest(ctx, req)
+	k.SetWithdrawRequestIndex(ctx, req)
+
+	// A single-sided withdrawal is a withdrawal followed by a swap.
+	ctx.GasMeter().ConsumeGas(k.GetWithdrawExtraGas(ctx)+k.GetOrderExtraGas(ctx), "WithdrawSingleSidedExtraGas")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawSingleSided,
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, msg.Withdrawer),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolCoin, msg.PoolCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyMinWithdrawnCoin, msg.MinWithdrawnCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWithdrawSingleSided{
+		Withdrawer:       msg.Withdrawer,
+		PoolId:           pool.Id,
+		PoolCoin:         msg.PoolCoin,
+		MinWithdrawnCoin: msg.MinWithdrawnCoin,
+		RequestId:        req.Id,
+	}); err != nil {
+		return types.WithdrawRequest{}, err
+	}
+
+	return req, nil
+}
+
+// ExecuteDepositRequest executes a deposit request.
+func (k Keeper) ExecuteDepositRequest(ctx sdk.Context, req types.DepositRequest) error {
+	pool, _ := k.GetPool(ctx, req.PoolId)
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	if pool.Disabled || depositsPaused(pair, pool) {
+		if err := k.FinishDepositRequest(ctx, req, types.RequestStatusFailed); err != nil {
+			return fmt.Errorf("refund deposit request: %w", err)
+		}
+		return nil
+	}
+
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	ps := k.GetPoolCoinSupply(ctx, pool)
+	ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
+	if ammPool.IsDepleted() {
+		k.MarkPoolAsDisabled(ctx, pool)
+		if err := k.FinishDepositRequest(ctx, req, types.RequestStatusFailed); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	ax, ay, pc := amm.Deposit(rx.Amount, ry.Amount, ps, req.DepositCoins.AmountOf(pair.QuoteCoinDenom), req.DepositCoins.AmountOf(pair.BaseCoinDenom))
+
+	if pc.IsZero() {
+		if err := k.FinishDepositRequest(ctx, req, types.RequestStatusFailed); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	mintedPoolCoin := sdk.NewCoin(pool.PoolCoinDenom, pc)
+	mintingCoins := sdk.NewCoins(mintedPoolCoin)
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, mintingCoins); err != nil {
+		return err
+	}
+
+	acceptedCoins := sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, ax), sdk.NewCoin(pair.BaseCoinDenom, ay))
+	bulkOp := types.NewBulkSendCoinsOperation()
+	bulkOp.QueueSendCoins(types.GlobalEscrowAddress, pool.GetReserveAddress(), acceptedCoins)
+	bulkOp.QueueSendCoins(k.accountKeeper.GetModuleAddress(types.ModuleName), req.GetDepositor(), mintingCoins)
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return err
+	}
+
+	req.AcceptedCoins = acceptedCoins
+	req.MintedPoolCoin = mintedPoolCoin
+	if err := k.FinishDepositRequest(ctx, req, types.RequestStatusSucceeded); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -473,6 +1163,156 @@ func (k Keeper) FinishDepositRequest(ctx sdk.Context, req types.DepositRequest,
 			sdk.NewAttribute(types.AttributeKeyStatus, req.Status.String()),
 		),
 	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDepositResult{
+		RequestId:      req.Id,
+		Depositor:      req.Depositor,
+		PoolId:         req.PoolId,
+		DepositCoins:   req.DepositCoins,
+		AcceptedCoins:  req.AcceptedCoins,
+		RefundedCoins:  refundingCoins,
+		MintedPoolCoin: req.MintedPoolCoin,
+		Status:         req.Status,
+	}); err != nil {
+		return err
+	}
+
+	if req.Status == types.RequestStatusSucceeded {
+		k.AfterDepositExecuted(ctx, req)
+	}
+
+	return nil
+}
+
+// ExecuteSingleSidedDepositRequest executes a single-sided deposit request.
+// A part of the deposit coin is swapped against the pool first, and then
+// the rest of the deposit coin and the swapped coin are deposited to the pool.
+// The swap and the deposit are executed atomically; if any of them fails or
+// the minted pool coin is smaller than the minimum, the whole deposit coin is
+// refunded to the depositor.
+func (k Keeper) ExecuteSingleSidedDepositRequest(ctx sdk.Context, req types.SingleSidedDepositRequest) error {
+	pool, _ := k.GetPool(ctx, req.PoolId)
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	if pool.Disabled || depositsPaused(pair, pool) || ordersPaused(pair, pool) {
+		return k.FinishSingleSidedDepositRequest(ctx, req, types.RequestStatusFailed)
+	}
+
+	ammPool := k.getPoolOrderer(ctx, pool, pair)
+	if ammPool.IsDepleted() {
+		k.MarkPoolAsDisabled(ctx, pool)
+		return k.FinishSingleSidedDepositRequest(ctx, req, types.RequestStatusFailed)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	res, err := k.executeSingleSidedDeposit(cacheCtx, pool, pair, ammPool, req)
+	if err == nil && res.MintedPoolCoin.IsLT(req.MinMintedPoolCoin) {
+		err = sdkerrors.Wrapf(
+			types.ErrTooSmallMintedPoolCoin, "%s is smaller than %s", res.MintedPoolCoin, req.MinMintedPoolCoin)
+	}
+	if err != nil {
+		return k.FinishSingleSidedDepositRequest(ctx, req, types.RequestStatusFailed)
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return k.FinishSingleSidedDepositRequest(ctx, res, types.RequestStatusSucceeded)
+}
+
+// executeSingleSidedDeposit swaps a part of the request's deposit coin
+// against the pool and deposits the result to the pool.
+// It returns the request with the result filled in.
+func (k Keeper) executeSingleSidedDeposit(
+	ctx sdk.Context, pool types.Pool, pair types.Pair, ammPool *types.PoolOrderer,
+	req types.SingleSidedDepositRequest) (types.SingleSidedDepositRequest, error) {
+	isQuote := req.DepositCoin.Denom == pair.QuoteCoinDenom
+	swapAmt := amm.SingleSidedDepositSwapAmount(ammPool.Pool, isQuote, req.DepositCoin.Amount)
+	swappedCoin := sdk.NewCoin(req.DepositCoin.Denom, swapAmt)
+	receivedCoin, remainingCoin, err := k.swapAgainst(
+		ctx, pair, ammPool.Price(), ammPool.Price(), []*types.PoolOrderer{ammPool}, nil, swappedCoin)
+	if err != nil {
+		return req, err
+	}
+	swappedCoin = swappedCoin.Sub(remainingCoin)
+	depositCoins := sdk.NewCoins(req.DepositCoin.Sub(swappedCoin), receivedCoin)
+
+	pool, _ = k.GetPool(ctx, pool.Id)
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	ps := k.GetPoolCoinSupply(ctx, pool)
+	ax, ay, pc := amm.Deposit(
+		rx.Amount, ry.Amount, ps, depositCoins.AmountOf(pair.QuoteCoinDenom), depositCoins.AmountOf(pair.BaseCoinDenom))
+	if pc.IsZero() {
+		return req, sdkerrors.Wrap(types.ErrInsufficientDepositAmount, "no pool coin is minted")
+	}
+
+	mintedPoolCoin := sdk.NewCoin(pool.PoolCoinDenom, pc)
+	mintingCoins := sdk.NewCoins(mintedPoolCoin)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, mintingCoins); err != nil {
+		return req, err
+	}
+
+	acceptedCoins := sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, ax), sdk.NewCoin(pair.BaseCoinDenom, ay))
+	bulkOp := types.NewBulkSendCoinsOperation()
+	bulkOp.QueueSendCoins(types.GlobalEscrowAddress, pool.GetReserveAddress(), acceptedCoins)
+	bulkOp.QueueSendCoins(k.accountKeeper.GetModuleAddress(types.ModuleName), req.GetDepositor(), mintingCoins)
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return req, err
+	}
+
+	req.SwappedCoin = swappedCoin
+	req.ReceivedCoin = receivedCoin
+	req.AcceptedCoins = acceptedCoins
+	req.RefundedCoins = depositCoins.Sub(acceptedCoins)
+	req.MintedPoolCoin = mintedPoolCoin
+	return req, nil
+}
+
+// FinishSingleSidedDepositRequest refunds unhandled coins and set request
+// status.
+// If the request has failed, the whole deposit coin is refunded.
+func (k Keeper) FinishSingleSidedDepositRequest(ctx sdk.Context, req types.SingleSidedDepositRequest, status types.RequestStatus) error {
+	if req.Status != types.RequestStatusNotExecuted { // sanity check
+		return nil
+	}
+
+	if status != types.RequestStatusSucceeded {
+		req.RefundedCoins = sdk.NewCoins(req.DepositCoin)
+	}
+	if !req.RefundedCoins.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, types.GlobalEscrowAddress, req.GetDepositor(), req.RefundedCoins); err != nil {
+			return err
+		}
+	}
+	req.SetStatus(status)
+	k.SetSingleSidedDepositRequest(ctx, req)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSingleSidedDepositResult,
+			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositor, req.Depositor),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(req.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositCoin, req.DepositCoin.String()),
+			sdk.NewAttribute(types.AttributeKeySwappedCoin, req.SwappedCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyReceivedCoin, req.ReceivedCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyAcceptedCoins, req.AcceptedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundedCoins, req.RefundedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyMintedPoolCoin, req.MintedPoolCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, req.Status.String()),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSingleSidedDepositResult{
+		RequestId:      req.Id,
+		Depositor:      req.Depositor,
+		PoolId:         req.PoolId,
+		DepositCoin:    req.DepositCoin,
+		SwappedCoin:    req.SwappedCoin,
+		ReceivedCoin:   req.ReceivedCoin,
+		AcceptedCoins:  req.AcceptedCoins,
+		RefundedCoins:  req.RefundedCoins,
+		MintedPoolCoin: req.MintedPoolCoin,
+		Status:         req.Status,
+	}); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -480,14 +1320,17 @@ func (k Keeper) FinishDepositRequest(ctx sdk.Context, req types.DepositRequest,
 // ExecuteWithdrawRequest executes a withdraw request.
 func (k Keeper) ExecuteWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest) error {
 	pool, _ := k.GetPool(ctx, req.PoolId)
-	if pool.Disabled {
+	if pool.MigratedToPoolId != 0 && req.SingleSided == nil {
+		return k.executeMigratedPoolWithdrawal(ctx, pool, req)
+	}
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	if req.SingleSided != nil && (pool.Disabled || pair.OrdersPaused) {
 		if err := k.FinishWithdrawRequest(ctx, req, types.RequestStatusFailed); err != nil {
 			return err
 		}
 		return nil
 	}
 
-	pair, _ := k.GetPair(ctx, pool.PairId)
 	rx, ry := k.getPoolBalances(ctx, pool, pair)
 	ps := k.GetPoolCoinSupply(ctx, pool)
 	ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
@@ -499,6 +1342,17 @@ func (k Keeper) ExecuteWithdrawRequest(ctx sdk.Context, req types.WithdrawReques
 		return nil
 	}
 
+	if req.SingleSided != nil {
+		cacheCtx, writeCache := ctx.CacheContext()
+		res, err := k.executeSingleSidedWithdrawal(cacheCtx, pool, pair, ammPool.Price(), req)
+		if err != nil {
+			return k.FinishWithdrawRequest(ctx, req, types.RequestStatusFailed)
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		return k.FinishWithdrawRequest(ctx, res, types.RequestStatusSucceeded)
+	}
+
 	x, y := amm.Withdraw(rx.Amount, ry.Amount, ps, req.PoolCoin.Amount, k.GetWithdrawFeeRate(ctx))
 	if x.IsZero() && y.IsZero() {
 		if err := k.FinishWithdrawRequest(ctx, req, types.RequestStatusFailed); err != nil {
@@ -533,6 +1387,123 @@ func (k Keeper) ExecuteWithdrawRequest(ctx sdk.Context, req types.WithdrawReques
 	return nil
 }
 
+// executeMigratedPoolWithdrawal executes a withdraw request from a migrated
+// pool.
+// The withdrawer receives the pro-rata share of the target pool's pool coin
+// and the reserve coins left in the pool's reserve by the migration.
+// No withdrawal fee of the source pool is charged, since the migration is
+// made by governance rather than by the withdrawer.
+// The withdrawer pays the withdrawal fee only when they withdraw the target
+// pool's pool coin from the target pool later.
+func (k Keeper) executeMigratedPoolWithdrawal(ctx sdk.Context, pool types.Pool, req types.WithdrawRequest) error {
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	targetPool, _ := k.GetPool(ctx, pool.MigratedToPoolId)
+	ps := k.GetPoolCoinSupply(ctx, pool)
+	spendable := k.bankKeeper.SpendableCoins(ctx, pool.GetReserveAddress())
+
+	var coins []sdk.Coin
+	for _, denom := range []string{targetPool.PoolCoinDenom, pair.QuoteCoinDenom, pair.BaseCoinDenom} {
+		amt := spendable.AmountOf(denom)
+		// The last withdrawer takes all the coins left.
+		if req.PoolCoin.Amount.LT(ps) {
+			amt = amt.Mul(req.PoolCoin.Amount).Quo(ps)
+		}
+		coins = append(coins, sdk.NewCoin(denom, amt))
+	}
+	withdrawnCoins := sdk.NewCoins(coins...)
+	if withdrawnCoins.IsZero() {
+		return k.FinishWithdrawRequest(ctx, req, types.RequestStatusFailed)
+	}
+
+	burningCoins := sdk.NewCoins(req.PoolCoin)
+	bulkOp := types.NewBulkSendCoinsOperation()
+	bulkOp.QueueSendCoins(types.GlobalEscrowAddress, k.accountKeeper.GetModuleAddress(types.ModuleName), burningCoins)
+	bulkOp.QueueSendCoins(pool.GetReserveAddress(), req.GetWithdrawer(), withdrawnCoins)
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burningCoins); err != nil {
+		return err
+	}
+
+	req.WithdrawnCoins = withdrawnCoins
+	return k.FinishWithdrawRequest(ctx, req, types.RequestStatusSucceeded)
+}
+
+// executeSingleSidedWithdrawal withdraws coins from the pool and swaps the
+// unwanted one through the pair, then sends the coins to the withdrawer.
+// poolPrice is used as the reference price for the swap when the pair has
+// no last price.
+// Coins not used by the swap are sent to the withdrawer along with the
+// wanted coin.
+// It returns the request with the result filled in.
+func (k Keeper) executeSingleSidedWithdrawal(
+	ctx sdk.Context, pool types.Pool, pair types.Pair, poolPrice sdk.Dec,
+	req types.WithdrawRequest) (types.WithdrawRequest, error) {
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	ps := k.GetPoolCoinSupply(ctx, pool)
+	x, y := amm.Withdraw(rx.Amount, ry.Amount, ps, req.PoolCoin.Amount, k.GetWithdrawFeeRate(ctx))
+	if x.IsZero() && y.IsZero() {
+		return req, sdkerrors.Wrap(types.ErrInsufficientLiquidity, "no coin is withdrawn")
+	}
+
+	withdrawnCoins := sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, x), sdk.NewCoin(pair.BaseCoinDenom, y))
+	burningCoins := sdk.NewCoins(req.PoolCoin)
+
+	bulkOp := types.NewBulkSendCoinsOperation()
+	bulkOp.QueueSendCoins(types.GlobalEscrowAddress, k.accountKeeper.GetModuleAddress(types.ModuleName), burningCoins)
+	bulkOp.QueueSendCoins(pool.GetReserveAddress(), types.GlobalEscrowAddress, withdrawnCoins)
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return req, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burningCoins); err != nil {
+		return req, err
+	}
+
+	// If the pool coin supply becomes 0, disable the pool.
+	if req.PoolCoin.Amount.Equal(ps) {
+		k.MarkPoolAsDisabled(ctx, pool)
+	}
+
+	ss := *req.SingleSided
+	swappingCoin := sdk.NewCoin(ss.SwappedCoin.Denom, withdrawnCoins.AmountOf(ss.SwappedCoin.Denom))
+	receivedCoin := sdk.NewCoin(ss.ReceivedCoin.Denom, sdk.ZeroInt())
+	remainingCoin := sdk.NewCoin(swappingCoin.Denom, sdk.ZeroInt())
+	if swappingCoin.IsPositive() {
+		lastPrice := poolPrice
+		if pair.LastPrice != nil {
+			lastPrice = *pair.LastPrice
+		}
+		dir := swapDirection(pair, swappingCoin.Denom)
+		var err error
+		receivedCoin, remainingCoin, err = k.swapAgainst(
+			ctx, pair, lastPrice, lastPrice, k.getPoolOrderers(ctx, pair), k.swapUserOrders(ctx, pair, dir), swappingCoin)
+		if err != nil {
+			return req, err
+		}
+	}
+
+	withdrawnCoin := sdk.NewCoin(
+		ss.MinWithdrawnCoin.Denom, withdrawnCoins.AmountOf(ss.MinWithdrawnCoin.Denom).Add(receivedCoin.Amount))
+	if withdrawnCoin.IsLT(ss.MinWithdrawnCoin) {
+		return req, sdkerrors.Wrapf(
+			types.ErrTooSmallWithdrawnCoin, "%s is smaller than %s", withdrawnCoin, ss.MinWithdrawnCoin)
+	}
+
+	sendingCoins := sdk.NewCoins(withdrawnCoin, remainingCoin)
+	if err := k.bankKeeper.SendCoins(ctx, types.GlobalEscrowAddress, req.GetWithdrawer(), sendingCoins); err != nil {
+		return req, err
+	}
+
+	ss.SwappedCoin = swappingCoin.Sub(remainingCoin)
+	ss.ReceivedCoin = receivedCoin
+	req.SingleSided = &ss
+	req.WithdrawnCoins = sendingCoins
+	return req, nil
+}
+
 // FinishWithdrawRequest refunds unhandled pool coin and set request status.
 func (k Keeper) FinishWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest, status types.RequestStatus) error {
 	if req.Status != types.RequestStatusNotExecuted { // sanity check
@@ -549,18 +1520,39 @@ func (k Keeper) FinishWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest
 	req.SetStatus(status)
 	k.SetWithdrawRequest(ctx, req)
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeWithdrawalResult,
-			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
-			sdk.NewAttribute(types.AttributeKeyWithdrawer, req.Withdrawer),
-			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(req.PoolId, 10)),
-			sdk.NewAttribute(types.AttributeKeyPoolCoin, req.PoolCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundingCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyWithdrawnCoins, req.WithdrawnCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyStatus, req.Status.String()),
-		),
-	})
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyWithdrawer, req.Withdrawer),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(req.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPoolCoin, req.PoolCoin.String()),
+		sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundingCoins.String()),
+		sdk.NewAttribute(types.AttributeKeyWithdrawnCoins, req.WithdrawnCoins.String()),
+		sdk.NewAttribute(types.AttributeKeyStatus, req.Status.String()),
+	}
+	if req.SingleSided != nil {
+		attrs = append(attrs,
+			sdk.NewAttribute(types.AttributeKeyMinWithdrawnCoin, req.SingleSided.MinWithdrawnCoin.String()),
+			sdk.NewAttribute(types.AttributeKeySwappedCoin, req.SingleSided.SwappedCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyReceivedCoin, req.SingleSided.ReceivedCoin.String()),
+		)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeWithdrawalResult, attrs...))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWithdrawalResult{
+		RequestId:      req.Id,
+		Withdrawer:     req.Withdrawer,
+		PoolId:         req.PoolId,
+		PoolCoin:       req.PoolCoin,
+		RefundedCoins:  refundingCoins,
+		WithdrawnCoins: req.WithdrawnCoins,
+		Status:         req.Status,
+		SingleSided:    req.SingleSided,
+	}); err != nil {
+		return err
+	}
+
+	if req.Status == types.RequestStatusSucceeded {
+		k.AfterWithdrawExecuted(ctx, req)
+	}
 
 	return nil
 }
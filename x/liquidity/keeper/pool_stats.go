@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+// accruePoolMatchResult adds the volume and fees from the pool's match
+// result to the pool's accrual.
+// The fees are what the pool earned from the spread, which is the
+// difference between the matched quote coin amount and the cost of the
+// matched amount on the pool's curve.
+// The cost is approximated with the geometric mean of the pool's prices
+// before and after the match, which is exact for basic pools.
+func (k Keeper) accruePoolMatchResult(
+	ctx sdk.Context, pool types.Pool, pair types.Pair, dir types.OrderDirection,
+	paidCoin, receivedCoin sdk.Coin, matchedAmt sdk.Int, priceBefore sdk.Dec) {
+	priceAfter := priceBefore
+	if ammPool := k.getPoolOrderer(ctx, pool, pair); !ammPool.IsDepleted() {
+		priceAfter = ammPool.Price()
+	}
+	avgPrice, err := priceBefore.Mul(priceAfter).ApproxSqrt()
+	if err != nil { // sanity check
+		panic(err)
+	}
+	cost := avgPrice.MulInt(matchedAmt).TruncateInt()
+
+	var volume, fees sdk.Int
+	switch dir {
+	case types.OrderDirectionBuy:
+		volume = paidCoin.Amount
+		fees = cost.Sub(paidCoin.Amount)
+	case types.OrderDirectionSell:
+		volume = receivedCoin.Amount
+		fees = receivedCoin.Amount.Sub(cost)
+	}
+	if fees.IsNegative() {
+		fees = sdk.ZeroInt()
+	}
+
+	accrual, found := k.GetPoolAccrual(ctx, pool.Id)
+	if !found {
+		accrual = types.NewPoolAccrual(pool.Id)
+	}
+	accrual.Volume = accrual.Volume.Add(volume)
+	accrual.Fees = accrual.Fees.Add(fees)
+	k.SetPoolAccrual(ctx, accrual)
+}
+
+// TakePoolAccrualSnapshots takes snapshots of all pool accruals if
+// types.PoolAccrualSnapshotInterval has passed since the last snapshots,
+// and prunes the snapshots which are no longer needed.
+func (k Keeper) TakePoolAccrualSnapshots(ctx sdk.Context) {
+	now := ctx.BlockTime()
+	if lastTime, found := k.GetLastPoolAccrualSnapshotTime(ctx); found &&
+		now.Before(lastTime.Add(types.PoolAccrualSnapshotInterval)) {
+		return
+	}
+
+	// Keep the latest snapshot older than types.MaxPoolAccrualSnapshotAge,
+	// so that the stats for the whole period can be calculated.
+	pruneBefore := now.Add(-types.MaxPoolAccrualSnapshotAge - types.PoolAccrualSnapshotInterval)
+	_ = k.IterateAllPoolAccruals(ctx, func(accrual types.PoolAccrual) (stop bool, err error) {
+		var outdated []types.PoolAccrualSnapshot
+		_ = k.IteratePoolAccrualSnapshotsByPool(ctx, accrual.PoolId, func(snapshot types.PoolAccrualSnapshot) (stop bool, err error) {
+			if !snapshot.Time.Before(pruneBefore) {
+				return true, nil
+			}
+			outdated = append(outdated, snapshot)
+			return false, nil
+		})
+		for _, snapshot := range outdated {
+			k.DeletePoolAccrualSnapshot(ctx, snapshot)
+		}
+		k.SetPoolAccrualSnapshot(ctx, accrual.Snapshot(now))
+		return false, nil
+	})
+	k.SetLastPoolAccrualSnapshotTime(ctx, now)
+}
+
+// poolAccrualSince returns the volume and fees of the pool accrued since
+// the given time, and the actual duration they were accrued for.
+// The latest snapshot taken at or before since is used as the base, or the
+// earliest snapshot if there is no such one.
+func (k Keeper) poolAccrualSince(
+	ctx sdk.Context, accrual types.PoolAccrual, since time.Time) (volume, fees sdk.Int, duration time.Duration) {
+	base, found := k.GetLatestPoolAccrualSnapshotBefore(ctx, accrual.PoolId, since)
+	if !found {
+		_ = k.IteratePoolAccrualSnapshotsByPool(ctx, accrual.PoolId, func(snapshot types.PoolAccrualSnapshot) (stop bool, err error) {
+			base, found = snapshot, true
+			return true, nil
+		})
+		if !found {
+			return sdk.ZeroInt(), sdk.ZeroInt(), 0
+		}
+	}
+	return accrual.Volume.Sub(base.Volume), accrual.Fees.Sub(base.Fees), ctx.BlockTime().Sub(base.Time)
+}
+
+// PoolStats returns the trading volume, fees and the estimated APR of the
+// pool, calculated from the pool's accrual and its snapshots.
+func (k Keeper) PoolStats(ctx sdk.Context, pool types.Pool) types.PoolStatsResponse {
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	accrual, found := k.GetPoolAccrual(ctx, pool.Id)
+	if !found {
+		accrual = types.NewPoolAccrual(pool.Id)
+	}
+
+	now := ctx.BlockTime()
+	volume24h, fees24h, _ := k.poolAccrualSince(ctx, accrual, now.Add(-24*time.Hour))
+	volume7d, fees7d, duration7d := k.poolAccrualSince(ctx, accrual, now.Add(-types.MaxPoolAccrualSnapshotAge))
+
+	totalValue := sdk.ZeroInt()
+	if !pool.Disabled {
+		ammPool := k.getPoolOrderer(ctx, pool, pair)
+		if !ammPool.IsDepleted() {
+			rx, ry := ammPool.Balances()
+			totalValue = ammPool.Price().MulInt(ry).TruncateInt().Add(rx)
+		}
+	}
+
+	apr := sdk.ZeroDec()
+	if totalValue.IsPositive() && duration7d > 0 {
+		year := sdk.NewDec(int64(365 * 24 * time.Hour))
+		apr = fees7d.ToDec().Quo(totalValue.ToDec()).Mul(year).QuoInt64(int64(duration7d))
+	}
+
+	quoteCoin := func(amt sdk.Int) sdk.Coin {
+		return sdk.NewCoin(pair.QuoteCoinDenom, amt)
+	}
+	return types.PoolStatsResponse{
+		PoolId:           pool.Id,
+		Volume24h:        quoteCoin(volume24h),
+		Fees24h:          quoteCoin(fees24h),
+		Volume7d:         quoteCoin(volume7d),
+		Fees7d:           quoteCoin(fees7d),
+		CumulativeVolume: quoteCoin(accrual.Volume),
+		CumulativeFees:   quoteCoin(accrual.Fees),
+		TotalValue:       quoteCoin(totalValue),
+		Apr:              apr,
+	}
+}
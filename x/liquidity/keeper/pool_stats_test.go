@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/liquidity"
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestPoolAccrual() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+
+	_, found := s.keeper.GetPoolAccrual(s.ctx, pool.Id)
+	s.Require().False(found)
+
+	// The pool sells at the uniform match price, which is higher than the
+	// average price on the pool's curve.
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.01"), sdk.NewInt(1000000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	accrual, found := s.keeper.GetPoolAccrual(s.ctx, pool.Id)
+	s.Require().True(found)
+	s.Require().True(accrual.Volume.GT(sdk.NewInt(1000000)))
+	s.Require().True(accrual.Fees.IsPositive())
+	s.Require().True(accrual.Fees.LT(accrual.Volume.QuoRaw(100)))
+
+	// The volume is the amount of quote coin the pool received.
+	reserve := s.getBalances(pool.GetReserveAddress())
+	s.Require().True(reserve.AmountOf("denom2").Sub(sdk.NewInt(1000000000)).Equal(accrual.Volume))
+}
+
+func (s *KeeperTestSuite) TestPoolStats() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+
+	// The pool earns the spread in both directions, so buy and sell orders
+	// are placed alternately.
+	buy := true
+	trade := func() types.PoolAccrual {
+		liquidity.BeginBlocker(s.ctx, s.keeper) // Deletes the completed orders.
+		if buy {
+			s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.05"), sdk.NewInt(1000000), 0, true)
+		} else {
+			s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.95"), sdk.NewInt(1000000), 0, true)
+		}
+		buy = !buy
+		liquidity.EndBlocker(s.ctx, s.keeper)
+		accrual, _ := s.keeper.GetPoolAccrual(s.ctx, pool.Id)
+		return accrual
+	}
+	stats := func() types.PoolStatsResponse {
+		resp, err := s.querier.PoolStats(sdk.WrapSDKContext(s.ctx), &types.QueryPoolStatsRequest{PoolId: pool.Id})
+		s.Require().NoError(err)
+		return resp.Stats
+	}
+
+	t0 := s.ctx.BlockTime()
+	accrual1 := trade() // A snapshot is taken right after the trade.
+	s.Require().True(accrual1.Fees.IsPositive())
+	s.Require().Len(s.keeper.GetAllPoolAccrualSnapshots(s.ctx), 1)
+
+	s.ctx = s.ctx.WithBlockTime(t0.Add(2 * time.Hour))
+	accrual2 := trade()
+	s.Require().Len(s.keeper.GetAllPoolAccrualSnapshots(s.ctx), 2)
+
+	// The earliest snapshot is used since the pool is younger than a day.
+	st := stats()
+	s.Require().Equal(accrual2.Volume.Sub(accrual1.Volume), st.Volume24h.Amount)
+	s.Require().Equal(accrual2.Fees.Sub(accrual1.Fees), st.Fees24h.Amount)
+	s.Require().Equal(st.Volume24h, st.Volume7d)
+	s.Require().Equal(accrual2.Volume, st.CumulativeVolume.Amount)
+	s.Require().Equal("denom2", st.TotalValue.Denom)
+	s.Require().True(st.TotalValue.Amount.GT(sdk.NewInt(2000000000)))
+	s.Require().True(st.Apr.IsPositive())
+
+	// A snapshot is not taken within the interval.
+	s.ctx = s.ctx.WithBlockTime(t0.Add(2*time.Hour + 30*time.Minute))
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	s.Require().Len(s.keeper.GetAllPoolAccrualSnapshots(s.ctx), 2)
+
+	s.ctx = s.ctx.WithBlockTime(t0.Add(26 * time.Hour))
+	accrual3 := trade()
+	st = stats()
+	s.Require().Equal(accrual3.Volume.Sub(accrual2.Volume), st.Volume24h.Amount)
+	s.Require().Equal(accrual3.Volume.Sub(accrual1.Volume), st.Volume7d.Amount)
+
+	// Old snapshots are pruned, except the latest one which is older than
+	// the max age.
+	s.ctx = s.ctx.WithBlockTime(t0.Add(8 * 24 * time.Hour))
+	liquidity.BeginBlocker(s.ctx, s.keeper)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	snapshots := s.keeper.GetAllPoolAccrualSnapshots(s.ctx)
+	s.Require().Len(snapshots, 2)
+	s.Require().Equal(t0.Add(26*time.Hour), snapshots[0].Time)
+	st = stats()
+	s.Require().True(st.Volume24h.IsZero())
+	s.Require().True(st.Volume7d.IsZero())
+	s.Require().True(st.Apr.IsZero())
+}
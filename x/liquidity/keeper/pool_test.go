@@ -1,11 +1,14 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	utils "github.com/crescent-network/crescent/v5/types"
 	"github.com/crescent-network/crescent/v5/x/liquidity"
+	"github.com/crescent-network/crescent/v5/x/liquidity/keeper"
 	"github.com/crescent-network/crescent/v5/x/liquidity/types"
 
 	_ "github.com/stretchr/testify/suite"
@@ -136,6 +139,195 @@ func (s *KeeperTestSuite) TestCreateRangedPool() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCreateStablePool() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	for _, tc := range []struct {
+		name        string
+		msg         *types.MsgCreateStablePool
+		expectedErr string
+	}{
+		{
+			"happy case",
+			types.NewMsgCreateStablePool(
+				s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), 100),
+			"",
+		},
+		{
+			"imbalanced reserves",
+			types.NewMsgCreateStablePool(
+				s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,5000000denom2"), 100),
+			"",
+		},
+		{
+			"pair not found",
+			types.NewMsgCreateStablePool(
+				s.addr(1), 2, utils.ParseCoins("1000000denom1,1000000denom2"), 100),
+			"pair 2 not found: not found",
+		},
+		{
+			"wrong denom",
+			types.NewMsgCreateStablePool(
+				s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,1000000denom3"), 100),
+			"coin denom denom3 is not in the pair: invalid coin denom",
+		},
+		{
+			"insufficient deposit amount",
+			types.NewMsgCreateStablePool(
+				s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,10denom2"), 100),
+			"10denom2 is smaller than 1000000denom2: insufficient deposit amount",
+		},
+	} {
+		s.Run(tc.name, func() {
+			s.fundAddr(tc.msg.GetCreator(), tc.msg.DepositCoins.Add(s.keeper.GetPoolCreationFee(s.ctx)...))
+			pool, err := s.keeper.CreateStablePool(s.ctx, tc.msg)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(types.PoolTypeStable, pool.Type)
+				s.Require().Equal(tc.msg.Amplification, pool.Amplification)
+				s.Require().True(coinsEq(tc.msg.DepositCoins, s.getBalances(pool.GetReserveAddress())))
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestStablePoolMatching() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createStablePool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), 100, true)
+
+	// The stable pool provides deep liquidity around the peg, where a basic
+	// pool with the same reserves would buy only about 1000000denom2 at
+	// this price.
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.999"), sdk.NewInt(50000000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	order, found := s.keeper.GetOrder(s.ctx, pair.Id, 1)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusCompleted, order.Status)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(pair.LastPrice.GTE(utils.ParseDec("0.999")))
+
+	rx, ry := s.keeper.GetPoolBalances(s.ctx, pool)
+	s.Require().True(ry.Amount.Equal(sdk.NewInt(1050000000)))
+	// The pool paid less than 50000000denom1 but not much.
+	s.Require().True(rx.Amount.GT(sdk.NewInt(950000000)))
+	s.Require().True(rx.Amount.LT(sdk.NewInt(950050000)))
+}
+
+func (s *KeeperTestSuite) TestStablePoolDeposit() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createStablePool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), 100, true)
+
+	s.fundAddr(s.addr(1), utils.ParseCoins("1000000denom1"))
+	_, err := s.keeper.Deposit(s.ctx, types.NewMsgDeposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1")))
+	s.Require().EqualError(err, "wrong number of deposit coins: 1: invalid request")
+
+	s.deposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.nextBlock()
+	s.Require().True(coinsEq(utils.ParseCoins("2000000denom1,2000000denom2"), s.getBalances(pool.GetReserveAddress())))
+}
+
+func (s *KeeperTestSuite) TestCreateWeightedPool() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	for _, tc := range []struct {
+		name        string
+		msg         *types.MsgCreateWeightedPool
+		expectedErr string
+	}{
+		{
+			"happy case",
+			types.NewMsgCreateWeightedPool(
+				s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,4000000denom2"), 20, 80),
+			"",
+		},
+		{
+			"pair not found",
+			types.NewMsgCreateWeightedPool(
+				s.addr(1), 2, utils.ParseCoins("1000000denom1,4000000denom2"), 20, 80),
+			"pair 2 not found: not found",
+		},
+		{
+			"wrong denom",
+			types.NewMsgCreateWeightedPool(
+				s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,4000000denom3"), 20, 80),
+			"coin denom denom3 is not in the pair: invalid coin denom",
+		},
+		{
+			"insufficient deposit amount",
+			types.NewMsgCreateWeightedPool(
+				s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,10denom2"), 20, 80),
+			"10denom2 is smaller than 1000000denom2: insufficient deposit amount",
+		},
+		{
+			"invalid weights",
+			types.NewMsgCreateWeightedPool(
+				s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,4000000denom2"), 20, 70),
+			"sum of weights must be 100: invalid request",
+		},
+	} {
+		s.Run(tc.name, func() {
+			s.fundAddr(tc.msg.GetCreator(), tc.msg.DepositCoins.Add(s.keeper.GetPoolCreationFee(s.ctx)...))
+			pool, err := s.keeper.CreateWeightedPool(s.ctx, tc.msg)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(types.PoolTypeWeighted, pool.Type)
+				s.Require().Equal(tc.msg.BaseCoinWeight, pool.BaseCoinWeight)
+				s.Require().Equal(tc.msg.QuoteCoinWeight, pool.QuoteCoinWeight)
+				s.Require().True(coinsEq(tc.msg.DepositCoins, s.getBalances(pool.GetReserveAddress())))
+				rx, ry := s.keeper.GetPoolBalances(s.ctx, pool)
+				ammPool := pool.AMMPool(rx.Amount, ry.Amount, sdk.Int{})
+				s.Require().True(ammPool.Price().Equal(utils.ParseDec("1")))
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestWeightedPoolMatching() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createWeightedPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,4000000000denom2"), 20, 80, true)
+
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.99"), sdk.NewInt(10000000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	order, found := s.keeper.GetOrder(s.ctx, pair.Id, 1)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusCompleted, order.Status)
+
+	rx, ry := s.keeper.GetPoolBalances(s.ctx, pool)
+	s.Require().True(ry.Amount.Equal(sdk.NewInt(1010000000)))
+	s.Require().True(rx.Amount.Equal(sdk.NewInt(3990025000)))
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(pair.LastPrice.Equal(utils.ParseDec("0.9975")))
+}
+
+func (s *KeeperTestSuite) TestWeightedPoolDeposit() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createWeightedPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,4000000denom2"), 20, 80, true)
+
+	s.fundAddr(s.addr(1), utils.ParseCoins("1000000denom1"))
+	_, err := s.keeper.Deposit(s.ctx, types.NewMsgDeposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1")))
+	s.Require().EqualError(err, "wrong number of deposit coins: 1: invalid request")
+
+	// Coins are accepted in the ratio of the pool's reserves, which is
+	// the ratio of the weights in value.
+	s.deposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.nextBlock()
+	s.Require().True(s.getBalance(s.addr(1), "denom1").Amount.Equal(sdk.NewInt(1750000)))
+	s.Require().True(s.getBalance(s.addr(1), "denom2").Amount.IsZero())
+	s.Require().True(coinsEq(utils.ParseCoins("1250000denom1,5000000denom2"), s.getBalances(pool.GetReserveAddress())))
+
+	s.withdraw(s.addr(1), pool.Id, s.getBalance(s.addr(1), pool.PoolCoinDenom))
+	s.nextBlock()
+	rx, ry := s.keeper.GetPoolBalances(s.ctx, pool)
+	ammPool := pool.AMMPool(rx.Amount, ry.Amount, sdk.Int{})
+	s.Require().True(utils.DecApproxEqual(utils.ParseDec("1"), ammPool.Price()))
+}
+
 func (s *KeeperTestSuite) TestPoolCreationFee() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 
@@ -350,6 +542,95 @@ func (s *KeeperTestSuite) TestDepositRefundTooSmallMintedPoolCoin() {
 	s.Require().True(coinsEq(depositCoins, s.getBalances(depositor)))
 }
 
+func (s *KeeperTestSuite) TestDepositSingleSided() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+
+	for _, depositCoin := range []sdk.Coin{utils.ParseCoin("10000000denom2"), utils.ParseCoin("10000000denom1")} {
+		depositor := s.addr(1)
+		req := s.depositSingleSided(depositor, pool.Id, depositCoin, sdk.NewInt64Coin(pool.PoolCoinDenom, 1), true)
+		s.Require().Equal(types.RequestStatusNotExecuted, req.Status)
+		_, broken := keeper.DepositCoinsEscrowInvariant(s.keeper)(s.ctx)
+		s.Require().False(broken)
+
+		liquidity.EndBlocker(s.ctx, s.keeper)
+		req, _ = s.keeper.GetSingleSidedDepositRequest(s.ctx, req.PoolId, req.Id)
+		s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+
+		// About half of the deposit coin is swapped, and almost all of
+		// the rest is deposited with the swapped coin.
+		s.Require().True(req.SwappedCoin.Amount.GT(sdk.NewInt(4900000)))
+		s.Require().True(req.SwappedCoin.Amount.LT(sdk.NewInt(5000000)))
+		s.Require().True(req.MintedPoolCoin.IsPositive())
+		s.Require().True(req.RefundedCoins.AmountOf(depositCoin.Denom).LT(sdk.NewInt(100000)))
+		s.Require().True(coinsEq(
+			sdk.NewCoins(req.MintedPoolCoin).Add(req.RefundedCoins...), s.getBalances(depositor)))
+
+		s.Require().NoError(s.app.BankKeeper.SendCoins(
+			s.ctx, depositor, s.addr(9), s.getBalances(depositor)))
+		liquidity.BeginBlocker(s.ctx, s.keeper)
+		_, found := s.keeper.GetSingleSidedDepositRequest(s.ctx, req.PoolId, req.Id)
+		s.Require().False(found)
+	}
+}
+
+func (s *KeeperTestSuite) TestDepositSingleSidedRefund() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+
+	// Only about 1/200 of the pool coin supply can be minted.
+	depositor := s.addr(1)
+	depositCoin := utils.ParseCoin("10000000denom2")
+	minMintedPoolCoin := sdk.NewCoin(pool.PoolCoinDenom, s.keeper.GetPoolCoinSupply(s.ctx, pool).QuoRaw(100))
+	req := s.depositSingleSided(depositor, pool.Id, depositCoin, minMintedPoolCoin, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	req, _ = s.keeper.GetSingleSidedDepositRequest(s.ctx, req.PoolId, req.Id)
+	s.Require().Equal(types.RequestStatusFailed, req.Status)
+
+	// The whole deposit coin is refunded and the pool is untouched.
+	s.Require().True(coinsEq(sdk.NewCoins(depositCoin), s.getBalances(depositor)))
+	s.Require().True(coinsEq(utils.ParseCoins("1000000000denom1,1000000000denom2"), s.getBalances(pool.GetReserveAddress())))
+}
+
+func (s *KeeperTestSuite) TestDepositSingleSided_Validation() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	clPool := s.createConcentratedPool(s.addr(0), pair.Id, utils.ParseDec("1.0"), true)
+
+	for _, tc := range []struct {
+		name        string
+		msg         *types.MsgDepositSingleSided
+		expectedErr string
+	}{
+		{
+			"pool not found",
+			types.NewMsgDepositSingleSided(s.addr(1), 10, utils.ParseCoin("1000000denom1"), utils.ParseCoin("1pool1")),
+			"pool 10 not found: not found",
+		},
+		{
+			"concentrated pool",
+			types.NewMsgDepositSingleSided(s.addr(1), clPool.Id, utils.ParseCoin("1000000denom1"), utils.ParseCoin("1pool2")),
+			"cannot deposit to a concentrated pool; open a position instead: wrong pool type",
+		},
+		{
+			"wrong pool coin denom",
+			types.NewMsgDepositSingleSided(s.addr(1), pool.Id, utils.ParseCoin("1000000denom1"), utils.ParseCoin("1pool2")),
+			"wrong pool coin denom",
+		},
+		{
+			"wrong deposit coin denom",
+			types.NewMsgDepositSingleSided(s.addr(1), pool.Id, utils.ParseCoin("1000000denom3"), utils.ParseCoin("1pool1")),
+			"coin denom denom3 is not in the pair: invalid coin denom",
+		},
+	} {
+		s.Run(tc.name, func() {
+			s.fundAddr(tc.msg.GetDepositor(), sdk.NewCoins(tc.msg.DepositCoin))
+			_, err := s.keeper.DepositSingleSided(s.ctx, tc.msg)
+			s.Require().EqualError(err, tc.expectedErr)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestTooLargePool() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
@@ -486,6 +767,78 @@ func (s *KeeperTestSuite) TestWithdrawRequestsByWithdrawer() {
 	s.Require().Equal(req2.Id, reqs[1].Id)
 }
 
+func (s *KeeperTestSuite) TestWithdrawSingleSided() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+
+	for _, denom := range []string{"denom2", "denom1"} {
+		withdrawer := s.addr(1)
+		s.deposit(withdrawer, pool.Id, utils.ParseCoins("10000000denom1,10000000denom2"), true)
+		s.nextBlock()
+
+		poolCoin := s.getBalance(withdrawer, pool.PoolCoinDenom)
+		// Send the refunded deposit coins away, if any.
+		s.Require().NoError(s.app.BankKeeper.SendCoins(
+			s.ctx, withdrawer, s.addr(9), s.getBalances(withdrawer).Sub(sdk.NewCoins(poolCoin))))
+		req := s.withdrawSingleSided(withdrawer, pool.Id, poolCoin, sdk.NewInt64Coin(denom, 19000000))
+		s.Require().NotNil(req.SingleSided)
+		s.Require().Len(s.keeper.GetWithdrawRequestsByWithdrawer(s.ctx, withdrawer), 1)
+
+		liquidity.EndBlocker(s.ctx, s.keeper)
+		req, _ = s.keeper.GetWithdrawRequest(s.ctx, req.PoolId, req.Id)
+		s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+
+		// Most of the other coin is swapped, and the withdrawer receives
+		// about twice the amount of the wanted coin.
+		// The rest of the other coin which is not swapped due to the price
+		// tick is sent to the withdrawer as well.
+		s.Require().True(req.SingleSided.SwappedCoin.Amount.GT(sdk.NewInt(9500000)))
+		s.Require().True(req.SingleSided.ReceivedCoin.Amount.GT(sdk.NewInt(9000000)))
+		s.Require().True(req.WithdrawnCoins.AmountOf(denom).GT(sdk.NewInt(19000000)))
+		s.Require().True(coinsEq(req.WithdrawnCoins, s.getBalances(withdrawer)))
+
+		s.Require().NoError(s.app.BankKeeper.SendCoins(
+			s.ctx, withdrawer, s.addr(9), s.getBalances(withdrawer)))
+		liquidity.BeginBlocker(s.ctx, s.keeper)
+		_, found := s.keeper.GetWithdrawRequest(s.ctx, req.PoolId, req.Id)
+		s.Require().False(found)
+	}
+}
+
+func (s *KeeperTestSuite) TestWithdrawSingleSidedRefund() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+
+	withdrawer := s.addr(1)
+	s.deposit(withdrawer, pool.Id, utils.ParseCoins("10000000denom1,10000000denom2"), true)
+	s.nextBlock()
+
+	// The withdrawer can't receive the whole 20000000denom2 due to the
+	// price impact of the swap.
+	poolCoin := s.getBalance(withdrawer, pool.PoolCoinDenom)
+	req := s.withdrawSingleSided(withdrawer, pool.Id, poolCoin, utils.ParseCoin("20000000denom2"))
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	req, _ = s.keeper.GetWithdrawRequest(s.ctx, req.PoolId, req.Id)
+	s.Require().Equal(types.RequestStatusFailed, req.Status)
+
+	// The whole pool coin is refunded and the pool is untouched.
+	s.Require().True(coinsEq(sdk.NewCoins(poolCoin), s.getBalances(withdrawer)))
+	s.Require().True(coinsEq(utils.ParseCoins("1010000000denom1,1010000000denom2"), s.getBalances(pool.GetReserveAddress())))
+}
+
+func (s *KeeperTestSuite) TestWithdrawSingleSided_Validation() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	_, err := s.keeper.WithdrawSingleSided(s.ctx, types.NewMsgWithdrawSingleSided(
+		s.addr(0), pool.Id, utils.ParseCoin("10000pool1"), utils.ParseCoin("1denom3")))
+	s.Require().EqualError(err, "coin denom denom3 is not in the pair: invalid coin denom")
+
+	_, err = s.keeper.WithdrawSingleSided(s.ctx, types.NewMsgWithdrawSingleSided(
+		s.addr(0), pool.Id, utils.ParseCoin("10000pool2"), utils.ParseCoin("1denom1")))
+	s.Require().ErrorIs(err, types.ErrWrongPoolCoinDenom)
+}
+
 func (s *KeeperTestSuite) TestPoolOrderOverflow_ExternalFunds() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
@@ -0,0 +1,316 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v5/x/liquidity/amm"
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+// getNextPositionIdWithUpdate increments the pool's last position id and
+// returns it.
+func (k Keeper) getNextPositionIdWithUpdate(ctx sdk.Context, pool types.Pool) uint64 {
+	id := pool.LastPositionId + 1
+	pool.LastPositionId = id
+	k.SetPool(ctx, pool)
+	return id
+}
+
+// ValidateMsgOpenPosition validates types.MsgOpenPosition.
+func (k Keeper) ValidateMsgOpenPosition(ctx sdk.Context, msg *types.MsgOpenPosition) error {
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", msg.PoolId)
+	}
+	if pool.Type != types.PoolTypeConcentrated {
+		return sdkerrors.Wrapf(types.ErrWrongPoolType, "pool %d is not a concentrated pool", msg.PoolId)
+	}
+	if pool.Disabled {
+		return types.ErrDisabledPool
+	}
+	pair, _ := k.GetPair(ctx, pool.PairId)
+	if depositsPaused(pair, pool) {
+		return sdkerrors.Wrapf(types.ErrDepositsPaused, "pool %d", pool.Id)
+	}
+
+	tickPrec := k.GetPairTickPrecision(ctx, pair)
+	if !amm.PriceToDownTick(msg.LowerPrice, tickPrec).Equal(msg.LowerPrice) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "lower price is not on ticks")
+	}
+	if !amm.PriceToDownTick(msg.UpperPrice, tickPrec).Equal(msg.UpperPrice) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "upper price is not on ticks")
+	}
+	lowestTick := amm.LowestTick(tickPrec)
+	if msg.LowerPrice.LT(lowestTick) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lower price must not be less than %s", lowestTick)
+	}
+
+	for _, coin := range msg.DepositCoins {
+		if coin.Denom != pair.BaseCoinDenom && coin.Denom != pair.QuoteCoinDenom {
+			return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", coin.Denom)
+		}
+	}
+
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	if rx.Amount.Add(msg.DepositCoins.AmountOf(rx.Denom)).GT(amm.MaxCoinAmount) {
+		return types.ErrTooLargePool
+	}
+	if ry.Amount.Add(msg.DepositCoins.AmountOf(ry.Denom)).GT(amm.MaxCoinAmount) {
+		return types.ErrTooLargePool
+	}
+
+	return nil
+}
+
+// OpenPosition handles types.MsgOpenPosition and opens a new position
+// in a concentrated pool.
+// The position's liquidity is the maximum liquidity that the deposit coins
+// can provide within the position's price range at the current pool price,
+// and only the coins needed for the liquidity are taken from the owner.
+func (k Keeper) OpenPosition(ctx sdk.Context, msg *types.MsgOpenPosition) (types.Position, error) {
+	if err := k.ValidateMsgOpenPosition(ctx, msg); err != nil {
+		return types.Position{}, err
+	}
+
+	pool, _ := k.GetPool(ctx, msg.PoolId)
+	pair, _ := k.GetPair(ctx, pool.PairId)
+
+	x, y := msg.DepositCoins.AmountOf(pair.QuoteCoinDenom), msg.DepositCoins.AmountOf(pair.BaseCoinDenom)
+	liquidity := amm.ConcentratedLiquidityForAmounts(x, y, msg.LowerPrice, msg.UpperPrice, *pool.Price)
+	if !liquidity.IsPositive() {
+		return types.Position{}, sdkerrors.Wrap(types.ErrInsufficientDepositAmount, "deposit coins provide no liquidity")
+	}
+	ax, ay := amm.ConcentratedLiquidityAmounts(liquidity, msg.LowerPrice, msg.UpperPrice, *pool.Price)
+	acceptedCoins := sdk.NewCoins(
+		sdk.NewCoin(pair.QuoteCoinDenom, sdk.MinInt(ax.Ceil().TruncateInt(), x)),
+		sdk.NewCoin(pair.BaseCoinDenom, sdk.MinInt(ay.Ceil().TruncateInt(), y)))
+
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetOwner(), pool.GetReserveAddress(), acceptedCoins); err != nil {
+		return types.Position{}, err
+	}
+
+	id := k.getNextPositionIdWithUpdate(ctx, pool)
+	pool, _ = k.GetPool(ctx, msg.PoolId)
+	pool.AddLiquidity(msg.LowerPrice, msg.UpperPrice, liquidity)
+	k.SetPool(ctx, pool)
+
+	position := types.NewPosition(id, pool.Id, msg.GetOwner(), msg.LowerPrice, msg.UpperPrice, liquidity)
+	k.SetPosition(ctx, position)
+	k.SetPositionIndex(ctx, position)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeOpenPosition,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(position.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyLowerPrice, msg.LowerPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyUpperPrice, msg.UpperPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyAcceptedCoins, acceptedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidity, liquidity.String()),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOpenPosition{
+		Owner:         msg.Owner,
+		PoolId:        msg.PoolId,
+		PositionId:    position.Id,
+		LowerPrice:    msg.LowerPrice,
+		UpperPrice:    msg.UpperPrice,
+		DepositCoins:  msg.DepositCoins,
+		AcceptedCoins: acceptedCoins,
+		Liquidity:     liquidity,
+	}); err != nil {
+		return types.Position{}, err
+	}
+
+	return position, nil
+}
+
+// validatePositionOwner returns the position if it exists and is owned by
+// the owner.
+func (k Keeper) validatePositionOwner(ctx sdk.Context, owner string, poolId, positionId uint64) (types.Position, error) {
+	position, found := k.GetPosition(ctx, poolId, positionId)
+	if !found {
+		return types.Position{},
+			sdkerrors.Wrapf(sdkerrors.ErrNotFound, "position %d not found in pool %d", positionId, poolId)
+	}
+	if owner != position.Owner {
+		return types.Position{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "mismatching owner")
+	}
+	return position, nil
+}
+
+// ValidateMsgClosePosition validates types.MsgClosePosition and returns
+// the position to be closed.
+func (k Keeper) ValidateMsgClosePosition(ctx sdk.Context, msg *types.MsgClosePosition) (types.Position, error) {
+	return k.validatePositionOwner(ctx, msg.Owner, msg.PoolId, msg.PositionId)
+}
+
+// ClosePosition handles types.MsgClosePosition and closes a position.
+// The position's liquidity is removed from the pool and the owner receives
+// the coins of the liquidity at the current pool price, along with the fees
+// accrued to the position.
+func (k Keeper) ClosePosition(ctx sdk.Context, msg *types.MsgClosePosition) error {
+	position, err := k.ValidateMsgClosePosition(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	pool, _ := k.GetPool(ctx, position.PoolId)
+	pair, _ := k.GetPair(ctx, pool.PairId)
+
+	x, y := position.Amounts(*pool.Price)
+	withdrawnCoins := k.capByPoolBalances(ctx, pool, pair, sdk.NewCoins(
+		sdk.NewCoin(pair.QuoteCoinDenom, x.TruncateInt()),
+		sdk.NewCoin(pair.BaseCoinDenom, y.TruncateInt())).Add(position.Fees...))
+
+	pool.AddLiquidity(position.LowerPrice, position.UpperPrice, position.Liquidity.Neg())
+	k.SetPool(ctx, pool)
+	k.DeletePosition(ctx, position)
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetReserveAddress(), position.GetOwner(), withdrawnCoins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClosePosition,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyLiquidity, position.Liquidity.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawnCoins, withdrawnCoins.String()),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClosePosition{
+		Owner:          msg.Owner,
+		PoolId:         msg.PoolId,
+		PositionId:     msg.PositionId,
+		Liquidity:      position.Liquidity,
+		WithdrawnCoins: withdrawnCoins,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateMsgCollectFees validates types.MsgCollectFees and returns
+// the position whose fees are to be collected.
+func (k Keeper) ValidateMsgCollectFees(ctx sdk.Context, msg *types.MsgCollectFees) (types.Position, error) {
+	return k.validatePositionOwner(ctx, msg.Owner, msg.PoolId, msg.PositionId)
+}
+
+// CollectFees handles types.MsgCollectFees and sends the fees accrued to
+// a position to its owner.
+func (k Keeper) CollectFees(ctx sdk.Context, msg *types.MsgCollectFees) error {
+	position, err := k.ValidateMsgCollectFees(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	pool, _ := k.GetPool(ctx, position.PoolId)
+	pair, _ := k.GetPair(ctx, pool.PairId)
+
+	fees := k.capByPoolBalances(ctx, pool, pair, position.Fees)
+	position.Fees = nil
+	k.SetPosition(ctx, position)
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetReserveAddress(), position.GetOwner(), fees); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCollectFees,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyFees, fees.String()),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCollectFees{
+		Owner:      msg.Owner,
+		PoolId:     msg.PoolId,
+		PositionId: msg.PositionId,
+		Fees:       fees,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// capByPoolBalances caps the coins by the pool's reserve balances, so that
+// rounding errors never make the pool's reserve account insufficient.
+func (k Keeper) capByPoolBalances(ctx sdk.Context, pool types.Pool, pair types.Pair, coins sdk.Coins) sdk.Coins {
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	return sdk.NewCoins(
+		sdk.NewCoin(rx.Denom, sdk.MinInt(coins.AmountOf(rx.Denom), rx.Amount)),
+		sdk.NewCoin(ry.Denom, sdk.MinInt(coins.AmountOf(ry.Denom), ry.Amount)))
+}
+
+// applyConcentratedPoolMatchResult moves the price of a concentrated pool
+// along its liquidity curve after the pool's orders have been matched,
+// and distributes the pool's profit from the matching to the positions
+// as fees.
+// The profit is the difference between the quote coin amount that the
+// liquidity curve would pay(or receive) and the amount actually paid(or
+// received) by the pool orders, and each position gets a share of it
+// proportional to its liquidity used in the matching.
+func (k Keeper) applyConcentratedPoolMatchResult(
+	ctx sdk.Context, pool types.Pool, pair types.Pair, dir types.OrderDirection,
+	paidCoin, receivedCoin sdk.Coin, matchedAmt sdk.Int) {
+	ammPool := pool.AMMPool(sdk.Int{}, sdk.Int{}, sdk.Int{})
+	rx, ry := ammPool.Balances()
+	switch dir {
+	case types.OrderDirectionBuy:
+		ammPool.SetBalances(rx.Sub(paidCoin.Amount), ry.Add(matchedAmt), false)
+	case types.OrderDirectionSell:
+		ammPool.SetBalances(rx.Add(receivedCoin.Amount), ry.Sub(matchedAmt), false)
+	}
+	oldPrice, newPrice := *pool.Price, ammPool.Price()
+	pool.Price = &newPrice
+	k.SetPool(ctx, pool)
+
+	var (
+		positions []types.Position
+		usedAmts  []sdk.Dec
+	)
+	totalUsedAmt := sdk.ZeroDec()
+	_ = k.IteratePositionsByPool(ctx, pool.Id, func(position types.Position) (stop bool, err error) {
+		x0, _ := position.Amounts(oldPrice)
+		x1, _ := position.Amounts(newPrice)
+		if usedAmt := x1.Sub(x0).Abs(); usedAmt.IsPositive() {
+			positions = append(positions, position)
+			usedAmts = append(usedAmts, usedAmt)
+			totalUsedAmt = totalUsedAmt.Add(usedAmt)
+		}
+		return false, nil
+	})
+	if !totalUsedAmt.IsPositive() {
+		return
+	}
+
+	var profit sdk.Dec
+	switch dir {
+	case types.OrderDirectionBuy:
+		profit = totalUsedAmt.Sub(paidCoin.Amount.ToDec())
+	case types.OrderDirectionSell:
+		profit = receivedCoin.Amount.ToDec().Sub(totalUsedAmt)
+	}
+	if !profit.IsPositive() {
+		return
+	}
+	for i, position := range positions {
+		fee := profit.Mul(usedAmts[i]).QuoTruncate(totalUsedAmt).TruncateInt()
+		if fee.IsPositive() {
+			position.Fees = position.Fees.Add(sdk.NewCoin(pair.QuoteCoinDenom, fee))
+			k.SetPosition(ctx, position)
+		}
+	}
+}
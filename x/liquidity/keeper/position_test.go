@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/liquidity"
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+
+	_ "github.com/stretchr/testify/suite"
+)
+
+func (s *KeeperTestSuite) TestCreateConcentratedPool() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	for _, tc := range []struct {
+		name        string
+		msg         *types.MsgCreateConcentratedPool
+		expectedErr string
+	}{
+		{
+			"happy case",
+			types.NewMsgCreateConcentratedPool(s.addr(1), pair.Id, utils.ParseDec("1.0")),
+			"",
+		},
+		{
+			"pair not found",
+			types.NewMsgCreateConcentratedPool(s.addr(1), 2, utils.ParseDec("1.0")),
+			"pair 2 not found: not found",
+		},
+		{
+			"initial price not on ticks",
+			types.NewMsgCreateConcentratedPool(s.addr(1), pair.Id, utils.ParseDec("1.00001")),
+			"initial price is not on ticks: invalid request",
+		},
+	} {
+		s.Run(tc.name, func() {
+			s.fundAddr(tc.msg.GetCreator(), s.keeper.GetPoolCreationFee(s.ctx))
+			pool, err := s.keeper.CreateConcentratedPool(s.ctx, tc.msg)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(types.PoolTypeConcentrated, pool.Type)
+				s.Require().True(pool.Price.Equal(tc.msg.InitialPrice))
+				s.Require().Empty(pool.LiquidityTicks)
+				s.Require().True(s.getBalances(pool.GetReserveAddress()).IsZero())
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestOpenPosition() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createConcentratedPool(s.addr(0), pair.Id, utils.ParseDec("1.0"), true)
+	basicPool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	for _, tc := range []struct {
+		name        string
+		msg         *types.MsgOpenPosition
+		expectedErr string
+	}{
+		{
+			"pool not found",
+			types.NewMsgOpenPosition(
+				s.addr(1), 10, utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseCoins("1000000denom1,1000000denom2")),
+			"pool 10 not found: not found",
+		},
+		{
+			"not a concentrated pool",
+			types.NewMsgOpenPosition(
+				s.addr(1), basicPool.Id, utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseCoins("1000000denom1,1000000denom2")),
+			"pool 2 is not a concentrated pool: wrong pool type",
+		},
+		{
+			"price not on ticks",
+			types.NewMsgOpenPosition(
+				s.addr(1), pool.Id, utils.ParseDec("0.500001"), utils.ParseDec("2.0"), utils.ParseCoins("1000000denom1,1000000denom2")),
+			"lower price is not on ticks: invalid request",
+		},
+		{
+			"wrong denom",
+			types.NewMsgOpenPosition(
+				s.addr(1), pool.Id, utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseCoins("1000000denom1,1000000denom3")),
+			"coin denom denom3 is not in the pair: invalid coin denom",
+		},
+		{
+			"no liquidity",
+			types.NewMsgOpenPosition(
+				s.addr(1), pool.Id, utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseCoins("1000000denom1")),
+			"deposit coins provide no liquidity: insufficient deposit amount",
+		},
+	} {
+		s.Run(tc.name, func() {
+			s.fundAddr(tc.msg.GetOwner(), tc.msg.DepositCoins)
+			_, err := s.keeper.OpenPosition(s.ctx, tc.msg)
+			s.Require().EqualError(err, tc.expectedErr)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestOpenPosition_AcceptedCoins() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createConcentratedPool(s.addr(0), pair.Id, utils.ParseDec("1.0"), true)
+
+	// Only the coins needed for the liquidity are taken.
+	owner := s.addr(1)
+	position := s.openPosition(
+		owner, pool.Id, utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseCoins("1000000denom1,2000000denom2"), true)
+	s.Require().EqualValues(1, position.Id)
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1,1000000denom2"), s.getBalances(pool.GetReserveAddress())))
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom2"), s.getBalances(owner)))
+
+	pool, _ = s.keeper.GetPool(s.ctx, pool.Id)
+	s.Require().EqualValues(1, pool.LastPositionId)
+	s.Require().Len(pool.LiquidityTicks, 2)
+	s.Require().True(pool.LiquidityTicks[0].Price.Equal(utils.ParseDec("0.5")))
+	s.Require().True(pool.LiquidityTicks[0].LiquidityNet.Equal(position.Liquidity))
+	s.Require().True(pool.LiquidityTicks[1].Price.Equal(utils.ParseDec("2.0")))
+	s.Require().True(pool.LiquidityTicks[1].LiquidityNet.Equal(position.Liquidity.Neg()))
+
+	// A position whose range is above the current price takes base coin only.
+	position2 := s.openPosition(
+		owner, pool.Id, utils.ParseDec("1.5"), utils.ParseDec("2.0"), utils.ParseCoins("1000000denom1"), true)
+	s.Require().EqualValues(2, position2.Id)
+	s.Require().True(coinsEq(utils.ParseCoins("2000000denom1,1000000denom2"), s.getBalances(pool.GetReserveAddress())))
+
+	pool, _ = s.keeper.GetPool(s.ctx, pool.Id)
+	s.Require().Len(pool.LiquidityTicks, 3)
+	s.Require().True(pool.LiquidityTicks[1].Price.Equal(utils.ParseDec("1.5")))
+
+	s.Require().Len(s.keeper.GetPositionsByPool(s.ctx, pool.Id), 2)
+	s.Require().Len(s.keeper.GetPositionsByOwner(s.ctx, owner), 2)
+}
+
+func (s *KeeperTestSuite) TestClosePosition() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createConcentratedPool(s.addr(0), pair.Id, utils.ParseDec("1.0"), true)
+
+	owner := s.addr(1)
+	position := s.openPosition(
+		owner, pool.Id, utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	err := s.keeper.ClosePosition(s.ctx, types.NewMsgClosePosition(s.addr(2), pool.Id, position.Id))
+	s.Require().EqualError(err, "mismatching owner: unauthorized")
+	err = s.keeper.ClosePosition(s.ctx, types.NewMsgClosePosition(owner, pool.Id, 10))
+	s.Require().EqualError(err, "position 10 not found in pool 1: not found")
+
+	s.Require().NoError(s.keeper.ClosePosition(s.ctx, types.NewMsgClosePosition(owner, pool.Id, position.Id)))
+	// Less than 1 unit of each coin is lost due to the truncation.
+	s.Require().True(coinsEq(utils.ParseCoins("999999denom1,999999denom2"), s.getBalances(owner)))
+
+	_, found := s.keeper.GetPosition(s.ctx, pool.Id, position.Id)
+	s.Require().False(found)
+	pool, _ = s.keeper.GetPool(s.ctx, pool.Id)
+	s.Require().Empty(pool.LiquidityTicks)
+	s.Require().False(pool.Disabled)
+}
+
+func (s *KeeperTestSuite) TestConcentratedPoolMatching() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createConcentratedPool(s.addr(0), pair.Id, utils.ParseDec("1.0"), true)
+
+	owner1, owner2 := s.addr(1), s.addr(2)
+	position1 := s.openPosition(
+		owner1, pool.Id, utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	position2 := s.openPosition(
+		owner2, pool.Id, utils.ParseDec("0.9"), utils.ParseDec("1.1"), utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+
+	s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("0.95"), sdk.NewInt(100000000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	order, found := s.keeper.GetOrder(s.ctx, pair.Id, 1)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusCompleted, order.Status)
+
+	// The pool price moved down along the liquidity curve.
+	pool, _ = s.keeper.GetPool(s.ctx, pool.Id)
+	s.Require().True(pool.Price.LT(utils.ParseDec("1.0")))
+	s.Require().True(pool.Price.GTE(utils.ParseDec("0.95")))
+
+	// Both positions earned fees, and the narrower position with more
+	// liquidity around the price earned more.
+	position1, _ = s.keeper.GetPosition(s.ctx, pool.Id, position1.Id)
+	position2, _ = s.keeper.GetPosition(s.ctx, pool.Id, position2.Id)
+	fee1, fee2 := position1.Fees.AmountOf("denom2"), position2.Fees.AmountOf("denom2")
+	s.Require().True(fee1.IsPositive())
+	s.Require().True(fee2.GT(fee1))
+
+	s.Require().NoError(s.keeper.CollectFees(s.ctx, types.NewMsgCollectFees(owner1, pool.Id, position1.Id)))
+	s.Require().True(intEq(fee1, s.getBalance(owner1, "denom2").Amount))
+	position1, _ = s.keeper.GetPosition(s.ctx, pool.Id, position1.Id)
+	s.Require().True(position1.Fees.IsZero())
+
+	// The pool's reserve always covers the positions and their fees.
+	s.Require().NoError(s.keeper.ClosePosition(s.ctx, types.NewMsgClosePosition(owner1, pool.Id, position1.Id)))
+	s.Require().NoError(s.keeper.ClosePosition(s.ctx, types.NewMsgClosePosition(owner2, pool.Id, position2.Id)))
+	reserve := s.getBalances(pool.GetReserveAddress())
+	s.Require().True(reserve.AmountOf("denom1").LTE(sdk.NewInt(2)))
+	s.Require().True(reserve.AmountOf("denom2").LTE(sdk.NewInt(2)))
+}
+
+func (s *KeeperTestSuite) TestConcentratedPoolWithoutLiquidity() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createConcentratedPool(s.addr(0), pair.Id, utils.ParseDec("1.0"), true)
+
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// The pool is not disabled even though it has no liquidity.
+	pool, _ = s.keeper.GetPool(s.ctx, pool.Id)
+	s.Require().False(pool.Disabled)
+
+	_, err := s.keeper.Deposit(s.ctx, types.NewMsgDeposit(s.addr(2), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2")))
+	s.Require().EqualError(err, "cannot deposit to a concentrated pool; open a position instead: wrong pool type")
+}
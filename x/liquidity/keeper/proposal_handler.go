@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+// HandlePairFeeRatesProposal is a handler for executing a pair fee rates proposal.
+func HandlePairFeeRatesProposal(ctx sdk.Context, k Keeper, p *types.PairFeeRatesProposal) error {
+	for _, change := range p.Changes {
+		pair, found := k.GetPair(ctx, change.PairId)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", change.PairId)
+		}
+		if err := k.ChangePairFeeRates(ctx, pair, change.MakerFeeRate, change.TakerFeeRate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleStablePoolAmplificationProposal is a handler for executing a stable
+// pool amplification proposal.
+func HandleStablePoolAmplificationProposal(ctx sdk.Context, k Keeper, p *types.StablePoolAmplificationProposal) error {
+	for _, change := range p.Changes {
+		pool, found := k.GetPool(ctx, change.PoolId)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", change.PoolId)
+		}
+		if pool.Type != types.PoolTypeStable {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pool %d is not a stable pool", change.PoolId)
+		}
+		if err := k.ChangeStablePoolAmplification(ctx, pool, change.Amplification); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandlePoolManagementProposal is a handler for executing a pool management
+// proposal.
+// Pair changes are applied first, then pool changes and then migrations.
+func HandlePoolManagementProposal(ctx sdk.Context, k Keeper, p *types.PoolManagementProposal) error {
+	for _, change := range p.PairChanges {
+		pair, found := k.GetPair(ctx, change.PairId)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", change.PairId)
+		}
+		if err := k.ChangePairStatus(ctx, pair, change.DepositsPaused, change.OrdersPaused); err != nil {
+			return err
+		}
+	}
+	for _, change := range p.PoolChanges {
+		pool, found := k.GetPool(ctx, change.PoolId)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", change.PoolId)
+		}
+		if err := k.ChangePoolStatus(ctx, pool, change.DepositsPaused, change.OrdersPaused, change.Disabled); err != nil {
+			return err
+		}
+	}
+	for _, migration := range p.Migrations {
+		sourcePool, found := k.GetPool(ctx, migration.SourcePoolId)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", migration.SourcePoolId)
+		}
+		targetPool, found := k.GetPool(ctx, migration.TargetPoolId)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", migration.TargetPoolId)
+		}
+		if err := k.MigratePool(ctx, sourcePool, targetPool); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandlePairOrderRulesProposal is a handler for executing a pair order rules
+// proposal.
+func HandlePairOrderRulesProposal(ctx sdk.Context, k Keeper, p *types.PairOrderRulesProposal) error {
+	for _, change := range p.Changes {
+		pair, found := k.GetPair(ctx, change.PairId)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", change.PairId)
+		}
+		if err := k.ChangePairOrderRules(ctx, pair, change.TickPrecision, change.MinOrderNotional); err != nil {
+			return err
+		}
+	}
+	return nil
+}
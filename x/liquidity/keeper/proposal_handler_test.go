@@ -0,0 +1,327 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestPairFeeRatesProposalHandler() {
+	pair1 := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+
+	makerFeeRate, takerFeeRate := utils.ParseDec("0.001"), utils.ParseDec("0.003")
+	proposal := types.NewPairFeeRatesProposal(
+		"Change pair fee rates", "Description",
+		[]types.PairFeeRatesChange{
+			types.NewPairFeeRatesChange(pair1.Id, &makerFeeRate, &takerFeeRate),
+			types.NewPairFeeRatesChange(pair2.Id, nil, &takerFeeRate),
+		})
+	s.handleProposal(proposal)
+
+	pair1, _ = s.keeper.GetPair(s.ctx, pair1.Id)
+	s.Require().Equal(makerFeeRate, *pair1.MakerFeeRate)
+	s.Require().Equal(takerFeeRate, *pair1.TakerFeeRate)
+	pair2, _ = s.keeper.GetPair(s.ctx, pair2.Id)
+	s.Require().Nil(pair2.MakerFeeRate)
+	s.Require().Equal(takerFeeRate, *pair2.TakerFeeRate)
+
+	// Unset fee rates are zero.
+	maker, taker := s.keeper.GetPairFeeRates(pair2)
+	s.Require().True(maker.IsZero())
+	s.Require().Equal(takerFeeRate, taker)
+
+	// Remove the fees of pair 1.
+	proposal = types.NewPairFeeRatesProposal(
+		"Remove pair fees", "Description",
+		[]types.PairFeeRatesChange{types.NewPairFeeRatesChange(pair1.Id, nil, nil)})
+	s.handleProposal(proposal)
+
+	pair1, _ = s.keeper.GetPair(s.ctx, pair1.Id)
+	s.Require().Nil(pair1.MakerFeeRate)
+	s.Require().Nil(pair1.TakerFeeRate)
+
+	proposal = types.NewPairFeeRatesProposal(
+		"Change pair fee rates", "Description",
+		[]types.PairFeeRatesChange{types.NewPairFeeRatesChange(3, &makerFeeRate, &takerFeeRate)})
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().EqualError(s.govHandler(s.ctx, proposal), "pair 3 not found: not found")
+}
+
+func (s *KeeperTestSuite) TestStablePoolAmplificationProposalHandler() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	basicPool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	stablePool := s.createStablePool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), 100, true)
+
+	proposal := types.NewStablePoolAmplificationProposal(
+		"Change amplification", "Description",
+		[]types.StablePoolAmplificationChange{
+			types.NewStablePoolAmplificationChange(stablePool.Id, 500),
+		})
+	s.handleProposal(proposal)
+
+	stablePool, _ = s.keeper.GetPool(s.ctx, stablePool.Id)
+	s.Require().EqualValues(500, stablePool.Amplification)
+
+	proposal = types.NewStablePoolAmplificationProposal(
+		"Change amplification", "Description",
+		[]types.StablePoolAmplificationChange{
+			types.NewStablePoolAmplificationChange(basicPool.Id, 500),
+		})
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().EqualError(s.govHandler(s.ctx, proposal), "pool 1 is not a stable pool: invalid request")
+
+	proposal = types.NewStablePoolAmplificationProposal(
+		"Change amplification", "Description",
+		[]types.StablePoolAmplificationChange{
+			types.NewStablePoolAmplificationChange(3, 500),
+		})
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().EqualError(s.govHandler(s.ctx, proposal), "pool 3 not found: not found")
+}
+
+func (s *KeeperTestSuite) TestPoolManagementProposalHandler_Pause() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	// Pause deposits and orders of the pair.
+	s.handleProposal(types.NewPoolManagementProposal(
+		"Pause pair", "Description",
+		[]types.PairStatusChange{types.NewPairStatusChange(pair.Id, true, true)}, nil, nil))
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(pair.DepositsPaused)
+	s.Require().True(pair.OrdersPaused)
+
+	depositCoins := utils.ParseCoins("1000000denom1,1000000denom2")
+	s.fundAddr(s.addr(1), depositCoins)
+	_, err := s.keeper.Deposit(s.ctx, types.NewMsgDeposit(s.addr(1), pool.Id, depositCoins))
+	s.Require().ErrorIs(err, types.ErrDepositsPaused)
+
+	offerCoin := utils.ParseCoin("1000000denom2")
+	s.fundAddr(s.addr(2), sdk.NewCoins(offerCoin))
+	_, err = s.keeper.LimitOrder(s.ctx, types.NewMsgLimitOrder(
+		s.addr(2), pair.Id, types.OrderDirectionBuy, offerCoin, "denom1",
+		utils.ParseDec("1.0"), sdk.NewInt(1000000), 0))
+	s.Require().ErrorIs(err, types.ErrOrdersPaused)
+
+	// Resume the pair and pause orders of the pool only.
+	s.handleProposal(types.NewPoolManagementProposal(
+		"Resume pair", "Description",
+		[]types.PairStatusChange{types.NewPairStatusChange(pair.Id, false, false)},
+		[]types.PoolStatusChange{types.NewPoolStatusChange(pool.Id, false, true, false)}, nil))
+
+	s.deposit(s.addr(1), pool.Id, depositCoins, false)
+	// The pool doesn't take the order.
+	order := s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.05"), sdk.NewInt(900000), time.Hour, false)
+	s.nextBlock()
+	s.Require().True(s.getBalance(s.addr(1), pool.PoolCoinDenom).IsPositive())
+	order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().Equal(types.OrderStatusNotMatched, order.Status)
+}
+
+func (s *KeeperTestSuite) TestPoolManagementProposalHandler_Reenable() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	s.handleProposal(types.NewPoolManagementProposal(
+		"Disable pool", "Description", nil,
+		[]types.PoolStatusChange{types.NewPoolStatusChange(pool.Id, false, false, true)}, nil))
+
+	depositCoins := utils.ParseCoins("1000000denom1,1000000denom2")
+	s.fundAddr(s.addr(1), depositCoins)
+	_, err := s.keeper.Deposit(s.ctx, types.NewMsgDeposit(s.addr(1), pool.Id, depositCoins))
+	s.Require().ErrorIs(err, types.ErrDisabledPool)
+
+	// A new basic pool can be created while the old one is disabled.
+	pool2 := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	proposal := types.NewPoolManagementProposal(
+		"Re-enable pool", "Description", nil,
+		[]types.PoolStatusChange{types.NewPoolStatusChange(pool.Id, false, false, false)}, nil)
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().EqualError(s.govHandler(s.ctx, proposal), "pair 1 already has an active basic pool: pool already exists")
+
+	s.handleProposal(types.NewPoolManagementProposal(
+		"Re-enable pool", "Description", nil,
+		[]types.PoolStatusChange{
+			types.NewPoolStatusChange(pool2.Id, false, false, true),
+			types.NewPoolStatusChange(pool.Id, false, false, false),
+		}, nil))
+
+	s.deposit(s.addr(1), pool.Id, depositCoins, false)
+	s.nextBlock()
+	s.Require().True(s.getBalance(s.addr(1), pool.PoolCoinDenom).IsPositive())
+
+	// A depleted pool cannot be re-enabled.
+	s.sendCoins(pool2.GetReserveAddress(), s.addr(3), s.getBalances(pool2.GetReserveAddress()))
+	proposal = types.NewPoolManagementProposal(
+		"Re-enable pool", "Description", nil,
+		[]types.PoolStatusChange{types.NewPoolStatusChange(pool2.Id, false, false, false)}, nil)
+	s.Require().EqualError(s.govHandler(s.ctx, proposal), "pool 2 is depleted: disabled pool")
+}
+
+func (s *KeeperTestSuite) TestPoolManagementProposalHandler_WithdrawFromDisabledPool() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.deposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.nextBlock()
+
+	s.handleProposal(types.NewPoolManagementProposal(
+		"Disable pool", "Description", nil,
+		[]types.PoolStatusChange{types.NewPoolStatusChange(pool.Id, false, false, true)}, nil))
+
+	// LPs can still withdraw from the disabled pool.
+	poolCoin := s.getBalance(s.addr(1), pool.PoolCoinDenom)
+	req := s.withdraw(s.addr(1), pool.Id, poolCoin)
+	s.keeper.ExecuteRequests(s.ctx)
+	req, _ = s.keeper.GetWithdrawRequest(s.ctx, pool.Id, req.Id)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+	s.Require().True(s.getBalance(s.addr(1), pool.PoolCoinDenom).IsZero())
+	s.Require().True(s.getBalance(s.addr(1), "denom1").IsPositive())
+	s.Require().True(s.getBalance(s.addr(1), "denom2").IsPositive())
+
+	// Single-sided withdrawals swap against the pool, so they are rejected.
+	_, err := s.keeper.WithdrawSingleSided(s.ctx, types.NewMsgWithdrawSingleSided(
+		s.addr(0), pool.Id, s.getBalance(s.addr(0), pool.PoolCoinDenom), utils.ParseCoin("1denom1")))
+	s.Require().ErrorIs(err, types.ErrDisabledPool)
+
+	// Once the pool is depleted, withdrawals are rejected.
+	s.withdraw(s.addr(0), pool.Id, s.getBalance(s.addr(0), pool.PoolCoinDenom))
+	s.nextBlock()
+	s.Require().True(s.getBalance(s.addr(0), pool.PoolCoinDenom).IsZero())
+	_, err = s.keeper.Withdraw(s.ctx, types.NewMsgWithdraw(s.addr(2), pool.Id, sdk.NewInt64Coin(pool.PoolCoinDenom, 1)))
+	s.Require().ErrorIs(err, types.ErrDisabledPool)
+}
+
+func (s *KeeperTestSuite) TestPoolManagementProposalHandler_Migrate() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	rangedPool := s.createRangedPool(
+		s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"), true)
+
+	s.deposit(s.addr(1), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	s.nextBlock()
+
+	rangedPoolCoinSupply := s.keeper.GetPoolCoinSupply(s.ctx, rangedPool)
+	s.handleProposal(types.NewPoolManagementProposal(
+		"Migrate pool", "Description", nil, nil,
+		[]types.PoolMigration{types.NewPoolMigration(pool.Id, rangedPool.Id)}))
+
+	pool, _ = s.keeper.GetPool(s.ctx, pool.Id)
+	s.Require().True(pool.Disabled)
+	s.Require().Equal(rangedPool.Id, pool.MigratedToPoolId)
+
+	// The source pool's reserve keeps the minted pool coin of the target pool.
+	migratedPoolCoin := s.getBalance(pool.GetReserveAddress(), rangedPool.PoolCoinDenom)
+	s.Require().True(migratedPoolCoin.IsPositive())
+	s.Require().True(intEq(
+		rangedPoolCoinSupply.Add(migratedPoolCoin.Amount), s.keeper.GetPoolCoinSupply(s.ctx, rangedPool)))
+
+	// The migrated pool accepts withdrawals only.
+	depositCoins := utils.ParseCoins("1000000denom1,1000000denom2")
+	s.fundAddr(s.addr(2), depositCoins)
+	_, err := s.keeper.Deposit(s.ctx, types.NewMsgDeposit(s.addr(2), pool.Id, depositCoins))
+	s.Require().ErrorIs(err, types.ErrDisabledPool)
+	_, err = s.keeper.WithdrawSingleSided(s.ctx, types.NewMsgWithdrawSingleSided(
+		s.addr(1), pool.Id, s.getBalance(s.addr(1), pool.PoolCoinDenom), utils.ParseCoin("1denom1")))
+	s.Require().ErrorIs(err, types.ErrDisabledPool)
+	proposal := types.NewPoolManagementProposal(
+		"Re-enable pool", "Description", nil,
+		[]types.PoolStatusChange{types.NewPoolStatusChange(pool.Id, false, false, false)}, nil)
+	s.Require().EqualError(s.govHandler(s.ctx, proposal), "pool 1 has been migrated to pool 2: disabled pool")
+
+	// Each LP receives the pro-rata share of the migrated liquidity.
+	s.withdraw(s.addr(1), pool.Id, s.getBalance(s.addr(1), pool.PoolCoinDenom))
+	s.nextBlock()
+	s.Require().True(coinEq(
+		sdk.NewCoin(rangedPool.PoolCoinDenom, migratedPoolCoin.Amount.QuoRaw(2)),
+		s.getBalance(s.addr(1), rangedPool.PoolCoinDenom)))
+
+	// The last withdrawer takes all the coins left.
+	s.withdraw(s.addr(0), pool.Id, s.getBalance(s.addr(0), pool.PoolCoinDenom))
+	s.nextBlock()
+	s.Require().True(s.getBalances(pool.GetReserveAddress()).IsZero())
+
+	// The pool coin of the target pool can be withdrawn from the target pool.
+	s.withdraw(s.addr(1), rangedPool.Id, s.getBalance(s.addr(1), rangedPool.PoolCoinDenom))
+	s.nextBlock()
+	s.Require().True(s.getBalance(s.addr(1), "denom1").IsPositive())
+	s.Require().True(s.getBalance(s.addr(1), "denom2").IsPositive())
+}
+
+func (s *KeeperTestSuite) TestPoolManagementProposalHandler_MigrateFailures() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+	pool2 := s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000denom2,1000000denom3"), true)
+	clPool := s.createConcentratedPool(s.addr(0), pair.Id, utils.ParseDec("1.0"), true)
+
+	for _, tc := range []struct {
+		migration   types.PoolMigration
+		expectedErr string
+	}{
+		{types.NewPoolMigration(pool.Id, pool2.Id), "pool 1 and pool 2 are not in the same pair: wrong denom pair"},
+		{types.NewPoolMigration(pool.Id, clPool.Id), "cannot migrate liquidity from or to a concentrated pool: wrong pool type"},
+		{types.NewPoolMigration(pool.Id, 10), "pool 10 not found: not found"},
+	} {
+		proposal := types.NewPoolManagementProposal(
+			"Migrate pool", "Description", nil, nil, []types.PoolMigration{tc.migration})
+		s.Require().NoError(proposal.ValidateBasic())
+		s.Require().EqualError(s.govHandler(s.ctx, proposal), tc.expectedErr)
+	}
+}
+
+func (s *KeeperTestSuite) TestPairOrderRulesProposalHandler() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	// Before the proposal, the tick precision param is used.
+	s.Require().Equal(int(s.keeper.GetTickPrecision(s.ctx)), s.keeper.GetPairTickPrecision(s.ctx, pair))
+	order := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.234"), sdk.NewInt(1000), time.Hour, true)
+	s.Require().True(decEq(utils.ParseDec("1.234"), order.Price))
+
+	tickPrec := uint32(1)
+	minOrderNotional := sdk.NewInt(1000000)
+	s.handleProposal(types.NewPairOrderRulesProposal(
+		"Change pair order rules", "Description",
+		[]types.PairOrderRulesChange{types.NewPairOrderRulesChange(pair.Id, &tickPrec, &minOrderNotional)}))
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().EqualValues(1, *pair.TickPrecision)
+	s.Require().True(intEq(minOrderNotional, *pair.MinOrderNotional))
+	s.Require().Equal(1, s.keeper.GetPairTickPrecision(s.ctx, pair))
+
+	// The order price is fit into the pair's ticks.
+	order = s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.234"), sdk.NewInt(1000000), time.Hour, true)
+	s.Require().True(decEq(utils.ParseDec("1.2"), order.Price))
+	order = s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.234"), sdk.NewInt(1000000), time.Hour, true)
+	s.Require().True(decEq(utils.ParseDec("1.3"), order.Price))
+
+	// Orders with notional smaller than the minimum are rejected.
+	offerCoin := utils.ParseCoin("1000denom2")
+	s.fundAddr(s.addr(3), sdk.NewCoins(offerCoin))
+	_, err := s.keeper.LimitOrder(s.ctx, types.NewMsgLimitOrder(
+		s.addr(3), pair.Id, types.OrderDirectionBuy, offerCoin, "denom1",
+		utils.ParseDec("1.0"), sdk.NewInt(1000), time.Hour))
+	s.Require().ErrorIs(err, types.ErrTooSmallOrder)
+
+	// Reset the order rules.
+	s.handleProposal(types.NewPairOrderRulesProposal(
+		"Reset pair order rules", "Description",
+		[]types.PairOrderRulesChange{types.NewPairOrderRulesChange(pair.Id, nil, nil)}))
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().Nil(pair.TickPrecision)
+	s.Require().Nil(pair.MinOrderNotional)
+	_, err = s.keeper.LimitOrder(s.ctx, types.NewMsgLimitOrder(
+		s.addr(3), pair.Id, types.OrderDirectionBuy, offerCoin, "denom1",
+		utils.ParseDec("1.0"), sdk.NewInt(1000), time.Hour))
+	s.Require().NoError(err)
+
+	proposal := types.NewPairOrderRulesProposal(
+		"Change pair order rules", "Description",
+		[]types.PairOrderRulesChange{types.NewPairOrderRulesChange(2, &tickPrec, nil)})
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().EqualError(s.govHandler(s.ctx, proposal), "pair 2 not found: not found")
+}
@@ -0,0 +1,506 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v5/x/liquidity/amm"
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+// getNextSwapRequestIdWithUpdate increments the last swap request id
+// and returns it.
+func (k Keeper) getNextSwapRequestIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetLastSwapRequestId(ctx) + 1
+	k.SetLastSwapRequestId(ctx, id)
+	return id
+}
+
+// ValidateMsgSwapExactIn validates types.MsgSwapExactIn.
+// It checks that the route connects the offer coin denom to the min out
+// coin denom.
+func (k Keeper) ValidateMsgSwapExactIn(ctx sdk.Context, msg *types.MsgSwapExactIn) error {
+	denom := msg.OfferCoin.Denom
+	for _, pairId := range msg.PairIds {
+		pair, found := k.GetPair(ctx, pairId)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+		}
+		if pair.OrdersPaused {
+			return sdkerrors.Wrapf(types.ErrOrdersPaused, "pair %d", pairId)
+		}
+		switch denom {
+		case pair.BaseCoinDenom:
+			denom = pair.QuoteCoinDenom
+		case pair.QuoteCoinDenom:
+			denom = pair.BaseCoinDenom
+		default:
+			return sdkerrors.Wrapf(types.ErrWrongPair, "denom %s is not in pair %d", denom, pairId)
+		}
+	}
+	if denom != msg.MinOutCoin.Denom {
+		return sdkerrors.Wrapf(
+			types.ErrWrongPair, "route ends with denom %s, not %s", denom, msg.MinOutCoin.Denom)
+	}
+	return nil
+}
+
+// SwapExactIn handles types.MsgSwapExactIn and stores the request.
+func (k Keeper) SwapExactIn(ctx sdk.Context, msg *types.MsgSwapExactIn) (types.SwapRequest, error) {
+	if err := k.ValidateMsgSwapExactIn(ctx, msg); err != nil {
+		return types.SwapRequest{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetOrderer(), types.GlobalEscrowAddress, sdk.NewCoins(msg.OfferCoin)); err != nil {
+		return types.SwapRequest{}, err
+	}
+
+	requestId := k.getNextSwapRequestIdWithUpdate(ctx)
+	req := types.NewSwapRequest(msg, requestId, ctx.BlockHeight())
+	k.SetSwapRequest(ctx, req)
+
+	ctx.GasMeter().ConsumeGas(k.GetOrderExtraGas(ctx)*sdk.Gas(len(msg.PairIds)), "OrderExtraGas")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSwapExactIn,
+			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+			sdk.NewAttribute(types.AttributeKeyPairIds, types.FormatUint64s(msg.PairIds)),
+			sdk.NewAttribute(types.AttributeKeyOfferCoin, msg.OfferCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyMinOutCoin, msg.MinOutCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSwapExactIn{
+		Orderer:    msg.Orderer,
+		PairIds:    msg.PairIds,
+		OfferCoin:  msg.OfferCoin,
+		MinOutCoin: msg.MinOutCoin,
+		RequestId:  req.Id,
+	}); err != nil {
+		return types.SwapRequest{}, err
+	}
+
+	return req, nil
+}
+
+// ExecuteSwapRequest executes a swap request.
+// The swaps in the route are executed atomically; if any of them fails or
+// the final output is smaller than the minimum, the whole offer coin is
+// refunded to the orderer.
+// lastPrices are the pairs' last prices before the batch matching, which
+// the price limits of the swaps are based on.
+func (k Keeper) ExecuteSwapRequest(ctx sdk.Context, req types.SwapRequest, lastPrices map[uint64]sdk.Dec) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	outCoin, refundedCoins, err := k.executeSwapRoute(cacheCtx, req, lastPrices)
+	if err == nil && outCoin.IsLT(req.MinOutCoin) {
+		err = sdkerrors.Wrapf(types.ErrTooSmallSwapOutput, "%s is smaller than %s", outCoin, req.MinOutCoin)
+	}
+	if err != nil {
+		return k.FinishSwapRequest(ctx, req, types.RequestStatusFailed)
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	req.OutCoin = outCoin
+	req.RefundedCoins = refundedCoins
+	return k.FinishSwapRequest(ctx, req, types.RequestStatusSucceeded)
+}
+
+// executeSwapRoute swaps the offer coin of the request through the pairs
+// in the route, using each swap's output as the next swap's offer coin.
+// Coins which are left in the middle of the route are returned as
+// refundedCoins.
+func (k Keeper) executeSwapRoute(
+	ctx sdk.Context, req types.SwapRequest, lastPrices map[uint64]sdk.Dec) (outCoin sdk.Coin, refundedCoins sdk.Coins, err error) {
+	outCoin = req.OfferCoin
+	for _, pairId := range req.PairIds {
+		pair, _ := k.GetPair(ctx, pairId)
+		var remainingCoin sdk.Coin
+		outCoin, remainingCoin, err = k.executeSwap(ctx, pair, lastPrices, outCoin)
+		if err != nil {
+			return sdk.Coin{}, nil, err
+		}
+		refundedCoins = refundedCoins.Add(remainingCoin)
+	}
+	return outCoin, refundedCoins, nil
+}
+
+// executeSwap swaps the offer coin in the pair against the pools' liquidity
+// and the resting user orders.
+// The swap is made as a market order from the global escrow address, and
+// the order must be fully matched.
+// Note that the offer coin might not be fully used for buy orders due to
+// the price difference, and the remaining part is returned as remainingCoin.
+// The price limits are based on the pair's last price in lastPrices, or
+// the current last price if the pair had no last price before the batch.
+func (k Keeper) executeSwap(
+	ctx sdk.Context, pair types.Pair, lastPrices map[uint64]sdk.Dec, offerCoin sdk.Coin) (receivedCoin, remainingCoin sdk.Coin, err error) {
+	if pair.OrdersPaused {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrOrdersPaused, "pair %d", pair.Id)
+	}
+	if pair.LastPrice == nil {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrNoLastPrice, "pair %d", pair.Id)
+	}
+	limitPrice, ok := lastPrices[pair.Id]
+	if !ok {
+		limitPrice = *pair.LastPrice
+	}
+
+	dir := swapDirection(pair, offerCoin.Denom)
+	return k.swapAgainst(
+		ctx, pair, *pair.LastPrice, limitPrice,
+		k.getPoolOrderers(ctx, pair), k.swapUserOrders(ctx, pair, dir), offerCoin)
+}
+
+// swapAgainst swaps the offer coin in the pair against the given pools and
+// user orders only, with lastPrice as the reference price for the matching
+// and the price limits based on limitPrice.
+// The swap's order is matched in its own order book and it is not stored,
+// so no hook is called for it; the coins are settled with the global escrow
+// address directly.
+// The pair's last price is not changed by the swap, but the swap is recorded
+// in the pair's candles and TWAP accumulator at its match price.
+// See executeSwap for details.
+func (k Keeper) swapAgainst(
+	ctx sdk.Context, pair types.Pair, lastPrice, limitPrice sdk.Dec, pools []*types.PoolOrderer, userOrders []types.Order,
+	offerCoin sdk.Coin) (receivedCoin, remainingCoin sdk.Coin, err error) {
+	dir := swapDirection(pair, offerCoin.Denom)
+	lowestPrice, highestPrice := k.PriceLimits(ctx, pair, limitPrice)
+	var (
+		price sdk.Dec
+		amt   sdk.Int
+	)
+	switch dir {
+	case amm.Buy:
+		counterOrders := k.swapCounterOrders(ctx, pair, pools, userOrders, dir, lowestPrice, highestPrice)
+		price, amt = swapBuyPriceAndAmount(counterOrders, lastPrice, highestPrice, offerCoin.Amount)
+	case amm.Sell:
+		price = lowestPrice
+		amt = offerCoin.Amount
+	}
+	if types.IsTooSmallOrderAmount(amt, price) {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "pair %d", pair.Id)
+	}
+
+	// The swap's order has no id since it is not stored.
+	// Its id doesn't affect the allocation, since there is no other user
+	// order in the same direction.
+	swapOrder := types.NewUserOrder(types.NewOrder(
+		types.OrderTypeMarket, 0, pair, types.GlobalEscrowAddress,
+		offerCoin, price, amt, ctx.BlockTime(), ctx.BlockHeight()))
+	ob := amm.NewOrderBook(swapOrder)
+	ob.SetAllocationPolicy(pair.AllocationPolicy.AMMAllocationPolicy())
+	for _, userOrder := range userOrders {
+		ob.AddOrder(types.NewUserOrder(userOrder))
+	}
+	matchPrice, quoteCoinDiff, matched := matchOrderBook(
+		ob, pools, &lastPrice, k.GetPairTickPrecision(ctx, pair), lowestPrice, highestPrice)
+	if !matched || !swapOrder.GetOpenAmount().IsZero() {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "pair %d", pair.Id)
+	}
+
+	var counterOrders []amm.Order
+	for _, order := range ob.Orders() {
+		if order != swapOrder {
+			counterOrders = append(counterOrders, order)
+		}
+	}
+	paidCoin := sdk.NewCoin(offerCoin.Denom, swapOrder.PaidOfferCoinAmount)
+	if err := k.bankKeeper.SendCoins(ctx, types.GlobalEscrowAddress, pair.GetEscrowAddress(), sdk.NewCoins(paidCoin)); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	if err := k.ApplyMatchResult(ctx, pair, counterOrders, quoteCoinDiff); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	// Swaps are always takers.
+	_, takerFeeRate := k.GetPairFeeRates(pair)
+	fee := sdk.NewCoin(swapOrder.DemandCoinDenom, takerFeeRate.MulInt(swapOrder.ReceivedDemandCoinAmount).TruncateInt())
+	receivedCoin = sdk.NewCoin(swapOrder.DemandCoinDenom, swapOrder.ReceivedDemandCoinAmount.Sub(fee.Amount))
+	bulkOp := types.NewBulkSendCoinsOperation()
+	bulkOp.QueueSendCoins(pair.GetEscrowAddress(), types.GlobalEscrowAddress, sdk.NewCoins(receivedCoin))
+	bulkOp.QueueSendCoins(pair.GetEscrowAddress(), k.GetFeeCollector(ctx), sdk.NewCoins(fee))
+	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	baseVolume, quoteVolume := matchedVolumes(ob.Orders())
+	k.UpdateCandles(ctx, pair, matchPrice, baseVolume, quoteVolume)
+	k.updateTWAPAccumulator(ctx, pair.Id, matchPrice)
+
+	return receivedCoin, offerCoin.Sub(paidCoin), nil
+}
+
+// swapDirection returns the order direction of a swap in the pair
+// which offers the given denom.
+func swapDirection(pair types.Pair, offerCoinDenom string) amm.OrderDirection {
+	if offerCoinDenom == pair.QuoteCoinDenom {
+		return amm.Buy
+	}
+	return amm.Sell
+}
+
+// swapUserOrders returns the resting user orders in the pair which a swap
+// in the given direction can be matched with.
+// Orders which have not been executed in a batch yet are excluded, as well as
+// trigger orders.
+func (k Keeper) swapUserOrders(ctx sdk.Context, pair types.Pair, dir amm.OrderDirection) (orders []types.Order) {
+	counterDir := types.OrderDirectionBuy
+	if dir == amm.Buy {
+		counterDir = types.OrderDirectionSell
+	}
+	_ = k.IterateOrdersByPair(ctx, pair.Id, func(order types.Order) (stop bool, err error) {
+		switch order.Status {
+		case types.OrderStatusNotMatched, types.OrderStatusPartiallyMatched:
+			if order.Direction == counterDir && order.Type != types.OrderTypeTrigger &&
+				!order.ExpiredAt(ctx.BlockTime()) {
+				orders = append(orders, order)
+			}
+		}
+		return false, nil
+	})
+	return orders
+}
+
+// swapCounterOrders returns the pools' orders and the user orders which a swap
+// in the given direction can be matched with, within the price limits.
+// The orders are sorted in the order of matching; sell orders are sorted by
+// price in ascending order and buy orders in descending order.
+func (k Keeper) swapCounterOrders(
+	ctx sdk.Context, pair types.Pair, pools []*types.PoolOrderer, userOrders []types.Order, dir amm.OrderDirection,
+	lowestPrice, highestPrice sdk.Dec) (orders []amm.Order) {
+	tickPrec := k.GetPairTickPrecision(ctx, pair)
+	for _, pool := range pools {
+		switch dir {
+		case amm.Buy:
+			orders = append(orders, amm.PoolSellOrders(pool, pool, lowestPrice, highestPrice, tickPrec)...)
+		case amm.Sell:
+			orders = append(orders, amm.PoolBuyOrders(pool, pool, lowestPrice, highestPrice, tickPrec)...)
+		}
+	}
+	for _, order := range userOrders {
+		if order.Price.LT(lowestPrice) || order.Price.GT(highestPrice) {
+			continue
+		}
+		orders = append(orders, types.NewUserOrder(order))
+	}
+	sort.SliceStable(orders, func(i, j int) bool {
+		if dir == amm.Buy {
+			return orders[i].GetPrice().LT(orders[j].GetPrice())
+		}
+		return orders[i].GetPrice().GT(orders[j].GetPrice())
+	})
+	return orders
+}
+
+// swapBuyPriceAndAmount returns the order price and the base coin amount
+// which can be bought from the sell orders with the given quote coin amount.
+// The sell orders are consumed from the lowest price, and each of them is
+// assumed to be matched at the higher one of its price and the last price.
+// The returned price is the highest price among the consumed orders, and
+// the amount is capped so that the quote coin amount covers it at the price.
+func swapBuyPriceAndAmount(sellOrders []amm.Order, lastPrice, highestPrice sdk.Dec, quoteAmt sdk.Int) (price sdk.Dec, amt sdk.Int) {
+	price = highestPrice
+	amt = sdk.ZeroInt()
+	remaining := quoteAmt
+	for _, order := range sellOrders {
+		price = sdk.MaxDec(order.GetPrice(), lastPrice)
+		orderAmt := amm.MatchableAmount(order, price)
+		cost := price.MulInt(orderAmt).Ceil().TruncateInt()
+		if cost.GTE(remaining) {
+			amt = amt.Add(remaining.ToDec().QuoTruncate(price).TruncateInt())
+			break
+		}
+		amt = amt.Add(orderAmt)
+		remaining = remaining.Sub(cost)
+	}
+	amt = sdk.MinInt(amt, quoteAmt.ToDec().QuoTruncate(price).TruncateInt())
+	return price, amt
+}
+
+// swapSellAmount returns the quote coin amount which can be received by
+// selling the base coin amount to the buy orders, and the base coin amount
+// which can be sold.
+// The buy orders are consumed from the highest price, and each of them is
+// assumed to be matched at the lower one of its price and the last price.
+func swapSellAmount(buyOrders []amm.Order, lastPrice sdk.Dec, baseAmt sdk.Int) (quoteAmt, soldAmt sdk.Int) {
+	quoteAmt, soldAmt = sdk.ZeroInt(), sdk.ZeroInt()
+	for _, order := range buyOrders {
+		if soldAmt.Equal(baseAmt) {
+			break
+		}
+		price := sdk.MinDec(order.GetPrice(), lastPrice)
+		amt := sdk.MinInt(amm.MatchableAmount(order, price), baseAmt.Sub(soldAmt))
+		quoteAmt = quoteAmt.Add(price.MulInt(amt).TruncateInt())
+		soldAmt = soldAmt.Add(amt)
+	}
+	return quoteAmt, soldAmt
+}
+
+// SwapRoutes returns all routes from denomIn to denomOut whose length is not
+// greater than maxLen.
+// A route is a list of pair ids, and it never visits the same denom twice.
+func (k Keeper) SwapRoutes(ctx sdk.Context, denomIn, denomOut string, maxLen int) (routes [][]uint64) {
+	visited := map[string]bool{denomIn: true}
+	var route []uint64
+	var walk func(denom string)
+	walk = func(denom string) {
+		if denom == denomOut {
+			routes = append(routes, append([]uint64{}, route...))
+			return
+		}
+		if len(route) == maxLen {
+			return
+		}
+		_ = k.IteratePairsByDenom(ctx, denom, func(otherDenom string, pairId uint64) (stop bool, err error) {
+			if visited[otherDenom] {
+				return false, nil
+			}
+			visited[otherDenom] = true
+			route = append(route, pairId)
+			walk(otherDenom)
+			route = route[:len(route)-1]
+			visited[otherDenom] = false
+			return false, nil
+		})
+	}
+	walk(denomIn)
+	return routes
+}
+
+// SimulateSwapRoute estimates the result of swapping the offer coin through
+// the pairs in the route, against the current pools and resting user orders.
+// The state is not changed.
+// priceImpact is the ratio of the output decrease compared to the output
+// which would be received at the pairs' last prices, and fees are
+// the taker fees paid in each pair, which are already deducted from outCoin.
+func (k Keeper) SimulateSwapRoute(
+	ctx sdk.Context, pairIds []uint64, offerCoin sdk.Coin) (outCoin sdk.Coin, priceImpact sdk.Dec, fees sdk.Coins, err error) {
+	idealAmt := offerCoin.Amount.ToDec()
+	outCoin = offerCoin
+	fees = sdk.Coins{}
+	for _, pairId := range pairIds {
+		pair, found := k.GetPair(ctx, pairId)
+		if !found {
+			return sdk.Coin{}, sdk.Dec{}, nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+		}
+		if pair.LastPrice == nil {
+			return sdk.Coin{}, sdk.Dec{}, nil, sdkerrors.Wrapf(types.ErrNoLastPrice, "pair %d", pair.Id)
+		}
+
+		dir := swapDirection(pair, outCoin.Denom)
+		lowestPrice, highestPrice := k.PriceLimits(ctx, pair, *pair.LastPrice)
+		counterOrders := k.swapCounterOrders(
+			ctx, pair, k.getPoolOrderers(ctx, pair), k.swapUserOrders(ctx, pair, dir), dir, lowestPrice, highestPrice)
+		switch dir {
+		case amm.Buy:
+			price, amt := swapBuyPriceAndAmount(counterOrders, *pair.LastPrice, highestPrice, outCoin.Amount)
+			if types.IsTooSmallOrderAmount(amt, price) {
+				return sdk.Coin{}, sdk.Dec{}, nil, sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "pair %d", pair.Id)
+			}
+			outCoin = sdk.NewCoin(pair.BaseCoinDenom, amt)
+			idealAmt = idealAmt.Quo(*pair.LastPrice)
+		case amm.Sell:
+			quoteAmt, soldAmt := swapSellAmount(counterOrders, *pair.LastPrice, outCoin.Amount)
+			if soldAmt.LT(outCoin.Amount) {
+				return sdk.Coin{}, sdk.Dec{}, nil, sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "pair %d", pair.Id)
+			}
+			outCoin = sdk.NewCoin(pair.QuoteCoinDenom, quoteAmt)
+			idealAmt = idealAmt.Mul(*pair.LastPrice)
+		}
+
+		// The swap is a taker in the pair.
+		_, takerFeeRate := k.GetPairFeeRates(pair)
+		fee := sdk.NewCoin(outCoin.Denom, takerFeeRate.MulInt(outCoin.Amount).TruncateInt())
+		if fee.IsPositive() {
+			outCoin = outCoin.Sub(fee)
+			fees = fees.Add(fee)
+		}
+		// Fees are not counted as price impact.
+		idealAmt = idealAmt.Mul(sdk.OneDec().Sub(takerFeeRate))
+	}
+	priceImpact = sdk.ZeroDec()
+	if idealAmt.IsPositive() {
+		priceImpact = sdk.OneDec().Sub(outCoin.Amount.ToDec().Quo(idealAmt))
+	}
+	return outCoin, priceImpact, fees, nil
+}
+
+// getPoolOrderers returns the pool orderers of the pair which can be used
+// for matching.
+func (k Keeper) getPoolOrderers(ctx sdk.Context, pair types.Pair) (pools []*types.PoolOrderer) {
+	_ = k.IteratePoolsByPair(ctx, pair.Id, func(pool types.Pool) (stop bool, err error) {
+		if pool.Disabled || pool.OrdersPaused {
+			return false, nil
+		}
+		ammPool := k.getPoolOrderer(ctx, pool, pair)
+		if ammPool.IsDepleted() {
+			return false, nil
+		}
+		pools = append(pools, ammPool)
+		return false, nil
+	})
+	return pools
+}
+
+// getPoolOrderer returns the pool orderer of the pool.
+func (k Keeper) getPoolOrderer(ctx sdk.Context, pool types.Pool, pair types.Pair) *types.PoolOrderer {
+	rx, ry := k.getPoolBalances(ctx, pool, pair)
+	ps := k.GetPoolCoinSupply(ctx, pool)
+	return types.NewPoolOrderer(
+		pool.AMMPool(rx.Amount, ry.Amount, ps),
+		pool.Id, pool.GetReserveAddress(), pair.BaseCoinDenom, pair.QuoteCoinDenom)
+}
+
+// FinishSwapRequest sends the output and refunds unused coins to the orderer,
+// and sets the request's status.
+// If the request has failed, the whole offer coin is refunded.
+func (k Keeper) FinishSwapRequest(ctx sdk.Context, req types.SwapRequest, status types.RequestStatus) error {
+	if req.Status != types.RequestStatusNotExecuted { // sanity check
+		return nil
+	}
+
+	var sendingCoins sdk.Coins
+	switch status {
+	case types.RequestStatusSucceeded:
+		sendingCoins = req.RefundedCoins.Add(req.OutCoin)
+	default:
+		sendingCoins = sdk.NewCoins(req.OfferCoin)
+		req.RefundedCoins = sendingCoins
+	}
+	if !sendingCoins.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, types.GlobalEscrowAddress, req.GetOrderer(), sendingCoins); err != nil {
+			return err
+		}
+	}
+	req.SetStatus(status)
+	k.SetSwapRequest(ctx, req)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSwapResult,
+			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderer, req.Orderer),
+			sdk.NewAttribute(types.AttributeKeyPairIds, types.FormatUint64s(req.PairIds)),
+			sdk.NewAttribute(types.AttributeKeyOfferCoin, req.OfferCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyOutCoin, req.OutCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundedCoins, req.RefundedCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, req.Status.String()),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSwapResult{
+		RequestId:     req.Id,
+		Orderer:       req.Orderer,
+		PairIds:       req.PairIds,
+		OfferCoin:     req.OfferCoin,
+		OutCoin:       req.OutCoin,
+		RefundedCoins: req.RefundedCoins,
+		Status:        req.Status,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
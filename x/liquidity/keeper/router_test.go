@@ -0,0 +1,271 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/liquidity"
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) createPairWithLastPrice(
+	baseCoinDenom, quoteCoinDenom string, lastPrice sdk.Dec) types.Pair {
+	s.T().Helper()
+	pair := s.createPair(s.addr(0), baseCoinDenom, quoteCoinDenom, true)
+	pair.LastPrice = &lastPrice
+	s.keeper.SetPair(s.ctx, pair)
+	return pair
+}
+
+func (s *KeeperTestSuite) TestSwapExactIn() {
+	pair1 := s.createPairWithLastPrice("denom1", "denom2", utils.ParseDec("1.0"))
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair2 := s.createPairWithLastPrice("denom3", "denom2", utils.ParseDec("2.0"))
+	s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom3,2000000000denom2"), true)
+
+	// denom1 -> denom2 -> denom3
+	orderer := s.addr(1)
+	req := s.swapExactIn(
+		orderer, []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("1000000denom1"), utils.ParseCoin("490000denom3"), true)
+	s.Require().Equal(types.RequestStatusNotExecuted, req.Status)
+	s.Require().True(coinsEq(sdk.Coins{}, s.getBalances(orderer)))
+
+	s.nextBlock()
+
+	// The request has been deleted.
+	_, found := s.keeper.GetSwapRequest(s.ctx, req.Id)
+	s.Require().False(found)
+
+	balances := s.getBalances(orderer)
+	s.Require().True(balances.AmountOf("denom1").IsZero())
+	s.Require().True(balances.AmountOf("denom3").GTE(sdk.NewInt(490000)))
+	// There's no swap request left in the escrow.
+	s.Require().True(s.getBalance(types.GlobalEscrowAddress, "denom1").IsZero())
+	s.Require().True(s.getBalance(types.GlobalEscrowAddress, "denom2").IsZero())
+	s.Require().True(s.getBalance(types.GlobalEscrowAddress, "denom3").IsZero())
+
+	// The last prices of the pairs are not changed by swaps.
+	pair1, _ = s.keeper.GetPair(s.ctx, pair1.Id)
+	s.Require().True(decEq(utils.ParseDec("1.0"), *pair1.LastPrice))
+	pair2, _ = s.keeper.GetPair(s.ctx, pair2.Id)
+	s.Require().True(decEq(utils.ParseDec("2.0"), *pair2.LastPrice))
+}
+
+func (s *KeeperTestSuite) TestSwapExactInPriceLimit() {
+	pair := s.createPairWithLastPrice("denom1", "denom2", utils.ParseDec("1.0"))
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+
+	// Each swap alone is within the price limit, but the swaps in the same
+	// block can't move the price further than the limit of the batch.
+	var reqs []types.SwapRequest
+	for i := 1; i <= 3; i++ {
+		reqs = append(reqs, s.swapExactIn(
+			s.addr(i), []uint64{pair.Id}, utils.ParseCoin("30000000denom1"), utils.ParseCoin("1denom2"), true))
+	}
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	numFailed := 0
+	for _, req := range reqs {
+		req, _ = s.keeper.GetSwapRequest(s.ctx, req.Id)
+		if req.Status == types.RequestStatusFailed {
+			numFailed++
+		}
+	}
+	s.Require().Positive(numFailed)
+
+	rx, ry := s.keeper.GetPoolBalances(s.ctx, pool)
+	s.Require().True(ry.Amount.ToDec().Quo(rx.Amount.ToDec()).GTE(utils.ParseDec("0.9")))
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(decEq(utils.ParseDec("1.0"), *pair.LastPrice))
+}
+
+func (s *KeeperTestSuite) TestSwapExactInResult() {
+	pair1 := s.createPairWithLastPrice("denom1", "denom2", utils.ParseDec("1.0"))
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair2 := s.createPairWithLastPrice("denom3", "denom2", utils.ParseDec("2.0"))
+	s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom3,2000000000denom2"), true)
+
+	req := s.swapExactIn(
+		s.addr(1), []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("1000000denom1"), utils.ParseCoin("490000denom3"), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	req, found := s.keeper.GetSwapRequest(s.ctx, req.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+	s.Require().True(coinEq(utils.ParseCoin("499013denom3"), req.OutCoin))
+	// The quote coin which was left at the second swap has been refunded.
+	s.Require().True(coinsEq(utils.ParseCoins("451denom2"), req.RefundedCoins))
+	s.Require().True(coinsEq(sdk.NewCoins(req.OutCoin).Add(req.RefundedCoins...), s.getBalances(s.addr(1))))
+}
+
+func (s *KeeperTestSuite) TestSwapExactInOrder() {
+	hooks := s.setMockHooks()
+
+	pair := s.createPairWithLastPrice("denom1", "denom2", utils.ParseDec("1.0"))
+	order := s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.02"), sdk.NewInt(1000000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	s.Require().Empty(hooks.matchedOrders)
+
+	req := s.swapExactIn(
+		s.addr(1), []uint64{pair.Id}, utils.ParseCoin("510000denom2"), utils.ParseCoin("490000denom1"), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	req, _ = s.keeper.GetSwapRequest(s.ctx, req.Id)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+
+	// The swap's order is not stored, and only the resting order is hooked.
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().Equal(order.Id, pair.LastOrderId)
+	s.Require().Len(hooks.matchedOrders, 1)
+	s.Require().Equal(order.Id, hooks.matchedOrders[0].Id)
+	order, _ = s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().Equal(types.OrderStatusPartiallyMatched, order.Status)
+	s.Require().True(intEq(sdk.NewInt(500000), order.OpenAmount))
+	s.Require().True(s.getBalance(types.GlobalEscrowAddress, "denom1").IsZero())
+	s.Require().True(s.getBalance(types.GlobalEscrowAddress, "denom2").IsZero())
+
+	// The swap is recorded in the candles and the TWAP accumulator, while the
+	// pair's last price is not changed.
+	s.Require().True(decEq(utils.ParseDec("1.0"), *pair.LastPrice))
+	candle, found := s.keeper.GetCandle(s.ctx, pair.Id, time.Minute, types.CandleOpenTime(s.ctx.BlockTime(), time.Minute))
+	s.Require().True(found)
+	s.Require().True(decEq(utils.ParseDec("1.02"), candle.Close))
+	s.Require().True(intEq(sdk.NewInt(500000), candle.BaseVolume))
+	acc, _ := s.keeper.GetTWAPAccumulator(s.ctx, pair.Id)
+	s.Require().True(decEq(utils.ParseDec("1.02"), acc.LastPrice))
+}
+
+func (s *KeeperTestSuite) TestSwapExactInMinOut() {
+	pair1 := s.createPairWithLastPrice("denom1", "denom2", utils.ParseDec("1.0"))
+	pool1 := s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair2 := s.createPairWithLastPrice("denom3", "denom2", utils.ParseDec("2.0"))
+	pool2 := s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom3,2000000000denom2"), true)
+
+	orderer := s.addr(1)
+	req := s.swapExactIn(
+		orderer, []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("1000000denom1"), utils.ParseCoin("500000denom3"), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// The output is smaller than the minimum, so the whole offer coin has been refunded.
+	req, _ = s.keeper.GetSwapRequest(s.ctx, req.Id)
+	s.Require().Equal(types.RequestStatusFailed, req.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1"), s.getBalances(orderer)))
+
+	// Nothing has changed in the pairs and the pools.
+	pair1, _ = s.keeper.GetPair(s.ctx, pair1.Id)
+	s.Require().True(decEq(utils.ParseDec("1.0"), *pair1.LastPrice))
+	pair2, _ = s.keeper.GetPair(s.ctx, pair2.Id)
+	s.Require().True(decEq(utils.ParseDec("2.0"), *pair2.LastPrice))
+	s.Require().True(coinsEq(
+		utils.ParseCoins("1000000000denom1,1000000000denom2"), s.getBalances(pool1.GetReserveAddress())))
+	s.Require().True(coinsEq(
+		utils.ParseCoins("1000000000denom3,2000000000denom2"), s.getBalances(pool2.GetReserveAddress())))
+}
+
+func (s *KeeperTestSuite) TestSwapExactInInsufficientLiquidity() {
+	pair1 := s.createPairWithLastPrice("denom1", "denom2", utils.ParseDec("1.0"))
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	// The second pair has no pool.
+	pair2 := s.createPairWithLastPrice("denom3", "denom2", utils.ParseDec("2.0"))
+
+	orderer := s.addr(1)
+	req := s.swapExactIn(
+		orderer, []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("1000000denom1"), utils.ParseCoin("1denom3"), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	req, _ = s.keeper.GetSwapRequest(s.ctx, req.Id)
+	s.Require().Equal(types.RequestStatusFailed, req.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1"), s.getBalances(orderer)))
+
+	// The first swap has been reverted too.
+	pair1, _ = s.keeper.GetPair(s.ctx, pair1.Id)
+	s.Require().True(decEq(utils.ParseDec("1.0"), *pair1.LastPrice))
+}
+
+func (s *KeeperTestSuite) TestSwapExactInWrongRoute() {
+	pair1 := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair2 := s.createPair(s.addr(0), "denom3", "denom2", true)
+	pair3 := s.createPair(s.addr(0), "denom4", "denom5", true)
+
+	orderer := s.addr(1)
+	s.fundAddr(orderer, utils.ParseCoins("1000000denom1"))
+
+	for _, tc := range []struct {
+		name        string
+		pairIds     []uint64
+		minOutCoin  sdk.Coin
+		expectedErr string
+	}{
+		{
+			"pair not found",
+			[]uint64{pair1.Id, 10},
+			utils.ParseCoin("1denom3"),
+			"pair 10 not found: not found",
+		},
+		{
+			"disconnected route",
+			[]uint64{pair1.Id, pair3.Id},
+			utils.ParseCoin("1denom4"),
+			"denom denom2 is not in pair 3: wrong denom pair",
+		},
+		{
+			"wrong min out coin denom",
+			[]uint64{pair1.Id, pair2.Id},
+			utils.ParseCoin("1denom2"),
+			"route ends with denom denom3, not denom2: wrong denom pair",
+		},
+	} {
+		s.Run(tc.name, func() {
+			msg := types.NewMsgSwapExactIn(orderer, tc.pairIds, utils.ParseCoin("1000000denom1"), tc.minOutCoin)
+			s.Require().NoError(msg.ValidateBasic())
+			_, err := s.keeper.SwapExactIn(s.ctx, msg)
+			s.Require().EqualError(err, tc.expectedErr)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSwapRoutes() {
+	pair1 := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair2 := s.createPair(s.addr(0), "denom3", "denom2", true)
+	pair3 := s.createPair(s.addr(0), "denom1", "denom3", true)
+	s.createPair(s.addr(0), "denom4", "denom5", true)
+
+	routes := s.keeper.SwapRoutes(s.ctx, "denom1", "denom3", 3)
+	s.Require().Len(routes, 2)
+	s.Require().Contains(routes, []uint64{pair1.Id, pair2.Id})
+	s.Require().Contains(routes, []uint64{pair3.Id})
+
+	routes = s.keeper.SwapRoutes(s.ctx, "denom1", "denom3", 1)
+	s.Require().Equal([][]uint64{{pair3.Id}}, routes)
+
+	s.Require().Empty(s.keeper.SwapRoutes(s.ctx, "denom1", "denom4", 3))
+}
+
+func (s *KeeperTestSuite) TestSimulateSwapRoute() {
+	pair1 := s.createPairWithLastPrice("denom1", "denom2", utils.ParseDec("1.0"))
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair2 := s.createPairWithLastPrice("denom3", "denom2", utils.ParseDec("2.0"))
+	s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom3,2000000000denom2"), true)
+	// A resting sell order in the second pair, which is cheaper than the pool.
+	s.sellLimitOrder(s.addr(2), pair2.Id, utils.ParseDec("2.0001"), sdk.NewInt(100000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	pairIds := []uint64{pair1.Id, pair2.Id}
+	offerCoin := utils.ParseCoin("1000000denom1")
+	outCoin, priceImpact, fees, err := s.keeper.SimulateSwapRoute(s.ctx, pairIds, offerCoin)
+	s.Require().NoError(err)
+	s.Require().True(priceImpact.IsPositive())
+	s.Require().True(fees.IsZero())
+
+	// The estimated output is the same as the actual output.
+	req := s.swapExactIn(s.addr(1), pairIds, offerCoin, utils.ParseCoin("1denom3"), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	req, _ = s.keeper.GetSwapRequest(s.ctx, req.Id)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+	s.Require().True(coinEq(outCoin, req.OutCoin))
+
+	// The resting order has been matched.
+	order, _ := s.keeper.GetOrder(s.ctx, pair2.Id, 1)
+	s.Require().Equal(types.OrderStatusCompleted, order.Status)
+}
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"bytes"
+	"time"
+
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -73,6 +76,26 @@ func (k Keeper) SetPairLookupIndex(ctx sdk.Context, denomA string, denomB string
 	store.Set(types.GetPairsByDenomsIndexKey(denomA, denomB, pairId), []byte{})
 }
 
+// IteratePairsByDenom iterates over the pairs which have the denom, using the
+// pair lookup index, and performs a callback function with the other denom
+// of the pair and the pair id.
+func (k Keeper) IteratePairsByDenom(ctx sdk.Context, denom string, cb func(otherDenom string, pairId uint64) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPairsByDenomIndexKeyPrefix(denom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, otherDenom, pairId := types.ParsePairsByDenomsIndexKey(iter.Key())
+		stop, err := cb(otherDenom, pairId)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
 // IterateAllPairs iterates over all the stored pairs and performs a callback function.
 // Stops iteration when callback returns true.
 func (k Keeper) IterateAllPairs(ctx sdk.Context, cb func(pair types.Pair) (stop bool, err error)) error {
@@ -237,10 +260,15 @@ func (k Keeper) GetDepositRequest(ctx sdk.Context, poolId, id uint64) (req types
 }
 
 // SetDepositRequest stores deposit request for the batch execution.
+// The request's status index is updated along with it.
 func (k Keeper) SetDepositRequest(ctx sdk.Context, req types.DepositRequest) {
 	store := ctx.KVStore(k.storeKey)
+	if prev, found := k.GetDepositRequest(ctx, req.PoolId, req.Id); found && prev.Status != req.Status {
+		store.Delete(types.GetDepositRequestByStatusIndexKey(prev.Status, prev.PoolId, prev.Id))
+	}
 	bz := types.MustMarshalDepositRequest(k.cdc, req)
 	store.Set(types.GetDepositRequestKey(req.PoolId, req.Id), bz)
+	store.Set(types.GetDepositRequestByStatusIndexKey(req.Status, req.PoolId, req.Id), []byte{})
 }
 
 func (k Keeper) SetDepositRequestIndex(ctx sdk.Context, req types.DepositRequest) {
@@ -267,6 +295,26 @@ func (k Keeper) IterateAllDepositRequests(ctx sdk.Context, cb func(req types.Dep
 	return nil
 }
 
+// IterateDepositRequestsByStatus iterates through deposit requests in the
+// store with the status and call cb for each request.
+func (k Keeper) IterateDepositRequestsByStatus(ctx sdk.Context, status types.RequestStatus, cb func(req types.DepositRequest) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDepositRequestsByStatusIndexKeyPrefix(status))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, poolId, reqId := types.ParseDepositRequestByStatusIndexKey(iter.Key())
+		req, _ := k.GetDepositRequest(ctx, poolId, reqId)
+		stop, err := cb(req)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
 // IterateDepositRequestsByDepositor iterates through deposit requests in the
 // store by a depositor and call cb on each order.
 func (k Keeper) IterateDepositRequestsByDepositor(ctx sdk.Context, depositor sdk.AccAddress, cb func(req types.DepositRequest) (stop bool, err error)) error {
@@ -310,6 +358,7 @@ func (k Keeper) GetDepositRequestsByDepositor(ctx sdk.Context, depositor sdk.Acc
 func (k Keeper) DeleteDepositRequest(ctx sdk.Context, req types.DepositRequest) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetDepositRequestKey(req.PoolId, req.Id))
+	store.Delete(types.GetDepositRequestByStatusIndexKey(req.Status, req.PoolId, req.Id))
 	k.DeleteDepositRequestIndex(ctx, req)
 }
 
@@ -318,6 +367,128 @@ func (k Keeper) DeleteDepositRequestIndex(ctx sdk.Context, req types.DepositRequ
 	store.Delete(types.GetDepositRequestIndexKey(req.GetDepositor(), req.PoolId, req.Id))
 }
 
+// GetSingleSidedDepositRequest returns the particular single-sided deposit request.
+func (k Keeper) GetSingleSidedDepositRequest(ctx sdk.Context, poolId, id uint64) (req types.SingleSidedDepositRequest, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSingleSidedDepositRequestKey(poolId, id))
+	if bz == nil {
+		return
+	}
+	req = types.MustUnmarshalSingleSidedDepositRequest(k.cdc, bz)
+	return req, true
+}
+
+// SetSingleSidedDepositRequest stores single-sided deposit request for the
+// batch execution.
+// The request's status index is updated along with it.
+func (k Keeper) SetSingleSidedDepositRequest(ctx sdk.Context, req types.SingleSidedDepositRequest) {
+	store := ctx.KVStore(k.storeKey)
+	if prev, found := k.GetSingleSidedDepositRequest(ctx, req.PoolId, req.Id); found && prev.Status != req.Status {
+		store.Delete(types.GetSingleSidedDepositRequestByStatusIndexKey(prev.Status, prev.PoolId, prev.Id))
+	}
+	bz := types.MustMarshalSingleSidedDepositRequest(k.cdc, req)
+	store.Set(types.GetSingleSidedDepositRequestKey(req.PoolId, req.Id), bz)
+	store.Set(types.GetSingleSidedDepositRequestByStatusIndexKey(req.Status, req.PoolId, req.Id), []byte{})
+}
+
+func (k Keeper) SetSingleSidedDepositRequestIndex(ctx sdk.Context, req types.SingleSidedDepositRequest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSingleSidedDepositRequestIndexKey(req.GetDepositor(), req.PoolId, req.Id), []byte{})
+}
+
+// IterateAllSingleSidedDepositRequests iterates through all single-sided
+// deposit requests in the store and call cb for each request.
+func (k Keeper) IterateAllSingleSidedDepositRequests(ctx sdk.Context, cb func(req types.SingleSidedDepositRequest) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SingleSidedDepositRequestKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		req := types.MustUnmarshalSingleSidedDepositRequest(k.cdc, iter.Value())
+		stop, err := cb(req)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateSingleSidedDepositRequestsByStatus iterates through single-sided
+// deposit requests in the store with the status and call cb for each request.
+func (k Keeper) IterateSingleSidedDepositRequestsByStatus(ctx sdk.Context, status types.RequestStatus, cb func(req types.SingleSidedDepositRequest) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetSingleSidedDepositRequestsByStatusIndexKeyPrefix(status))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, poolId, reqId := types.ParseSingleSidedDepositRequestByStatusIndexKey(iter.Key())
+		req, _ := k.GetSingleSidedDepositRequest(ctx, poolId, reqId)
+		stop, err := cb(req)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateSingleSidedDepositRequestsByDepositor iterates through single-sided
+// deposit requests in the store by a depositor and call cb on each request.
+func (k Keeper) IterateSingleSidedDepositRequestsByDepositor(ctx sdk.Context, depositor sdk.AccAddress, cb func(req types.SingleSidedDepositRequest) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetSingleSidedDepositRequestIndexKeyPrefix(depositor))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, poolId, reqId := types.ParseSingleSidedDepositRequestIndexKey(iter.Key())
+		req, _ := k.GetSingleSidedDepositRequest(ctx, poolId, reqId)
+		stop, err := cb(req)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllSingleSidedDepositRequests returns all single-sided deposit requests
+// in the store.
+func (k Keeper) GetAllSingleSidedDepositRequests(ctx sdk.Context) (reqs []types.SingleSidedDepositRequest) {
+	reqs = []types.SingleSidedDepositRequest{}
+	_ = k.IterateAllSingleSidedDepositRequests(ctx, func(req types.SingleSidedDepositRequest) (stop bool, err error) {
+		reqs = append(reqs, req)
+		return false, nil
+	})
+	return
+}
+
+// GetSingleSidedDepositRequestsByDepositor returns single-sided deposit
+// requests by the depositor.
+func (k Keeper) GetSingleSidedDepositRequestsByDepositor(ctx sdk.Context, depositor sdk.AccAddress) (reqs []types.SingleSidedDepositRequest) {
+	_ = k.IterateSingleSidedDepositRequestsByDepositor(ctx, depositor, func(req types.SingleSidedDepositRequest) (stop bool, err error) {
+		reqs = append(reqs, req)
+		return false, nil
+	})
+	return
+}
+
+// DeleteSingleSidedDepositRequest deletes a single-sided deposit request.
+func (k Keeper) DeleteSingleSidedDepositRequest(ctx sdk.Context, req types.SingleSidedDepositRequest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSingleSidedDepositRequestKey(req.PoolId, req.Id))
+	store.Delete(types.GetSingleSidedDepositRequestByStatusIndexKey(req.Status, req.PoolId, req.Id))
+	k.DeleteSingleSidedDepositRequestIndex(ctx, req)
+}
+
+func (k Keeper) DeleteSingleSidedDepositRequestIndex(ctx sdk.Context, req types.SingleSidedDepositRequest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSingleSidedDepositRequestIndexKey(req.GetDepositor(), req.PoolId, req.Id))
+}
+
 // GetWithdrawRequest returns the particular withdraw request.
 func (k Keeper) GetWithdrawRequest(ctx sdk.Context, poolId, id uint64) (req types.WithdrawRequest, found bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -330,10 +501,15 @@ func (k Keeper) GetWithdrawRequest(ctx sdk.Context, poolId, id uint64) (req type
 }
 
 // SetWithdrawRequest stores withdraw request for the batch execution.
+// The request's status index is updated along with it.
 func (k Keeper) SetWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest) {
 	store := ctx.KVStore(k.storeKey)
+	if prev, found := k.GetWithdrawRequest(ctx, req.PoolId, req.Id); found && prev.Status != req.Status {
+		store.Delete(types.GetWithdrawRequestByStatusIndexKey(prev.Status, prev.PoolId, prev.Id))
+	}
 	bz := types.MustMarshaWithdrawRequest(k.cdc, req)
 	store.Set(types.GetWithdrawRequestKey(req.PoolId, req.Id), bz)
+	store.Set(types.GetWithdrawRequestByStatusIndexKey(req.Status, req.PoolId, req.Id), []byte{})
 }
 
 func (k Keeper) SetWithdrawRequestIndex(ctx sdk.Context, req types.WithdrawRequest) {
@@ -360,6 +536,26 @@ func (k Keeper) IterateAllWithdrawRequests(ctx sdk.Context, cb func(req types.Wi
 	return nil
 }
 
+// IterateWithdrawRequestsByStatus iterates through withdraw requests in the
+// store with the status and call cb for each request.
+func (k Keeper) IterateWithdrawRequestsByStatus(ctx sdk.Context, status types.RequestStatus, cb func(req types.WithdrawRequest) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetWithdrawRequestsByStatusIndexKeyPrefix(status))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, poolId, reqId := types.ParseWithdrawRequestByStatusIndexKey(iter.Key())
+		req, _ := k.GetWithdrawRequest(ctx, poolId, reqId)
+		stop, err := cb(req)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
 // IterateWithdrawRequestsByWithdrawer iterates through withdraw requests in the
 // store by a withdrawer and call cb on each order.
 func (k Keeper) IterateWithdrawRequestsByWithdrawer(ctx sdk.Context, withdrawer sdk.AccAddress, cb func(req types.WithdrawRequest) (stop bool, err error)) error {
@@ -403,6 +599,7 @@ func (k Keeper) GetWithdrawRequestsByWithdrawer(ctx sdk.Context, withdrawer sdk.
 func (k Keeper) DeleteWithdrawRequest(ctx sdk.Context, req types.WithdrawRequest) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetWithdrawRequestKey(req.PoolId, req.Id))
+	store.Delete(types.GetWithdrawRequestByStatusIndexKey(req.Status, req.PoolId, req.Id))
 	k.DeleteWithdrawRequestIndex(ctx, req)
 }
 
@@ -423,10 +620,55 @@ func (k Keeper) GetOrder(ctx sdk.Context, pairId, id uint64) (order types.Order,
 }
 
 // SetOrder stores an order for the batch execution.
+// The order's expiration time index, status index and price index are set
+// along with it.
+// SetOrder doesn't delete the index entries of the order stored before, so
+// use setOrderWithPrev when the status, the price or the type of a stored
+// order is changed.
 func (k Keeper) SetOrder(ctx sdk.Context, order types.Order) {
+	k.setOrderWithPrev(ctx, nil, order)
+}
+
+// setOrderWithPrev stores an order, updating the order's indexes from prev,
+// the order stored before.
+// prev is nil for a new order.
+// The previous order is passed by the caller rather than read from the store
+// since orders are written in the hottest path of the batch execution.
+func (k Keeper) setOrderWithPrev(ctx sdk.Context, prev *types.Order, order types.Order) {
 	store := ctx.KVStore(k.storeKey)
+	var prevPriceIndexKey []byte
+	if prev != nil {
+		if prev.Status != order.Status {
+			store.Delete(types.GetOrderByStatusIndexKey(prev.Status, prev.PairId, prev.Id))
+		}
+		prevPriceIndexKey = orderPriceIndexKey(*prev)
+	}
 	bz := types.MustMarshaOrder(k.cdc, order)
 	store.Set(types.GetOrderKey(order.PairId, order.Id), bz)
+	if prev == nil {
+		store.Set(types.GetOrderByExpireAtIndexKey(order.ExpireAt, order.PairId, order.Id), []byte{})
+	}
+	if prev == nil || prev.Status != order.Status {
+		store.Set(types.GetOrderByStatusIndexKey(order.Status, order.PairId, order.Id), []byte{})
+	}
+	if priceIndexKey := orderPriceIndexKey(order); !bytes.Equal(priceIndexKey, prevPriceIndexKey) {
+		if prevPriceIndexKey != nil {
+			store.Delete(prevPriceIndexKey)
+		}
+		if priceIndexKey != nil {
+			store.Set(priceIndexKey, []byte{})
+		}
+	}
+}
+
+// orderPriceIndexKey returns the price index key of the order, or nil if
+// the order is not indexed by price.
+// Only orders which can be added to the order book are indexed.
+func orderPriceIndexKey(order types.Order) []byte {
+	if order.Type == types.OrderTypeTrigger || !order.Status.IsMatchable() {
+		return nil
+	}
+	return types.GetOrderByPriceIndexKey(order.PairId, order.Direction, order.Price, order.Id)
 }
 
 func (k Keeper) SetOrderIndex(ctx sdk.Context, order types.Order) {
@@ -472,6 +714,97 @@ func (k Keeper) IterateOrdersByPair(ctx sdk.Context, pairId uint64, cb func(orde
 	return nil
 }
 
+// IterateOrdersExpiredAt iterates through orders in the store which are
+// expired at t, in the order of their expiration time, and call cb for each
+// order.
+func (k Keeper) IterateOrdersExpiredAt(ctx sdk.Context, t time.Time, cb func(order types.Order) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		types.OrderByExpireAtIndexKeyPrefix,
+		sdk.PrefixEndBytes(types.GetOrdersByExpireAtIndexKeyPrefix(t)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, pairId, orderId := types.ParseOrderByExpireAtIndexKey(iter.Key())
+		order, _ := k.GetOrder(ctx, pairId, orderId)
+		stop, err := cb(order)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateOrdersByStatus iterates through orders in the store with the status
+// and call cb for each order.
+func (k Keeper) IterateOrdersByStatus(ctx sdk.Context, status types.OrderStatus, cb func(order types.Order) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetOrdersByStatusIndexKeyPrefix(status))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, pairId, orderId := types.ParseOrderByStatusIndexKey(iter.Key())
+		order, _ := k.GetOrder(ctx, pairId, orderId)
+		stop, err := cb(order)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateOrdersByPairAndStatus iterates through the orders within the pair
+// with the status and call cb for each order.
+func (k Keeper) IterateOrdersByPairAndStatus(ctx sdk.Context, pairId uint64, status types.OrderStatus, cb func(order types.Order) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetOrdersByPairAndStatusIndexKeyPrefix(status, pairId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, _, orderId := types.ParseOrderByStatusIndexKey(iter.Key())
+		order, _ := k.GetOrder(ctx, pairId, orderId)
+		stop, err := cb(order)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateOrdersByPrice iterates through the matchable orders within the pair
+// with the direction and call cb for each order.
+// Buy orders are iterated from the highest price and sell orders are
+// iterated from the lowest price, so the iteration can be stopped once the
+// price goes out of the range the caller is interested in.
+func (k Keeper) IterateOrdersByPrice(ctx sdk.Context, pairId uint64, dir types.OrderDirection, cb func(order types.Order) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	var iter sdk.Iterator
+	if dir == types.OrderDirectionBuy {
+		iter = sdk.KVStoreReversePrefixIterator(store, types.GetOrdersByPriceIndexKeyPrefix(pairId, dir))
+	} else {
+		iter = sdk.KVStorePrefixIterator(store, types.GetOrdersByPriceIndexKeyPrefix(pairId, dir))
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, _, _, orderId := types.ParseOrderByPriceIndexKey(iter.Key())
+		order, _ := k.GetOrder(ctx, pairId, orderId)
+		stop, err := cb(order)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
 // IterateOrdersByOrderer iterates through orders in the store by an orderer
 // and call cb on each order.
 func (k Keeper) IterateOrdersByOrderer(ctx sdk.Context, orderer sdk.AccAddress, cb func(order types.Order) (stop bool, err error)) error {
@@ -524,6 +857,11 @@ func (k Keeper) GetOrdersByOrderer(ctx sdk.Context, orderer sdk.AccAddress) (ord
 func (k Keeper) DeleteOrder(ctx sdk.Context, order types.Order) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetOrderKey(order.PairId, order.Id))
+	store.Delete(types.GetOrderByExpireAtIndexKey(order.ExpireAt, order.PairId, order.Id))
+	store.Delete(types.GetOrderByStatusIndexKey(order.Status, order.PairId, order.Id))
+	if priceIndexKey := orderPriceIndexKey(order); priceIndexKey != nil {
+		store.Delete(priceIndexKey)
+	}
 	k.DeleteOrderIndex(ctx, order)
 }
 
@@ -563,3 +901,568 @@ func (k Keeper) IterateAllNumMMOrders(ctx sdk.Context, cb func(ordererAddr sdk.A
 		}
 	}
 }
+
+// GetLastSwapRequestId returns the last swap request id.
+func (k Keeper) GetLastSwapRequestId(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastSwapRequestIdKey)
+	if bz == nil {
+		id = 0 // initialize the swap request id
+	} else {
+		var val gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(bz, &val)
+		id = val.GetValue()
+	}
+	return
+}
+
+// SetLastSwapRequestId stores the last swap request id.
+func (k Keeper) SetLastSwapRequestId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.LastSwapRequestIdKey, bz)
+}
+
+// GetSwapRequest returns the particular swap request.
+func (k Keeper) GetSwapRequest(ctx sdk.Context, id uint64) (req types.SwapRequest, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSwapRequestKey(id))
+	if bz == nil {
+		return
+	}
+	req = types.MustUnmarshalSwapRequest(k.cdc, bz)
+	return req, true
+}
+
+// SetSwapRequest stores swap request for the batch execution.
+// The request's status index is updated along with it.
+func (k Keeper) SetSwapRequest(ctx sdk.Context, req types.SwapRequest) {
+	store := ctx.KVStore(k.storeKey)
+	if prev, found := k.GetSwapRequest(ctx, req.Id); found && prev.Status != req.Status {
+		store.Delete(types.GetSwapRequestByStatusIndexKey(prev.Status, prev.Id))
+	}
+	bz := types.MustMarshalSwapRequest(k.cdc, req)
+	store.Set(types.GetSwapRequestKey(req.Id), bz)
+	store.Set(types.GetSwapRequestByStatusIndexKey(req.Status, req.Id), []byte{})
+}
+
+// IterateAllSwapRequests iterates through all swap requests in the store
+// and call cb for each request.
+func (k Keeper) IterateAllSwapRequests(ctx sdk.Context, cb func(req types.SwapRequest) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SwapRequestKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		req := types.MustUnmarshalSwapRequest(k.cdc, iter.Value())
+		stop, err := cb(req)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateSwapRequestsByStatus iterates through swap requests in the store
+// with the status and call cb for each request.
+func (k Keeper) IterateSwapRequestsByStatus(ctx sdk.Context, status types.RequestStatus, cb func(req types.SwapRequest) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetSwapRequestsByStatusIndexKeyPrefix(status))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, reqId := types.ParseSwapRequestByStatusIndexKey(iter.Key())
+		req, _ := k.GetSwapRequest(ctx, reqId)
+		stop, err := cb(req)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllSwapRequests returns all swap requests in the store.
+func (k Keeper) GetAllSwapRequests(ctx sdk.Context) (reqs []types.SwapRequest) {
+	reqs = []types.SwapRequest{}
+	_ = k.IterateAllSwapRequests(ctx, func(req types.SwapRequest) (stop bool, err error) {
+		reqs = append(reqs, req)
+		return false, nil
+	})
+	return reqs
+}
+
+// DeleteSwapRequest deletes a swap request.
+func (k Keeper) DeleteSwapRequest(ctx sdk.Context, req types.SwapRequest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSwapRequestKey(req.Id))
+	store.Delete(types.GetSwapRequestByStatusIndexKey(req.Status, req.Id))
+}
+
+// GetPosition returns the particular position.
+func (k Keeper) GetPosition(ctx sdk.Context, poolId, id uint64) (position types.Position, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPositionKey(poolId, id))
+	if bz == nil {
+		return
+	}
+	position = types.MustUnmarshalPosition(k.cdc, bz)
+	return position, true
+}
+
+// SetPosition stores a position.
+func (k Keeper) SetPosition(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalPosition(k.cdc, position)
+	store.Set(types.GetPositionKey(position.PoolId, position.Id), bz)
+}
+
+func (k Keeper) SetPositionIndex(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPositionIndexKey(position.GetOwner(), position.PoolId, position.Id), []byte{})
+}
+
+// IterateAllPositions iterates through all positions in the store and
+// call cb for each position.
+func (k Keeper) IterateAllPositions(ctx sdk.Context, cb func(position types.Position) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PositionKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		position := types.MustUnmarshalPosition(k.cdc, iter.Value())
+		stop, err := cb(position)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IteratePositionsByPool iterates through all the positions within the pool
+// and call cb for each position.
+func (k Keeper) IteratePositionsByPool(ctx sdk.Context, poolId uint64, cb func(position types.Position) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPositionsByPoolKeyPrefix(poolId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		position := types.MustUnmarshalPosition(k.cdc, iter.Value())
+		stop, err := cb(position)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IteratePositionsByOwner iterates through positions in the store by an owner
+// and call cb on each position.
+func (k Keeper) IteratePositionsByOwner(ctx sdk.Context, owner sdk.AccAddress, cb func(position types.Position) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPositionIndexKeyPrefix(owner))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, poolId, positionId := types.ParsePositionIndexKey(iter.Key())
+		position, _ := k.GetPosition(ctx, poolId, positionId)
+		stop, err := cb(position)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllPositions returns all positions in the store.
+func (k Keeper) GetAllPositions(ctx sdk.Context) (positions []types.Position) {
+	positions = []types.Position{}
+	_ = k.IterateAllPositions(ctx, func(position types.Position) (stop bool, err error) {
+		positions = append(positions, position)
+		return false, nil
+	})
+	return
+}
+
+// GetPositionsByPool returns positions within the pool.
+func (k Keeper) GetPositionsByPool(ctx sdk.Context, poolId uint64) (positions []types.Position) {
+	_ = k.IteratePositionsByPool(ctx, poolId, func(position types.Position) (stop bool, err error) {
+		positions = append(positions, position)
+		return false, nil
+	})
+	return
+}
+
+// GetPositionsByOwner returns positions by the owner.
+func (k Keeper) GetPositionsByOwner(ctx sdk.Context, owner sdk.AccAddress) (positions []types.Position) {
+	_ = k.IteratePositionsByOwner(ctx, owner, func(position types.Position) (stop bool, err error) {
+		positions = append(positions, position)
+		return false, nil
+	})
+	return
+}
+
+// DeletePosition deletes a position.
+func (k Keeper) DeletePosition(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPositionKey(position.PoolId, position.Id))
+	k.DeletePositionIndex(ctx, position)
+}
+
+func (k Keeper) DeletePositionIndex(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPositionIndexKey(position.GetOwner(), position.PoolId, position.Id))
+}
+
+// GetPoolAccrual returns the accrual of the pool.
+func (k Keeper) GetPoolAccrual(ctx sdk.Context, poolId uint64) (accrual types.PoolAccrual, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolAccrualKey(poolId))
+	if bz == nil {
+		return
+	}
+	accrual = types.MustUnmarshalPoolAccrual(k.cdc, bz)
+	return accrual, true
+}
+
+// SetPoolAccrual stores a pool accrual.
+func (k Keeper) SetPoolAccrual(ctx sdk.Context, accrual types.PoolAccrual) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalPoolAccrual(k.cdc, accrual)
+	store.Set(types.GetPoolAccrualKey(accrual.PoolId), bz)
+}
+
+// IterateAllPoolAccruals iterates through all pool accruals in the store and
+// call cb for each accrual.
+func (k Keeper) IterateAllPoolAccruals(ctx sdk.Context, cb func(accrual types.PoolAccrual) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PoolAccrualKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		accrual := types.MustUnmarshalPoolAccrual(k.cdc, iter.Value())
+		stop, err := cb(accrual)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllPoolAccruals returns all pool accruals in the store.
+func (k Keeper) GetAllPoolAccruals(ctx sdk.Context) (accruals []types.PoolAccrual) {
+	accruals = []types.PoolAccrual{}
+	_ = k.IterateAllPoolAccruals(ctx, func(accrual types.PoolAccrual) (stop bool, err error) {
+		accruals = append(accruals, accrual)
+		return false, nil
+	})
+	return
+}
+
+// SetPoolAccrualSnapshot stores a pool accrual snapshot.
+func (k Keeper) SetPoolAccrualSnapshot(ctx sdk.Context, snapshot types.PoolAccrualSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalPoolAccrualSnapshot(k.cdc, snapshot)
+	store.Set(types.GetPoolAccrualSnapshotKey(snapshot.PoolId, snapshot.Time), bz)
+}
+
+// IterateAllPoolAccrualSnapshots iterates through all pool accrual snapshots
+// in the store and call cb for each snapshot.
+func (k Keeper) IterateAllPoolAccrualSnapshots(ctx sdk.Context, cb func(snapshot types.PoolAccrualSnapshot) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PoolAccrualSnapshotKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		snapshot := types.MustUnmarshalPoolAccrualSnapshot(k.cdc, iter.Value())
+		stop, err := cb(snapshot)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IteratePoolAccrualSnapshotsByPool iterates through the pool accrual
+// snapshots of the pool in ascending order of time and call cb for each
+// snapshot.
+func (k Keeper) IteratePoolAccrualSnapshotsByPool(ctx sdk.Context, poolId uint64, cb func(snapshot types.PoolAccrualSnapshot) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPoolAccrualSnapshotsByPoolKeyPrefix(poolId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		snapshot := types.MustUnmarshalPoolAccrualSnapshot(k.cdc, iter.Value())
+		stop, err := cb(snapshot)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetLatestPoolAccrualSnapshotBefore returns the latest snapshot of the pool
+// taken at or before t.
+func (k Keeper) GetLatestPoolAccrualSnapshotBefore(ctx sdk.Context, poolId uint64, t time.Time) (snapshot types.PoolAccrualSnapshot, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.ReverseIterator(
+		types.GetPoolAccrualSnapshotsByPoolKeyPrefix(poolId),
+		sdk.PrefixEndBytes(types.GetPoolAccrualSnapshotKey(poolId, t)))
+	defer iter.Close()
+	if !iter.Valid() {
+		return
+	}
+	return types.MustUnmarshalPoolAccrualSnapshot(k.cdc, iter.Value()), true
+}
+
+// GetAllPoolAccrualSnapshots returns all pool accrual snapshots in the store.
+func (k Keeper) GetAllPoolAccrualSnapshots(ctx sdk.Context) (snapshots []types.PoolAccrualSnapshot) {
+	snapshots = []types.PoolAccrualSnapshot{}
+	_ = k.IterateAllPoolAccrualSnapshots(ctx, func(snapshot types.PoolAccrualSnapshot) (stop bool, err error) {
+		snapshots = append(snapshots, snapshot)
+		return false, nil
+	})
+	return
+}
+
+// DeletePoolAccrualSnapshot deletes a pool accrual snapshot.
+func (k Keeper) DeletePoolAccrualSnapshot(ctx sdk.Context, snapshot types.PoolAccrualSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolAccrualSnapshotKey(snapshot.PoolId, snapshot.Time))
+}
+
+// GetLastPoolAccrualSnapshotTime returns the last time pool accrual snapshots
+// were taken.
+func (k Keeper) GetLastPoolAccrualSnapshotTime(ctx sdk.Context) (t time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastPoolAccrualSnapshotTimeKey)
+	if bz == nil {
+		return
+	}
+	t, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return t, true
+}
+
+// SetLastPoolAccrualSnapshotTime stores the last time pool accrual snapshots
+// were taken.
+func (k Keeper) SetLastPoolAccrualSnapshotTime(ctx sdk.Context, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastPoolAccrualSnapshotTimeKey, sdk.FormatTimeBytes(t))
+}
+
+// GetTWAPAccumulator returns the twap accumulator of the pair.
+func (k Keeper) GetTWAPAccumulator(ctx sdk.Context, pairId uint64) (acc types.TWAPAccumulator, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTWAPAccumulatorKey(pairId))
+	if bz == nil {
+		return
+	}
+	acc = types.MustUnmarshalTWAPAccumulator(k.cdc, bz)
+	return acc, true
+}
+
+// SetTWAPAccumulator stores a twap accumulator.
+func (k Keeper) SetTWAPAccumulator(ctx sdk.Context, acc types.TWAPAccumulator) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalTWAPAccumulator(k.cdc, acc)
+	store.Set(types.GetTWAPAccumulatorKey(acc.PairId), bz)
+}
+
+// IterateAllTWAPAccumulators iterates through all twap accumulators in the
+// store and call cb for each accumulator.
+func (k Keeper) IterateAllTWAPAccumulators(ctx sdk.Context, cb func(acc types.TWAPAccumulator) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TWAPAccumulatorKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		acc := types.MustUnmarshalTWAPAccumulator(k.cdc, iter.Value())
+		stop, err := cb(acc)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllTWAPAccumulators returns all twap accumulators in the store.
+func (k Keeper) GetAllTWAPAccumulators(ctx sdk.Context) (accs []types.TWAPAccumulator) {
+	accs = []types.TWAPAccumulator{}
+	_ = k.IterateAllTWAPAccumulators(ctx, func(acc types.TWAPAccumulator) (stop bool, err error) {
+		accs = append(accs, acc)
+		return false, nil
+	})
+	return
+}
+
+// GetTWAPSnapshot returns the twap snapshot of the pair with the sequence.
+func (k Keeper) GetTWAPSnapshot(ctx sdk.Context, pairId, seq uint64) (snapshot types.TWAPSnapshot, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTWAPSnapshotKey(pairId, seq))
+	if bz == nil {
+		return
+	}
+	snapshot = types.MustUnmarshalTWAPSnapshot(k.cdc, bz)
+	return snapshot, true
+}
+
+// SetTWAPSnapshot stores a twap snapshot.
+func (k Keeper) SetTWAPSnapshot(ctx sdk.Context, snapshot types.TWAPSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalTWAPSnapshot(k.cdc, snapshot)
+	store.Set(types.GetTWAPSnapshotKey(snapshot.PairId, snapshot.Seq), bz)
+}
+
+// IterateAllTWAPSnapshots iterates through all twap snapshots in the store
+// and call cb for each snapshot.
+func (k Keeper) IterateAllTWAPSnapshots(ctx sdk.Context, cb func(snapshot types.TWAPSnapshot) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TWAPSnapshotKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		snapshot := types.MustUnmarshalTWAPSnapshot(k.cdc, iter.Value())
+		stop, err := cb(snapshot)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateTWAPSnapshotsByPair iterates through the twap snapshots of the pair
+// in ascending order of sequence and call cb for each snapshot.
+// If reverse is true, the snapshots are iterated in descending order.
+func (k Keeper) IterateTWAPSnapshotsByPair(ctx sdk.Context, pairId uint64, reverse bool, cb func(snapshot types.TWAPSnapshot) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	var iter sdk.Iterator
+	if reverse {
+		iter = sdk.KVStoreReversePrefixIterator(store, types.GetTWAPSnapshotsByPairKeyPrefix(pairId))
+	} else {
+		iter = sdk.KVStorePrefixIterator(store, types.GetTWAPSnapshotsByPairKeyPrefix(pairId))
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		snapshot := types.MustUnmarshalTWAPSnapshot(k.cdc, iter.Value())
+		stop, err := cb(snapshot)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllTWAPSnapshots returns all twap snapshots in the store.
+func (k Keeper) GetAllTWAPSnapshots(ctx sdk.Context) (snapshots []types.TWAPSnapshot) {
+	snapshots = []types.TWAPSnapshot{}
+	_ = k.IterateAllTWAPSnapshots(ctx, func(snapshot types.TWAPSnapshot) (stop bool, err error) {
+		snapshots = append(snapshots, snapshot)
+		return false, nil
+	})
+	return
+}
+
+// DeleteTWAPSnapshot deletes a twap snapshot.
+func (k Keeper) DeleteTWAPSnapshot(ctx sdk.Context, snapshot types.TWAPSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTWAPSnapshotKey(snapshot.PairId, snapshot.Seq))
+}
+
+// GetCandle returns the candle of the pair with the interval and the open
+// time.
+func (k Keeper) GetCandle(ctx sdk.Context, pairId uint64, interval time.Duration, openTime time.Time) (candle types.Candle, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCandleKey(pairId, interval, openTime))
+	if bz == nil {
+		return
+	}
+	candle = types.MustUnmarshalCandle(k.cdc, bz)
+	return candle, true
+}
+
+// SetCandle stores a candle.
+func (k Keeper) SetCandle(ctx sdk.Context, candle types.Candle) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalCandle(k.cdc, candle)
+	store.Set(types.GetCandleKey(candle.PairId, candle.Interval, candle.OpenTime), bz)
+}
+
+// IterateAllCandles iterates through all candles in the store and call cb
+// for each candle.
+func (k Keeper) IterateAllCandles(ctx sdk.Context, cb func(candle types.Candle) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.CandleKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		candle := types.MustUnmarshalCandle(k.cdc, iter.Value())
+		stop, err := cb(candle)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateCandlesByPairAndInterval iterates through the candles of the pair
+// with the interval in ascending order of open time and call cb for each
+// candle.
+func (k Keeper) IterateCandlesByPairAndInterval(ctx sdk.Context, pairId uint64, interval time.Duration, cb func(candle types.Candle) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetCandlesByPairAndIntervalKeyPrefix(pairId, interval))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		candle := types.MustUnmarshalCandle(k.cdc, iter.Value())
+		stop, err := cb(candle)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllCandles returns all candles in the store.
+func (k Keeper) GetAllCandles(ctx sdk.Context) (candles []types.Candle) {
+	candles = []types.Candle{}
+	_ = k.IterateAllCandles(ctx, func(candle types.Candle) (stop bool, err error) {
+		candles = append(candles, candle)
+		return false, nil
+	})
+	return
+}
+
+// DeleteCandle deletes a candle.
+func (k Keeper) DeleteCandle(ctx sdk.Context, candle types.Candle) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCandleKey(candle.PairId, candle.Interval, candle.OpenTime))
+}
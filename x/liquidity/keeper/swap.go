@@ -2,26 +2,87 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/crescent-network/crescent/v5/x/liquidity/amm"
 	"github.com/crescent-network/crescent/v5/x/liquidity/types"
 )
 
-func (k Keeper) PriceLimits(ctx sdk.Context, lastPrice sdk.Dec) (lowest, highest sdk.Dec) {
-	return types.PriceLimits(lastPrice, k.GetMaxPriceLimitRatio(ctx), int(k.GetTickPrecision(ctx)))
+func (k Keeper) PriceLimits(ctx sdk.Context, pair types.Pair, lastPrice sdk.Dec) (lowest, highest sdk.Dec) {
+	return types.PriceLimits(lastPrice, k.GetMaxPriceLimitRatio(ctx), k.GetPairTickPrecision(ctx, pair))
+}
+
+// validateOrderSize validates that the order is not smaller than the
+// module-wide minimum and the pair's minimum order notional.
+func validateOrderSize(pair types.Pair, amt sdk.Int, price sdk.Dec) error {
+	if types.IsTooSmallOrderAmount(amt, price) {
+		return types.ErrTooSmallOrder
+	}
+	if pair.IsTooSmallOrderNotional(amt, price) {
+		return sdkerrors.Wrapf(
+			types.ErrTooSmallOrder, "order notional %s is smaller than %s", price.MulInt(amt), pair.MinOrderNotional)
+	}
+	return nil
+}
+
+// orderPrice validates the order price and returns the price fit into a tick.
+// The price of a market order is derived from the pair's last price.
+func (k Keeper) orderPrice(
+	ctx sdk.Context, pair types.Pair, typ types.OrderType, direction types.OrderDirection, price *sdk.Dec) (sdk.Dec, error) {
+	tickPrec := k.GetPairTickPrecision(ctx, pair)
+	switch typ {
+	case types.OrderTypeLimit, types.OrderTypeMM:
+		var upperPriceLimit, lowerPriceLimit sdk.Dec
+		if pair.LastPrice != nil {
+			lowerPriceLimit, upperPriceLimit = k.PriceLimits(ctx, pair, *pair.LastPrice)
+		} else {
+			upperPriceLimit = amm.HighestTick(tickPrec)
+			lowerPriceLimit = amm.LowestTick(tickPrec)
+		}
+		switch {
+		case price.GT(upperPriceLimit):
+			return sdk.Dec{}, sdkerrors.Wrapf(types.ErrPriceOutOfRange, "%s is higher than %s", *price, upperPriceLimit)
+		case price.LT(lowerPriceLimit):
+			return sdk.Dec{}, sdkerrors.Wrapf(types.ErrPriceOutOfRange, "%s is lower than %s", *price, lowerPriceLimit)
+		}
+	case types.OrderTypeMarket:
+		if pair.LastPrice == nil {
+			return sdk.Dec{}, types.ErrNoLastPrice
+		}
+	default:
+		panic(fmt.Sprintf("unknown order type: %v", typ))
+	}
+
+	switch direction {
+	case types.OrderDirectionBuy:
+		if typ == types.OrderTypeMarket {
+			return amm.PriceToDownTick(
+				pair.LastPrice.Mul(sdk.OneDec().Add(k.GetMaxPriceLimitRatio(ctx))), tickPrec), nil
+		}
+		return amm.PriceToDownTick(*price, tickPrec), nil
+	case types.OrderDirectionSell:
+		if typ == types.OrderTypeMarket {
+			return amm.PriceToUpTick(
+				pair.LastPrice.Mul(sdk.OneDec().Sub(k.GetMaxPriceLimitRatio(ctx))), tickPrec), nil
+		}
+		return amm.PriceToUpTick(*price, tickPrec), nil
+	default:
+		return sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order direction: %s", direction)
+	}
 }
 
 // placeOrder validates an order and place the order.
 func (k Keeper) placeOrder(
 	ctx sdk.Context, typ types.OrderType, ordererAddr sdk.AccAddress, pairId uint64, direction types.OrderDirection,
 	offerCoin sdk.Coin, demandCoinDenom string, price *sdk.Dec, amount sdk.Int,
-	orderLifespan time.Duration) (order types.Order, err error) {
+	orderLifespan time.Duration, timeInForce types.TimeInForce, postOnly bool) (order types.Order, err error) {
 	spendable := k.bankKeeper.SpendableCoins(ctx, ordererAddr)
 	if spendableAmt := spendable.AmountOf(offerCoin.Denom); spendableAmt.LT(offerCoin.Amount) {
 		return types.Order{}, sdkerrors.Wrapf(
@@ -39,35 +100,16 @@ func (k Keeper) placeOrder(
 	if !found {
 		return types.Order{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
 	}
+	if pair.OrdersPaused {
+		return types.Order{}, sdkerrors.Wrapf(types.ErrOrdersPaused, "pair %d", pairId)
+	}
 
-	tickPrec := k.GetTickPrecision(ctx)
-	switch typ {
-	case types.OrderTypeLimit, types.OrderTypeMM:
-		var upperPriceLimit, lowerPriceLimit sdk.Dec
-		if pair.LastPrice != nil {
-			lowerPriceLimit, upperPriceLimit = k.PriceLimits(ctx, *pair.LastPrice)
-		} else {
-			upperPriceLimit = amm.HighestTick(int(tickPrec))
-			lowerPriceLimit = amm.LowestTick(int(tickPrec))
-		}
-		switch {
-		case price.GT(upperPriceLimit):
-			return types.Order{}, sdkerrors.Wrapf(types.ErrPriceOutOfRange, "%s is higher than %s", *price, upperPriceLimit)
-		case price.LT(lowerPriceLimit):
-			return types.Order{}, sdkerrors.Wrapf(types.ErrPriceOutOfRange, "%s is lower than %s", *price, lowerPriceLimit)
-		}
-	case types.OrderTypeMarket:
-		if pair.LastPrice == nil {
-			return types.Order{}, types.ErrNoLastPrice
-		}
-	default:
-		panic(fmt.Sprintf("unknown order type: %v", typ))
+	resultPrice, err := k.orderPrice(ctx, pair, typ, direction, price)
+	if err != nil {
+		return types.Order{}, err
 	}
 
-	var (
-		resultOfferCoin sdk.Coin
-		resultPrice     sdk.Dec
-	)
+	var resultOfferCoin sdk.Coin
 	switch direction {
 	case types.OrderDirectionBuy:
 		if offerCoin.Denom != pair.QuoteCoinDenom || demandCoinDenom != pair.BaseCoinDenom {
@@ -75,15 +117,6 @@ func (k Keeper) placeOrder(
 				sdkerrors.Wrapf(types.ErrWrongPair, "denom pair (%s, %s) != (%s, %s)",
 					demandCoinDenom, offerCoin.Denom, pair.BaseCoinDenom, pair.QuoteCoinDenom)
 		}
-		switch typ {
-		case types.OrderTypeMarket:
-			resultPrice = amm.PriceToDownTick(
-				pair.LastPrice.Mul(
-					sdk.OneDec().Add(k.GetMaxPriceLimitRatio(ctx))),
-				int(tickPrec))
-		default:
-			resultPrice = amm.PriceToDownTick(*price, int(tickPrec))
-		}
 		resultOfferCoin = sdk.NewCoin(offerCoin.Denom, amm.OfferCoinAmount(amm.Buy, resultPrice, amount))
 		if offerCoin.IsLT(resultOfferCoin) {
 			return types.Order{}, sdkerrors.Wrapf(
@@ -95,15 +128,6 @@ func (k Keeper) placeOrder(
 				sdkerrors.Wrapf(types.ErrWrongPair, "denom pair (%s, %s) != (%s, %s)",
 					offerCoin.Denom, demandCoinDenom, pair.BaseCoinDenom, pair.QuoteCoinDenom)
 		}
-		switch typ {
-		case types.OrderTypeMarket:
-			resultPrice = amm.PriceToUpTick(
-				pair.LastPrice.Mul(
-					sdk.OneDec().Sub(k.GetMaxPriceLimitRatio(ctx))),
-				int(tickPrec))
-		default:
-			resultPrice = amm.PriceToUpTick(*price, int(tickPrec))
-		}
 		resultOfferCoin = sdk.NewCoin(offerCoin.Denom, amount)
 		if offerCoin.Amount.LT(amount) {
 			return types.Order{}, sdkerrors.Wrapf(
@@ -111,8 +135,14 @@ func (k Keeper) placeOrder(
 				offerCoin, sdk.NewCoin(offerCoin.Denom, amount))
 		}
 	}
-	if types.IsTooSmallOrderAmount(amount, resultPrice) {
-		return types.Order{}, types.ErrTooSmallOrder
+	if err := validateOrderSize(pair, amount, resultPrice); err != nil {
+		return types.Order{}, err
+	}
+
+	if postOnly {
+		if err := validatePostOnlyPrice(k.OrderView(ctx, pair), direction, resultPrice); err != nil {
+			return types.Order{}, err
+		}
 	}
 
 	refundedCoin := offerCoin.Sub(resultOfferCoin)
@@ -124,19 +154,65 @@ func (k Keeper) placeOrder(
 	expireAt := ctx.BlockTime().Add(orderLifespan)
 	order = types.NewOrder(
 		typ, orderId, pair, ordererAddr, resultOfferCoin, resultPrice, amount, expireAt, ctx.BlockHeight())
+	order.TimeInForce = timeInForce
+	order.PostOnly = postOnly
 	k.SetOrder(ctx, order)
 	k.SetOrderIndex(ctx, order)
 
 	ctx.GasMeter().ConsumeGas(k.GetOrderExtraGas(ctx), "OrderExtraGas")
 
 	var evtType string
+	var evt proto.Message
 	switch typ {
 	case types.OrderTypeLimit:
 		evtType = types.EventTypeLimitOrder
+		evt = &types.EventLimitOrder{
+			Orderer:         ordererAddr.String(),
+			PairId:          pairId,
+			Direction:       direction,
+			OfferCoin:       resultOfferCoin,
+			DemandCoinDenom: demandCoinDenom,
+			Price:           resultPrice,
+			Amount:          amount,
+			OrderId:         order.Id,
+			BatchId:         order.BatchId,
+			ExpireAt:        order.ExpireAt,
+			RefundedCoin:    refundedCoin,
+			TimeInForce:     timeInForce,
+			PostOnly:        postOnly,
+		}
 	case types.OrderTypeMarket:
 		evtType = types.EventTypeMarketOrder
+		evt = &types.EventMarketOrder{
+			Orderer:         ordererAddr.String(),
+			PairId:          pairId,
+			Direction:       direction,
+			OfferCoin:       resultOfferCoin,
+			DemandCoinDenom: demandCoinDenom,
+			Price:           resultPrice,
+			Amount:          amount,
+			OrderId:         order.Id,
+			BatchId:         order.BatchId,
+			ExpireAt:        order.ExpireAt,
+			RefundedCoin:    refundedCoin,
+			TimeInForce:     timeInForce,
+		}
 	case types.OrderTypeMM:
 		evtType = types.EventTypeMMOrder
+		evt = &types.EventMMOrder{
+			Orderer:         ordererAddr.String(),
+			PairId:          pairId,
+			Direction:       direction,
+			OfferCoin:       resultOfferCoin,
+			DemandCoinDenom: demandCoinDenom,
+			Price:           resultPrice,
+			Amount:          amount,
+			OrderId:         order.Id,
+			BatchId:         order.BatchId,
+			ExpireAt:        order.ExpireAt,
+			RefundedCoin:    refundedCoin,
+			PostOnly:        postOnly,
+		}
 	}
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -154,22 +230,75 @@ func (k Keeper) placeOrder(
 			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
 		),
 	})
+	if err := ctx.EventManager().EmitTypedEvent(evt); err != nil {
+		return types.Order{}, err
+	}
+
+	k.AfterOrderPlaced(ctx, order)
 
 	return order, nil
 }
 
+// validatePostOnlyPrice returns an error if an order with the given price
+// would cross the best opposite price of the order view.
+func validatePostOnlyPrice(ov amm.OrderView, direction types.OrderDirection, price sdk.Dec) error {
+	switch direction {
+	case types.OrderDirectionBuy:
+		if lowestSellPrice, found := ov.LowestSellPrice(); found && price.GTE(lowestSellPrice) {
+			return sdkerrors.Wrapf(
+				types.ErrPostOnlyOrderWouldCross, "price %s is not lower than the lowest sell price %s",
+				price, lowestSellPrice)
+		}
+	case types.OrderDirectionSell:
+		if highestBuyPrice, found := ov.HighestBuyPrice(); found && price.LTE(highestBuyPrice) {
+			return sdkerrors.Wrapf(
+				types.ErrPostOnlyOrderWouldCross, "price %s is not higher than the highest buy price %s",
+				price, highestBuyPrice)
+		}
+	}
+	return nil
+}
+
+// OrderView returns the order view of the pair, which consists of
+// the open user orders and the pools' orders.
+// Trigger orders which are not triggered yet are not included.
+func (k Keeper) OrderView(ctx sdk.Context, pair types.Pair) amm.MultipleOrderViews {
+	ob := amm.NewOrderBook()
+	_ = k.IterateOrdersByPair(ctx, pair.Id, func(order types.Order) (stop bool, err error) {
+		if order.Status.IsMatchable() && order.Type != types.OrderTypeTrigger {
+			ob.AddOrder(types.NewUserOrder(order))
+		}
+		return false, nil
+	})
+	ov := amm.MultipleOrderViews{ob.MakeView()}
+	_ = k.IteratePoolsByPair(ctx, pair.Id, func(pool types.Pool) (stop bool, err error) {
+		if pool.Disabled || pool.OrdersPaused {
+			return false, nil
+		}
+		rx, ry := k.getPoolBalances(ctx, pool, pair)
+		ps := k.GetPoolCoinSupply(ctx, pool)
+		ammPool := pool.AMMPool(rx.Amount, ry.Amount, ps)
+		if ammPool.IsDepleted() {
+			return false, nil
+		}
+		ov = append(ov, ammPool)
+		return false, nil
+	})
+	return ov
+}
+
 // LimitOrder handles types.MsgLimitOrder and stores types.Order.
 func (k Keeper) LimitOrder(ctx sdk.Context, msg *types.MsgLimitOrder) (types.Order, error) {
 	return k.placeOrder(
 		ctx, types.OrderTypeLimit, msg.GetOrderer(), msg.PairId, msg.Direction,
-		msg.OfferCoin, msg.DemandCoinDenom, &msg.Price, msg.Amount, msg.OrderLifespan)
+		msg.OfferCoin, msg.DemandCoinDenom, &msg.Price, msg.Amount, msg.OrderLifespan, msg.TimeInForce, msg.PostOnly)
 }
 
 // MarketOrder handles types.MsgMarketOrder and stores types.Order.
 func (k Keeper) MarketOrder(ctx sdk.Context, msg *types.MsgMarketOrder) (types.Order, error) {
 	return k.placeOrder(
 		ctx, types.OrderTypeMarket, msg.GetOrderer(), msg.PairId, msg.Direction,
-		msg.OfferCoin, msg.DemandCoinDenom, nil, msg.Amount, msg.OrderLifespan)
+		msg.OfferCoin, msg.DemandCoinDenom, nil, msg.Amount, msg.OrderLifespan, types.TimeInForceUnspecified, false)
 }
 
 // MMOrder handles types.MsgMMOrder and stores types.Order.
@@ -182,7 +311,7 @@ func (k Keeper) MMOrder(ctx sdk.Context, msg *types.MsgMMOrder) (types.Order, er
 
 	order, err := k.placeOrder(
 		ctx, types.OrderTypeMM, ordererAddr, msg.PairId, msg.Direction,
-		msg.OfferCoin, msg.DemandCoinDenom, &msg.Price, msg.Amount, msg.OrderLifespan)
+		msg.OfferCoin, msg.DemandCoinDenom, &msg.Price, msg.Amount, msg.OrderLifespan, types.TimeInForceUnspecified, msg.PostOnly)
 	if err != nil {
 		return types.Order{}, err
 	}
@@ -191,6 +320,289 @@ func (k Keeper) MMOrder(ctx sdk.Context, msg *types.MsgMMOrder) (types.Order, er
 	return order, nil
 }
 
+// BatchOrders handles types.MsgBatchOrders and stores multiple types.Order
+// at once.
+// Offer coins of all orders are checked and escrowed at once, and the orders
+// get contiguous ids.
+// Order extra gas is consumed only once for the whole orders.
+func (k Keeper) BatchOrders(ctx sdk.Context, msg *types.MsgBatchOrders) ([]types.Order, error) {
+	maxOrderLifespan := k.GetMaxOrderLifespan(ctx)
+	if msg.OrderLifespan > maxOrderLifespan {
+		return nil,
+			sdkerrors.Wrapf(types.ErrTooLongOrderLifespan, "%s is longer than %s", msg.OrderLifespan, maxOrderLifespan)
+	}
+
+	pair, found := k.GetPair(ctx, msg.PairId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
+	}
+	if pair.OrdersPaused {
+		return nil, sdkerrors.Wrapf(types.ErrOrdersPaused, "pair %d", msg.PairId)
+	}
+
+	ordererAddr := msg.GetOrderer()
+	var numMMOrders uint32
+	if msg.OrderType == types.OrderTypeMM {
+		numMMOrders = k.GetNumMMOrders(ctx, ordererAddr, msg.PairId)
+		if numMMOrders+uint32(len(msg.Orders)) > k.GetMaxNumMarketMakingOrdersPerPair(ctx) {
+			return nil, types.ErrMaxNumMMOrdersExceeded
+		}
+	}
+
+	var ov amm.OrderView
+	if msg.PostOnly {
+		ov = k.OrderView(ctx, pair)
+	}
+	var (
+		prices                           = make([]sdk.Dec, len(msg.Orders))
+		offerCoins                       = make([]sdk.Coin, len(msg.Orders))
+		totalOfferCoins                  sdk.Coins
+		highestBuyPrice, lowestSellPrice *sdk.Dec
+	)
+	for i, entry := range msg.Orders {
+		price, err := k.orderPrice(ctx, pair, msg.OrderType, entry.Direction, &entry.Price)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid order at index %d", i)
+		}
+		if err := validateOrderSize(pair, entry.Amount, price); err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid order at index %d", i)
+		}
+		switch entry.Direction {
+		case types.OrderDirectionBuy:
+			offerCoins[i] = sdk.NewCoin(pair.QuoteCoinDenom, amm.OfferCoinAmount(amm.Buy, price, entry.Amount))
+			if highestBuyPrice == nil || price.GT(*highestBuyPrice) {
+				highestBuyPrice = &price
+			}
+		case types.OrderDirectionSell:
+			offerCoins[i] = sdk.NewCoin(pair.BaseCoinDenom, entry.Amount)
+			if lowestSellPrice == nil || price.LT(*lowestSellPrice) {
+				lowestSellPrice = &price
+			}
+		}
+		if msg.PostOnly {
+			if err := validatePostOnlyPrice(ov, entry.Direction, price); err != nil {
+				return nil, sdkerrors.Wrapf(err, "invalid order at index %d", i)
+			}
+		}
+		prices[i] = price
+		totalOfferCoins = totalOfferCoins.Add(offerCoins[i])
+	}
+	if msg.PostOnly && highestBuyPrice != nil && lowestSellPrice != nil && highestBuyPrice.GTE(*lowestSellPrice) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrPostOnlyOrderWouldCross, "highest buy price %s is not lower than lowest sell price %s",
+			*highestBuyPrice, *lowestSellPrice)
+	}
+
+	spendable := k.bankKeeper.SpendableCoins(ctx, ordererAddr)
+	for _, coin := range totalOfferCoins {
+		if spendableAmt := spendable.AmountOf(coin.Denom); spendableAmt.LT(coin.Amount) {
+			return nil, sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "%s is smaller than %s",
+				sdk.NewCoin(coin.Denom, spendableAmt), coin)
+		}
+	}
+	if err := k.bankKeeper.SendCoins(ctx, ordererAddr, pair.GetEscrowAddress(), totalOfferCoins); err != nil {
+		return nil, err
+	}
+
+	firstOrderId := k.getNextOrderIdRangeWithUpdate(ctx, pair, uint64(len(msg.Orders)))
+	expireAt := ctx.BlockTime().Add(msg.OrderLifespan)
+	orders := make([]types.Order, len(msg.Orders))
+	orderIds := make([]uint64, len(msg.Orders))
+	orderExtraGas := k.GetOrderExtraGas(ctx)
+	for i, entry := range msg.Orders {
+		ctx.GasMeter().ConsumeGas(orderExtraGas, "OrderExtraGas")
+
+		order := types.NewOrder(
+			msg.OrderType, firstOrderId+uint64(i), pair, ordererAddr,
+			offerCoins[i], prices[i], entry.Amount, expireAt, ctx.BlockHeight())
+		order.PostOnly = msg.PostOnly
+		k.SetOrder(ctx, order)
+		k.SetOrderIndex(ctx, order)
+		k.AfterOrderPlaced(ctx, order)
+		orders[i] = order
+		orderIds[i] = order.Id
+	}
+	if msg.OrderType == types.OrderTypeMM {
+		k.SetNumMMOrders(ctx, ordererAddr, msg.PairId, numMMOrders+uint32(len(msg.Orders)))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBatchOrders,
+			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderType, msg.OrderType.String()),
+			sdk.NewAttribute(types.AttributeKeyOrderIds, types.FormatUint64s(orderIds)),
+			sdk.NewAttribute(types.AttributeKeyOfferCoins, totalOfferCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(pair.CurrentBatchId, 10)),
+			sdk.NewAttribute(types.AttributeKeyExpireAt, expireAt.Format(time.RFC3339)),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBatchOrders{
+		Orderer:    msg.Orderer,
+		PairId:     msg.PairId,
+		OrderType:  msg.OrderType,
+		OrderIds:   orderIds,
+		OfferCoins: totalOfferCoins,
+		BatchId:    pair.CurrentBatchId,
+		ExpireAt:   expireAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// TriggerOrder handles types.MsgTriggerOrder and stores types.Order.
+// The order stays dormant until it is triggered by the pair's last price.
+func (k Keeper) TriggerOrder(ctx sdk.Context, msg *types.MsgTriggerOrder) (types.Order, error) {
+	ordererAddr := msg.GetOrderer()
+	spendable := k.bankKeeper.SpendableCoins(ctx, ordererAddr)
+	if spendableAmt := spendable.AmountOf(msg.OfferCoin.Denom); spendableAmt.LT(msg.OfferCoin.Amount) {
+		return types.Order{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFunds, "%s is smaller than %s",
+			sdk.NewCoin(msg.OfferCoin.Denom, spendableAmt), msg.OfferCoin)
+	}
+
+	maxOrderLifespan := k.GetMaxOrderLifespan(ctx)
+	if msg.OrderLifespan > maxOrderLifespan {
+		return types.Order{},
+			sdkerrors.Wrapf(types.ErrTooLongOrderLifespan, "%s is longer than %s", msg.OrderLifespan, maxOrderLifespan)
+	}
+
+	pair, found := k.GetPair(ctx, msg.PairId)
+	if !found {
+		return types.Order{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
+	}
+	if pair.OrdersPaused {
+		return types.Order{}, sdkerrors.Wrapf(types.ErrOrdersPaused, "pair %d", msg.PairId)
+	}
+
+	tickPrec := k.GetPairTickPrecision(ctx, pair)
+	triggeredOrderType := types.OrderTypeMarket
+	if msg.Price != nil {
+		triggeredOrderType = types.OrderTypeLimit
+		// The price limits at the moment the order is triggered are unknown,
+		// so check only the absolute price range here.
+		upperPriceLimit, lowerPriceLimit := amm.HighestTick(tickPrec), amm.LowestTick(tickPrec)
+		switch {
+		case msg.Price.GT(upperPriceLimit):
+			return types.Order{}, sdkerrors.Wrapf(types.ErrPriceOutOfRange, "%s is higher than %s", *msg.Price, upperPriceLimit)
+		case msg.Price.LT(lowerPriceLimit):
+			return types.Order{}, sdkerrors.Wrapf(types.ErrPriceOutOfRange, "%s is lower than %s", *msg.Price, lowerPriceLimit)
+		}
+	}
+
+	// For market orders, the trigger price is used as the order price
+	// until the order is triggered.
+	var (
+		resultOfferCoin sdk.Coin
+		resultPrice     sdk.Dec
+	)
+	switch msg.Direction {
+	case types.OrderDirectionBuy:
+		if msg.OfferCoin.Denom != pair.QuoteCoinDenom || msg.DemandCoinDenom != pair.BaseCoinDenom {
+			return types.Order{},
+				sdkerrors.Wrapf(types.ErrWrongPair, "denom pair (%s, %s) != (%s, %s)",
+					msg.DemandCoinDenom, msg.OfferCoin.Denom, pair.BaseCoinDenom, pair.QuoteCoinDenom)
+		}
+		switch triggeredOrderType {
+		case types.OrderTypeMarket:
+			resultPrice = amm.PriceToDownTick(msg.TriggerPrice, tickPrec)
+			resultOfferCoin = msg.OfferCoin
+		default:
+			resultPrice = amm.PriceToDownTick(*msg.Price, tickPrec)
+			resultOfferCoin = sdk.NewCoin(msg.OfferCoin.Denom, amm.OfferCoinAmount(amm.Buy, resultPrice, msg.Amount))
+			if msg.OfferCoin.IsLT(resultOfferCoin) {
+				return types.Order{}, sdkerrors.Wrapf(
+					types.ErrInsufficientOfferCoin, "%s is smaller than %s", msg.OfferCoin, resultOfferCoin)
+			}
+		}
+	case types.OrderDirectionSell:
+		if msg.OfferCoin.Denom != pair.BaseCoinDenom || msg.DemandCoinDenom != pair.QuoteCoinDenom {
+			return types.Order{},
+				sdkerrors.Wrapf(types.ErrWrongPair, "denom pair (%s, %s) != (%s, %s)",
+					msg.OfferCoin.Denom, msg.DemandCoinDenom, pair.BaseCoinDenom, pair.QuoteCoinDenom)
+		}
+		switch triggeredOrderType {
+		case types.OrderTypeMarket:
+			resultPrice = amm.PriceToUpTick(msg.TriggerPrice, tickPrec)
+		default:
+			resultPrice = amm.PriceToUpTick(*msg.Price, tickPrec)
+		}
+		resultOfferCoin = sdk.NewCoin(msg.OfferCoin.Denom, msg.Amount)
+		if msg.OfferCoin.Amount.LT(msg.Amount) {
+			return types.Order{}, sdkerrors.Wrapf(
+				types.ErrInsufficientOfferCoin, "%s is smaller than %s",
+				msg.OfferCoin, sdk.NewCoin(msg.OfferCoin.Denom, msg.Amount))
+		}
+	}
+	if err := validateOrderSize(pair, msg.Amount, resultPrice); err != nil {
+		return types.Order{}, err
+	}
+
+	refundedCoin := msg.OfferCoin.Sub(resultOfferCoin)
+	if err := k.bankKeeper.SendCoins(ctx, ordererAddr, pair.GetEscrowAddress(), sdk.NewCoins(resultOfferCoin)); err != nil {
+		return types.Order{}, err
+	}
+
+	orderId := k.getNextOrderIdWithUpdate(ctx, pair)
+	expireAt := ctx.BlockTime().Add(msg.OrderLifespan)
+	order := types.NewOrder(
+		types.OrderTypeTrigger, orderId, pair, ordererAddr, resultOfferCoin, resultPrice, msg.Amount, expireAt, ctx.BlockHeight())
+	triggerPrice := msg.TriggerPrice
+	order.TriggerPrice = &triggerPrice
+	order.TriggerCondition = msg.TriggerCondition
+	order.TriggeredOrderType = triggeredOrderType
+	k.SetOrder(ctx, order)
+	k.SetOrderIndex(ctx, order)
+
+	ctx.GasMeter().ConsumeGas(k.GetOrderExtraGas(ctx), "OrderExtraGas")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTriggerOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderDirection, msg.Direction.String()),
+			sdk.NewAttribute(types.AttributeKeyOfferCoin, resultOfferCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyDemandCoinDenom, msg.DemandCoinDenom),
+			sdk.NewAttribute(types.AttributeKeyPrice, resultPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTriggerPrice, msg.TriggerPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyTriggerCondition, msg.TriggerCondition.String()),
+			sdk.NewAttribute(types.AttributeKeyOrderType, triggeredOrderType.String()),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(order.BatchId, 10)),
+			sdk.NewAttribute(types.AttributeKeyExpireAt, order.ExpireAt.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTriggerOrder{
+		Orderer:            msg.Orderer,
+		PairId:             msg.PairId,
+		Direction:          msg.Direction,
+		OfferCoin:          resultOfferCoin,
+		DemandCoinDenom:    msg.DemandCoinDenom,
+		Price:              resultPrice,
+		Amount:             msg.Amount,
+		TriggerPrice:       msg.TriggerPrice,
+		TriggerCondition:   msg.TriggerCondition,
+		TriggeredOrderType: triggeredOrderType,
+		OrderId:            order.Id,
+		BatchId:            order.BatchId,
+		ExpireAt:           order.ExpireAt,
+		RefundedCoin:       refundedCoin,
+	}); err != nil {
+		return types.Order{}, err
+	}
+
+	k.AfterOrderPlaced(ctx, order)
+
+	return order, nil
+}
+
 // ValidateMsgCancelOrder validates types.MsgCancelOrder and returns the order.
 func (k Keeper) ValidateMsgCancelOrder(ctx sdk.Context, msg *types.MsgCancelOrder) (order types.Order, err error) {
 	var found bool
@@ -231,10 +643,159 @@ func (k Keeper) CancelOrder(ctx sdk.Context, msg *types.MsgCancelOrder) error {
 			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(msg.OrderId, 10)),
 		),
 	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCancelOrder{
+		Orderer: msg.Orderer,
+		PairId:  msg.PairId,
+		OrderId: msg.OrderId,
+	}); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// ValidateMsgReplaceOrder validates types.MsgReplaceOrder and returns
+// the order to be replaced.
+func (k Keeper) ValidateMsgReplaceOrder(ctx sdk.Context, msg *types.MsgReplaceOrder) (order types.Order, err error) {
+	order, err = k.ValidateMsgCancelOrder(ctx, types.NewMsgCancelOrder(msg.GetOrderer(), msg.PairId, msg.OrderId))
+	if err != nil {
+		return types.Order{}, err
+	}
+	if !order.Status.IsMatchable() {
+		return types.Order{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order with status %s cannot be replaced", order.Status)
+	}
+	if order.Type != types.OrderTypeLimit && order.Type != types.OrderTypeMM {
+		return types.Order{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order type %s cannot be replaced", order.Type)
+	}
+	if pair, _ := k.GetPair(ctx, msg.PairId); pair.OrdersPaused {
+		return types.Order{}, sdkerrors.Wrapf(types.ErrOrdersPaused, "pair %d", msg.PairId)
+	}
+	maxOrderLifespan := k.GetMaxOrderLifespan(ctx)
+	if msg.OrderLifespan > maxOrderLifespan {
+		return types.Order{},
+			sdkerrors.Wrapf(types.ErrTooLongOrderLifespan, "%s is longer than %s", msg.OrderLifespan, maxOrderLifespan)
+	}
+	return order, nil
+}
+
+// ReplaceOrder handles types.MsgReplaceOrder and replaces an order with
+// a new one in the same pair.
+// Only the difference between the old order's remaining offer coin and
+// the new order's offer coin is transferred.
+// The new order keeps the old order's batch id, and thus its priority,
+// when the price is unchanged and the amount is not increased.
+func (k Keeper) ReplaceOrder(ctx sdk.Context, msg *types.MsgReplaceOrder) (types.Order, error) {
+	order, err := k.ValidateMsgReplaceOrder(ctx, msg)
+	if err != nil {
+		return types.Order{}, err
+	}
+
+	pair, _ := k.GetPair(ctx, msg.PairId)
+	price, err := k.orderPrice(ctx, pair, order.Type, order.Direction, &msg.Price)
+	if err != nil {
+		return types.Order{}, err
+	}
+	if err := validateOrderSize(pair, msg.Amount, price); err != nil {
+		return types.Order{}, err
+	}
+	if order.PostOnly {
+		if err := validatePostOnlyPrice(k.OrderView(ctx, pair), order.Direction, price); err != nil {
+			return types.Order{}, err
+		}
+	}
+
+	var offerCoin sdk.Coin
+	switch order.Direction {
+	case types.OrderDirectionBuy:
+		offerCoin = sdk.NewCoin(pair.QuoteCoinDenom, amm.OfferCoinAmount(amm.Buy, price, msg.Amount))
+	case types.OrderDirectionSell:
+		offerCoin = sdk.NewCoin(pair.BaseCoinDenom, msg.Amount)
+	}
+
+	ordererAddr := msg.GetOrderer()
+	escrowAddr := pair.GetEscrowAddress()
+	switch {
+	case offerCoin.Amount.GT(order.RemainingOfferCoin.Amount):
+		diff := offerCoin.Sub(order.RemainingOfferCoin)
+		spendable := k.bankKeeper.SpendableCoins(ctx, ordererAddr)
+		if spendableAmt := spendable.AmountOf(diff.Denom); spendableAmt.LT(diff.Amount) {
+			return types.Order{}, sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "%s is smaller than %s",
+				sdk.NewCoin(diff.Denom, spendableAmt), diff)
+		}
+		if err := k.bankKeeper.SendCoins(ctx, ordererAddr, escrowAddr, sdk.NewCoins(diff)); err != nil {
+			return types.Order{}, err
+		}
+	case offerCoin.Amount.LT(order.RemainingOfferCoin.Amount):
+		diff := order.RemainingOfferCoin.Sub(offerCoin)
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddr, ordererAddr, sdk.NewCoins(diff)); err != nil {
+			return types.Order{}, err
+		}
+	}
+
+	// The old order's remaining offer coin has been moved to the new order,
+	// so it is canceled without being refunded.
+	keepPriority := price.Equal(order.Price) && msg.Amount.LTE(order.OpenAmount)
+	batchId := order.BatchId
+	order.RemainingOfferCoin = sdk.NewCoin(order.RemainingOfferCoin.Denom, sdk.ZeroInt())
+	if err := k.FinishOrder(ctx, order, types.OrderStatusCanceled); err != nil {
+		return types.Order{}, err
+	}
+	// The number of MM orders doesn't change since the new order has
+	// the same type as the old one.
+	if order.Type == types.OrderTypeMM {
+		k.SetNumMMOrders(ctx, ordererAddr, pair.Id, k.GetNumMMOrders(ctx, ordererAddr, pair.Id)+1)
+	}
+
+	orderId := k.getNextOrderIdWithUpdate(ctx, pair)
+	expireAt := ctx.BlockTime().Add(msg.OrderLifespan)
+	newOrder := types.NewOrder(
+		order.Type, orderId, pair, ordererAddr, offerCoin, price, msg.Amount, expireAt, ctx.BlockHeight())
+	newOrder.TimeInForce = order.TimeInForce
+	newOrder.PostOnly = order.PostOnly
+	if keepPriority {
+		newOrder.BatchId = batchId
+	}
+	k.SetOrder(ctx, newOrder)
+	k.SetOrderIndex(ctx, newOrder)
+
+	ctx.GasMeter().ConsumeGas(k.GetOrderExtraGas(ctx), "OrderExtraGas")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReplaceOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderDirection, newOrder.Direction.String()),
+			sdk.NewAttribute(types.AttributeKeyReplacedOrderId, strconv.FormatUint(msg.OrderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(newOrder.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOfferCoin, offerCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(newOrder.BatchId, 10)),
+			sdk.NewAttribute(types.AttributeKeyExpireAt, newOrder.ExpireAt.Format(time.RFC3339)),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventReplaceOrder{
+		Orderer:         msg.Orderer,
+		PairId:          msg.PairId,
+		Direction:       newOrder.Direction,
+		ReplacedOrderId: msg.OrderId,
+		OrderId:         newOrder.Id,
+		OfferCoin:       offerCoin,
+		Price:           price,
+		Amount:          msg.Amount,
+		BatchId:         newOrder.BatchId,
+		ExpireAt:        newOrder.ExpireAt,
+	}); err != nil {
+		return types.Order{}, err
+	}
+
+	k.AfterOrderPlaced(ctx, newOrder)
+
+	return newOrder, nil
+}
+
 // CancelAllOrders handles types.MsgCancelAllOrders and cancels all orders.
 func (k Keeper) CancelAllOrders(ctx sdk.Context, msg *types.MsgCancelAllOrders) error {
 	orderPairCache := map[uint64]types.Pair{} // maps order's pair id to pair, to cache the result
@@ -250,7 +811,7 @@ func (k Keeper) CancelAllOrders(ctx sdk.Context, msg *types.MsgCancelAllOrders)
 		orderPairCache[pairId] = pair // also cache the pair to use at below
 	}
 
-	var canceledOrderIds []string
+	var canceledOrderIds []uint64
 	if err := k.IterateOrdersByOrderer(ctx, msg.GetOrderer(), func(order types.Order) (stop bool, err error) {
 		_, ok := pairIdSet[order.PairId] // is the pair included in the pair set?
 		if len(pairIdSet) == 0 || ok {   // pair ids not specified(cancel all), or the pair is in the set
@@ -263,7 +824,7 @@ func (k Keeper) CancelAllOrders(ctx sdk.Context, msg *types.MsgCancelAllOrders)
 				if err := k.FinishOrder(ctx, order, types.OrderStatusCanceled); err != nil {
 					return false, err
 				}
-				canceledOrderIds = append(canceledOrderIds, strconv.FormatUint(order.Id, 10))
+				canceledOrderIds = append(canceledOrderIds, order.Id)
 			}
 		}
 		return false, nil
@@ -276,45 +837,74 @@ func (k Keeper) CancelAllOrders(ctx sdk.Context, msg *types.MsgCancelAllOrders)
 			types.EventTypeCancelAllOrders,
 			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
 			sdk.NewAttribute(types.AttributeKeyPairIds, strings.Join(pairIds, ",")),
-			sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, strings.Join(canceledOrderIds, ",")),
+			sdk.NewAttribute(types.AttributeKeyCanceledOrderIds, types.FormatUint64s(canceledOrderIds)),
 		),
 	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCancelAllOrders{
+		Orderer:          msg.Orderer,
+		PairIds:          msg.PairIds,
+		CanceledOrderIds: canceledOrderIds,
+	}); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// ExecuteMatching matches the orders of the pair in the batch and applies
+// the result.
 func (k Keeper) ExecuteMatching(ctx sdk.Context, pair types.Pair) error {
-	ob := amm.NewOrderBook()
-
-	if err := k.IterateOrdersByPair(ctx, pair.Id, func(order types.Order) (stop bool, err error) {
-		switch order.Status {
-		case types.OrderStatusNotExecuted,
-			types.OrderStatusNotMatched,
-			types.OrderStatusPartiallyMatched:
-			if order.Status != types.OrderStatusNotExecuted && order.ExpiredAt(ctx.BlockTime()) {
-				if err := k.FinishOrder(ctx, order, types.OrderStatusExpired); err != nil {
-					return false, err
-				}
-				return false, nil
-			}
-			// TODO: add orders only when price is in the range?
-			ob.AddOrder(types.NewUserOrder(order))
-			if order.Status == types.OrderStatusNotExecuted {
-				order.SetStatus(types.OrderStatusNotMatched)
-				k.SetOrder(ctx, order)
-			}
-		case types.OrderStatusCanceled:
-		default:
-			return false, fmt.Errorf("invalid order status: %s", order.Status)
-		}
-		return false, nil
-	}); err != nil {
+	m, err := k.prepareMatching(ctx, pair)
+	if err != nil {
 		return err
 	}
+	m.match()
+	return k.applyMatching(ctx, m)
+}
+
+// pairMatching holds what is needed to match the orders of a pair, which
+// is read from the store beforehand, and the result of the matching.
+// The matching itself doesn't access the store, so the matching of
+// different pairs can be computed concurrently.
+type pairMatching struct {
+	pair                      types.Pair
+	tickPrec                  int
+	lowestPrice, highestPrice sdk.Dec // Set only when the pair has the last price.
+	orders, triggerOrders     []types.Order
+	pools                     []*types.PoolOrderer
+
+	// The changes found while collecting the orders and pools, which are
+	// written in applyMatching.
+	newOrders     []types.Order // Orders placed in this batch, to be marked as not matched.
+	expiredOrders []types.Order // Resting orders which have been expired.
+	depletedPools []types.Pool  // Pools to be disabled.
+
+	// The result of the matching.
+	ob             *amm.OrderBook
+	userOrders     []*types.UserOrder
+	canceledOrders []types.Order // Fill-or-kill orders which are not fully matched.
+	matchPrice     sdk.Dec
+	quoteCoinDiff  sdk.Int
+	matched        bool
+}
+
+// prepareMatching collects the orders and pools of the pair to be matched
+// in the batch.
+// prepareMatching doesn't write to the store; the changes found are written
+// in applyMatching, so that the writes of the pairs are not interleaved
+// differently when the matchings are prepared for all pairs beforehand.
+func (k Keeper) prepareMatching(ctx sdk.Context, pair types.Pair) (*pairMatching, error) {
+	m := &pairMatching{
+		pair:     pair,
+		tickPrec: k.GetPairTickPrecision(ctx, pair),
+	}
+	if err := k.collectOrders(ctx, m); err != nil {
+		return nil, err
+	}
 
 	var pools []*types.PoolOrderer
 	_ = k.IteratePoolsByPair(ctx, pair.Id, func(pool types.Pool) (stop bool, err error) {
-		if pool.Disabled {
+		if pool.Disabled || pool.OrdersPaused {
 			return false, nil
 		}
 		rx, ry := k.getPoolBalances(ctx, pool, pair)
@@ -323,30 +913,289 @@ func (k Keeper) ExecuteMatching(ctx sdk.Context, pair types.Pair) error {
 			pool.AMMPool(rx.Amount, ry.Amount, ps),
 			pool.Id, pool.GetReserveAddress(), pair.BaseCoinDenom, pair.QuoteCoinDenom)
 		if ammPool.IsDepleted() {
-			k.MarkPoolAsDisabled(ctx, pool)
+			// A concentrated pool with no liquidity is not disabled since
+			// positions can be opened in it later.
+			if pool.Type != types.PoolTypeConcentrated {
+				m.depletedPools = append(m.depletedPools, pool)
+			}
 			return false, nil
 		}
 		pools = append(pools, ammPool)
 		return false, nil
 	})
+	m.pools = pools
+
+	if pair.LastPrice != nil {
+		m.lowestPrice, m.highestPrice = k.PriceLimits(ctx, pair, *pair.LastPrice)
+	}
+	return m, nil
+}
+
+// match matches the orders and pools of the pair.
+// Fill-or-kill orders which are not fully matched are removed from
+// the order book and the matching is done again, until every fill-or-kill
+// order left in the order book is fully matched.
+func (m *pairMatching) match() {
+	orders := m.orders
+	for {
+		m.ob = amm.NewOrderBook()
+		m.ob.SetAllocationPolicy(m.pair.AllocationPolicy.AMMAllocationPolicy())
+		m.userOrders = make([]*types.UserOrder, len(orders))
+		for i, order := range orders {
+			m.userOrders[i] = types.NewUserOrder(order)
+			m.ob.AddOrder(m.userOrders[i])
+		}
+		m.matchPrice, m.quoteCoinDiff, m.matched = matchOrderBook(
+			m.ob, m.pools, m.pair.LastPrice, m.tickPrec, m.lowestPrice, m.highestPrice)
 
-	matchPrice, quoteCoinDiff, matched := k.Match(ctx, ob, pools, pair.LastPrice)
-	if matched {
-		orders := ob.Orders()
-		if err := k.ApplyMatchResult(ctx, pair, orders, quoteCoinDiff); err != nil {
+		var remainingOrders []types.Order
+		for i, order := range orders {
+			if order.TimeInForce == types.TimeInForceFillOrKill && !m.userOrders[i].IsFullyMatched(order) {
+				m.canceledOrders = append(m.canceledOrders, order)
+				continue
+			}
+			remainingOrders = append(remainingOrders, order)
+		}
+		if len(remainingOrders) == len(orders) {
+			break
+		}
+		orders = remainingOrders
+	}
+	m.orders = orders
+}
+
+// applyMatching applies the changes found by prepareMatching and the result
+// of the matching to the state.
+func (k Keeper) applyMatching(ctx sdk.Context, m *pairMatching) error {
+	pair := m.pair
+	for _, order := range m.newOrders {
+		prev := order
+		order.SetStatus(types.OrderStatusNotMatched)
+		k.setOrderWithPrev(ctx, &prev, order)
+	}
+	for _, order := range m.expiredOrders {
+		if err := k.FinishOrder(ctx, order, types.OrderStatusExpired); err != nil {
+			return err
+		}
+	}
+	for _, pool := range m.depletedPools {
+		k.MarkPoolAsDisabled(ctx, pool)
+	}
+
+	for _, order := range m.canceledOrders {
+		if err := k.FinishOrder(ctx, order, types.OrderStatusCanceled); err != nil {
+			return err
+		}
+	}
+
+	if m.matched {
+		if err := k.ApplyMatchResult(ctx, pair, m.ob.Orders(), m.quoteCoinDiff); err != nil {
 			return err
 		}
+		matchPrice := m.matchPrice
 		pair.LastPrice = &matchPrice
+		baseVolume, quoteVolume := matchedVolumes(m.ob.Orders())
+		k.UpdateCandles(ctx, pair, matchPrice, baseVolume, quoteVolume)
+	}
+
+	// Immediate-or-cancel orders which are not matched at all are canceled here.
+	// Partially matched ones are handled in ApplyMatchResult.
+	for i, order := range m.orders {
+		if order.TimeInForce == types.TimeInForceImmediateOrCancel && !m.userOrders[i].IsMatched() {
+			if err := k.FinishOrder(ctx, order, types.OrderStatusCanceled); err != nil {
+				return err
+			}
+		}
 	}
 
 	pair.CurrentBatchId++
 	k.SetPair(ctx, pair)
+	k.UpdateTWAPAccumulator(ctx, pair)
+
+	// Trigger orders are checked after the last price has been updated.
+	// Expired trigger orders are handled in ExecuteRequests, like other orders.
+	if pair.LastPrice != nil {
+		for _, order := range m.triggerOrders {
+			if order.ShouldBeTriggered(*pair.LastPrice) {
+				if err := k.ExecuteTriggerOrder(ctx, pair, order); err != nil {
+					return err
+				}
+			}
+		}
+	}
 
 	return nil
 }
 
-func (k Keeper) Match(ctx sdk.Context, ob *amm.OrderBook, pools []*types.PoolOrderer, lastPrice *sdk.Dec) (matchPrice sdk.Dec, quoteCoinDiff sdk.Int, matched bool) {
-	tickPrec := int(k.GetTickPrecision(ctx))
+// collectOrders collects the orders to be added to the order book of the
+// pair in this batch, sorted by their ids, and the trigger orders not
+// triggered yet.
+// Orders placed in this batch are collected as not matched, and expired
+// resting orders are collected separately, to be written in applyMatching.
+func (k Keeper) collectOrders(ctx sdk.Context, m *pairMatching) error {
+	pair := m.pair
+	// newOrder collects the order placed in this batch as not matched.
+	newOrder := func(order types.Order) {
+		m.newOrders = append(m.newOrders, order)
+		order.SetStatus(types.OrderStatusNotMatched)
+		m.orders = append(m.orders, order)
+	}
+	if pair.LastPrice == nil {
+		// There's no price limit before the first match, so every order is
+		// collected.
+		return k.IterateOrdersByPair(ctx, pair.Id, func(order types.Order) (stop bool, err error) {
+			switch order.Status {
+			case types.OrderStatusNotExecuted,
+				types.OrderStatusNotMatched,
+				types.OrderStatusPartiallyMatched:
+				switch {
+				case order.Type == types.OrderTypeTrigger: // Not triggered yet.
+					m.triggerOrders = append(m.triggerOrders, order)
+				case order.Status == types.OrderStatusNotExecuted:
+					newOrder(order)
+				case order.ExpiredAt(ctx.BlockTime()):
+					m.expiredOrders = append(m.expiredOrders, order)
+				default:
+					m.orders = append(m.orders, order)
+				}
+			case types.OrderStatusCanceled:
+			default:
+				return false, fmt.Errorf("invalid order status: %s", order.Status)
+			}
+			return false, nil
+		})
+	}
+
+	// Every order placed in this batch is collected regardless of its price,
+	// so that immediate-or-cancel and fill-or-kill orders are handled the same.
+	_ = k.IterateOrdersByPairAndStatus(ctx, pair.Id, types.OrderStatusNotExecuted, func(order types.Order) (stop bool, err error) {
+		if order.Type == types.OrderTypeTrigger { // Not triggered yet.
+			m.triggerOrders = append(m.triggerOrders, order)
+		} else {
+			newOrder(order)
+		}
+		return false, nil
+	})
+	// Resting orders are streamed from the price index, and only those which
+	// can be matched in this batch are collected.
+	// The order book is matched within the price limits, but a resting order
+	// beyond the limits can still be matched with an order on the other side
+	// crossing it.
+	// So the range is widened up to the best price of the other side, which
+	// keeps the matching result the same as with all orders.
+	minBuyPrice, maxSellPrice := k.PriceLimits(ctx, pair, *pair.LastPrice)
+	_ = k.IterateOrdersByPrice(ctx, pair.Id, types.OrderDirectionSell, func(order types.Order) (stop bool, err error) {
+		minBuyPrice = sdk.MinDec(minBuyPrice, order.Price)
+		return true, nil
+	})
+	_ = k.IterateOrdersByPrice(ctx, pair.Id, types.OrderDirectionBuy, func(order types.Order) (stop bool, err error) {
+		maxSellPrice = sdk.MaxDec(maxSellPrice, order.Price)
+		return true, nil
+	})
+	collectRestingOrder := func(order types.Order) {
+		switch {
+		case order.Status == types.OrderStatusNotExecuted: // Already collected.
+		case order.ExpiredAt(ctx.BlockTime()):
+			m.expiredOrders = append(m.expiredOrders, order)
+		default:
+			m.orders = append(m.orders, order)
+		}
+	}
+	_ = k.IterateOrdersByPrice(ctx, pair.Id, types.OrderDirectionBuy, func(order types.Order) (stop bool, err error) {
+		if order.Price.LT(minBuyPrice) {
+			return true, nil
+		}
+		collectRestingOrder(order)
+		return false, nil
+	})
+	_ = k.IterateOrdersByPrice(ctx, pair.Id, types.OrderDirectionSell, func(order types.Order) (stop bool, err error) {
+		if order.Price.GT(maxSellPrice) {
+			return true, nil
+		}
+		collectRestingOrder(order)
+		return false, nil
+	})
+	sort.Slice(m.expiredOrders, func(i, j int) bool {
+		return m.expiredOrders[i].Id < m.expiredOrders[j].Id
+	})
+	// Orders are added to the order book in the order of their ids, as the
+	// allocation within a tick depends on it.
+	sort.Slice(m.orders, func(i, j int) bool {
+		return m.orders[i].Id < m.orders[j].Id
+	})
+	return nil
+}
+
+// ExecuteTriggerOrder converts a trigger order into a limit or market order,
+// which will be matched from the next batch.
+func (k Keeper) ExecuteTriggerOrder(ctx sdk.Context, pair types.Pair, order types.Order) error {
+	prev := order
+	if order.TriggeredOrderType == types.OrderTypeMarket {
+		tickPrec := k.GetPairTickPrecision(ctx, pair)
+		maxPriceLimitRatio := k.GetMaxPriceLimitRatio(ctx)
+		switch order.Direction {
+		case types.OrderDirectionBuy:
+			order.Price = amm.PriceToDownTick(pair.LastPrice.Mul(sdk.OneDec().Add(maxPriceLimitRatio)), tickPrec)
+			// The offer coin might not be enough to buy the whole amount at the new price,
+			// so reduce the order amount in that case.
+			order.Amount = sdk.MinInt(
+				order.Amount, order.RemainingOfferCoin.Amount.ToDec().QuoTruncate(order.Price).TruncateInt())
+			order.OpenAmount = order.Amount
+		case types.OrderDirectionSell:
+			order.Price = amm.PriceToUpTick(pair.LastPrice.Mul(sdk.OneDec().Sub(maxPriceLimitRatio)), tickPrec)
+		}
+		// The order might become too small for the pair after the amount is
+		// reduced, so the order is canceled in that case.
+		if err := validateOrderSize(pair, order.Amount, order.Price); err != nil {
+			return k.FinishOrder(ctx, order, types.OrderStatusCanceled)
+		}
+	}
+	order.Type = order.TriggeredOrderType
+	order.BatchId = pair.CurrentBatchId
+	k.setOrderWithPrev(ctx, &prev, order)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeOrderTriggered,
+			sdk.NewAttribute(types.AttributeKeyOrderer, order.Orderer),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(order.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderType, order.Type.String()),
+			sdk.NewAttribute(types.AttributeKeyLastPrice, pair.LastPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, order.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, order.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(order.BatchId, 10)),
+		),
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderTriggered{
+		Orderer:   order.Orderer,
+		PairId:    order.PairId,
+		OrderId:   order.Id,
+		OrderType: order.Type,
+		LastPrice: *pair.LastPrice,
+		Price:     order.Price,
+		Amount:    order.Amount,
+		BatchId:   order.BatchId,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (k Keeper) Match(ctx sdk.Context, pair types.Pair, ob *amm.OrderBook, pools []*types.PoolOrderer, lastPrice *sdk.Dec) (matchPrice sdk.Dec, quoteCoinDiff sdk.Int, matched bool) {
+	var lowestPrice, highestPrice sdk.Dec
+	if lastPrice != nil {
+		lowestPrice, highestPrice = k.PriceLimits(ctx, pair, *lastPrice)
+	}
+	return matchOrderBook(ob, pools, lastPrice, k.GetPairTickPrecision(ctx, pair), lowestPrice, highestPrice)
+}
+
+// matchOrderBook matches the order book with the pools.
+// The price limits are used only when the last price is not nil.
+func matchOrderBook(
+	ob *amm.OrderBook, pools []*types.PoolOrderer, lastPrice *sdk.Dec, tickPrec int,
+	lowestPrice, highestPrice sdk.Dec) (matchPrice sdk.Dec, quoteCoinDiff sdk.Int, matched bool) {
 	if lastPrice == nil {
 		ov := amm.MultipleOrderViews{ob.MakeView()}
 		for _, pool := range pools {
@@ -369,7 +1218,6 @@ func (k Keeper) Match(ctx sdk.Context, ob *amm.OrderBook, pools []*types.PoolOrd
 		}
 		quoteCoinDiff, matched = ob.MatchAtSinglePrice(matchPrice)
 	} else {
-		lowestPrice, highestPrice := k.PriceLimits(ctx, *lastPrice)
 		for _, pool := range pools {
 			poolOrders := amm.PoolOrders(pool, pool, lowestPrice, highestPrice, tickPrec)
 			ob.AddOrder(poolOrders...)
@@ -379,8 +1227,40 @@ func (k Keeper) Match(ctx sdk.Context, ob *amm.OrderBook, pools []*types.PoolOrd
 	return
 }
 
+// SimulateOrder simulates the matching of the order in the next batch,
+// with the current orders and pools of the pair.
+// The order is matched the same way as in the batch matching, after the
+// orders already placed, and fill-or-kill orders are handled the same.
+// The matching is done on a cache-wrapped context, so nothing is written to
+// the state.
+// The returned user order holds the result of the order, and matchPrice and
+// matched are the result of the whole batch matching.
+func (k Keeper) SimulateOrder(
+	ctx sdk.Context, pair types.Pair, order types.Order) (userOrder *types.UserOrder, matchPrice sdk.Dec, matched bool, err error) {
+	if pair.OrdersPaused {
+		return nil, sdk.Dec{}, false, sdkerrors.Wrapf(types.ErrOrdersPaused, "pair %d", pair.Id)
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	m, err := k.prepareMatching(cacheCtx, pair)
+	if err != nil {
+		return nil, sdk.Dec{}, false, err
+	}
+	m.orders = append(m.orders, order)
+	m.match()
+	for i, o := range m.orders {
+		if o.Id == order.Id {
+			return m.userOrders[i], m.matchPrice, m.matched, nil
+		}
+	}
+	// The order is a fill-or-kill order which can't be fully matched, so it
+	// has been removed from the order book.
+	return types.NewUserOrder(order), m.matchPrice, m.matched, nil
+}
+
 func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.Order, quoteCoinDiff sdk.Int) error {
 	bulkOp := types.NewBulkSendCoinsOperation()
+	poolPriceBeforeById := map[uint64]sdk.Dec{}
 	for _, order := range orders { // TODO: need optimization to filter matched orders only
 		order, ok := order.(*types.PoolOrder)
 		if !ok {
@@ -389,6 +1269,10 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 		if !order.IsMatched() {
 			continue
 		}
+		if _, ok := poolPriceBeforeById[order.PoolId]; !ok {
+			pool, _ := k.GetPool(ctx, order.PoolId)
+			poolPriceBeforeById[order.PoolId] = k.getPoolOrderer(ctx, pool, pair).Price()
+		}
 		paidCoin := sdk.NewCoin(order.OfferCoinDenom, order.PaidOfferCoinAmount)
 		bulkOp.QueueSendCoins(order.ReserveAddress, pair.GetEscrowAddress(), sdk.NewCoins(paidCoin))
 	}
@@ -405,6 +1289,14 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 	}
 	poolMatchResultById := map[uint64]*PoolMatchResult{}
 	var poolMatchResults []*PoolMatchResult
+	type UserOrderMatchResult struct {
+		OrderId      uint64
+		PaidCoin     sdk.Coin
+		ReceivedCoin sdk.Coin
+	}
+	var userOrderMatchResults []UserOrderMatchResult
+	feeCollector := k.GetFeeCollector(ctx)
+	makerFeeRate, takerFeeRate := k.GetPairFeeRates(pair)
 	for _, order := range orders {
 		if !order.IsMatched() {
 			continue
@@ -414,23 +1306,45 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 
 		switch order := order.(type) {
 		case *types.UserOrder:
+			// Orders which have been resting on the order book since earlier
+			// batches are makers, and the others are takers.
+			feeRate := takerFeeRate
+			if order.BatchId < pair.CurrentBatchId {
+				feeRate = makerFeeRate
+			}
 			paidCoin := sdk.NewCoin(order.OfferCoinDenom, order.PaidOfferCoinAmount)
-			receivedCoin := sdk.NewCoin(order.DemandCoinDenom, order.ReceivedDemandCoinAmount)
+			fee := sdk.NewCoin(order.DemandCoinDenom, feeRate.MulInt(order.ReceivedDemandCoinAmount).TruncateInt())
+			receivedCoin := sdk.NewCoin(order.DemandCoinDenom, order.ReceivedDemandCoinAmount.Sub(fee.Amount))
 
 			o, _ := k.GetOrder(ctx, pair.Id, order.OrderId)
 			o.OpenAmount = o.OpenAmount.Sub(matchedAmt)
 			o.RemainingOfferCoin = o.RemainingOfferCoin.Sub(paidCoin)
 			o.ReceivedCoin = o.ReceivedCoin.Add(receivedCoin)
+			o.Fee = o.Fee.Add(fee)
 
-			if o.OpenAmount.IsZero() {
+			switch {
+			case o.OpenAmount.IsZero():
 				if err := k.FinishOrder(ctx, o, types.OrderStatusCompleted); err != nil {
 					return err
 				}
-			} else {
+			case o.TimeInForce == types.TimeInForceImmediateOrCancel:
+				// The unmatched part of an immediate-or-cancel order is canceled
+				// right after the batch.
+				if err := k.FinishOrder(ctx, o, types.OrderStatusCanceled); err != nil {
+					return err
+				}
+			default:
+				prev := o
 				o.SetStatus(types.OrderStatusPartiallyMatched)
-				k.SetOrder(ctx, o)
+				k.setOrderWithPrev(ctx, &prev, o)
 			}
 			bulkOp.QueueSendCoins(pair.GetEscrowAddress(), order.Orderer, sdk.NewCoins(receivedCoin))
+			bulkOp.QueueSendCoins(pair.GetEscrowAddress(), feeCollector, sdk.NewCoins(fee))
+			userOrderMatchResults = append(userOrderMatchResults, UserOrderMatchResult{
+				OrderId:      order.OrderId,
+				PaidCoin:     paidCoin,
+				ReceivedCoin: receivedCoin,
+			})
 
 			ctx.EventManager().EmitEvents(sdk.Events{
 				sdk.NewEvent(
@@ -442,8 +1356,21 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 					sdk.NewAttribute(types.AttributeKeyMatchedAmount, matchedAmt.String()),
 					sdk.NewAttribute(types.AttributeKeyPaidCoin, paidCoin.String()),
 					sdk.NewAttribute(types.AttributeKeyReceivedCoin, receivedCoin.String()),
+					sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
 				),
 			})
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventUserOrderMatched{
+				Direction:     types.OrderDirectionFromAMM(order.Direction),
+				Orderer:       order.Orderer.String(),
+				PairId:        pair.Id,
+				OrderId:       order.OrderId,
+				MatchedAmount: matchedAmt,
+				PaidCoin:      paidCoin,
+				ReceivedCoin:  receivedCoin,
+				Fee:           fee,
+			}); err != nil {
+				return err
+			}
 		case *types.PoolOrder:
 			paidCoin := sdk.NewCoin(order.OfferCoinDenom, order.PaidOfferCoinAmount)
 			receivedCoin := sdk.NewCoin(order.DemandCoinDenom, order.ReceivedDemandCoinAmount)
@@ -477,7 +1404,20 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
 		return err
 	}
+	for _, r := range userOrderMatchResults {
+		order, _ := k.GetOrder(ctx, pair.Id, r.OrderId)
+		k.AfterOrderMatched(ctx, order, r.PaidCoin, r.ReceivedCoin)
+	}
 	for _, r := range poolMatchResults {
+		pool, _ := k.GetPool(ctx, r.PoolId)
+		if pool.Type == types.PoolTypeConcentrated {
+			k.applyConcentratedPoolMatchResult(
+				ctx, pool, pair, r.OrderDirection, r.PaidCoin, r.ReceivedCoin, r.MatchedAmount)
+			pool, _ = k.GetPool(ctx, r.PoolId)
+		}
+		k.accruePoolMatchResult(
+			ctx, pool, pair, r.OrderDirection, r.PaidCoin, r.ReceivedCoin, r.MatchedAmount,
+			poolPriceBeforeById[r.PoolId])
 		ctx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
 				types.EventTypePoolOrderMatched,
@@ -489,6 +1429,16 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 				sdk.NewAttribute(types.AttributeKeyReceivedCoin, r.ReceivedCoin.String()),
 			),
 		})
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventPoolOrderMatched{
+			Direction:     r.OrderDirection,
+			PairId:        pair.Id,
+			PoolId:        r.PoolId,
+			MatchedAmount: r.MatchedAmount,
+			PaidCoin:      r.PaidCoin,
+			ReceivedCoin:  r.ReceivedCoin,
+		}); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -505,8 +1455,11 @@ func (k Keeper) FinishOrder(ctx sdk.Context, order types.Order, status types.Ord
 		}
 	}
 
+	// The order's status and price are the same as the stored order's,
+	// since only the amounts are changed by the callers.
+	prev := order
 	order.SetStatus(status)
-	k.SetOrder(ctx, order)
+	k.setOrderWithPrev(ctx, &prev, order)
 	if order.Type == types.OrderTypeMM {
 		ordererAddr := order.GetOrderer()
 		numMMOrders := k.GetNumMMOrders(ctx, ordererAddr, order.PairId)
@@ -529,9 +1482,25 @@ func (k Keeper) FinishOrder(ctx sdk.Context, order types.Order, status types.Ord
 			sdk.NewAttribute(types.AttributeKeyOfferCoin, order.OfferCoin.String()),
 			sdk.NewAttribute(types.AttributeKeyRemainingOfferCoin, order.RemainingOfferCoin.String()),
 			sdk.NewAttribute(types.AttributeKeyReceivedCoin, order.ReceivedCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, order.Fee.String()),
 			sdk.NewAttribute(types.AttributeKeyStatus, order.Status.String()),
 		),
 	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderResult{
+		Direction:          order.Direction,
+		Orderer:            order.Orderer,
+		PairId:             order.PairId,
+		OrderId:            order.Id,
+		Amount:             order.Amount,
+		OpenAmount:         order.OpenAmount,
+		OfferCoin:          order.OfferCoin,
+		RemainingOfferCoin: order.RemainingOfferCoin,
+		ReceivedCoin:       order.ReceivedCoin,
+		Fee:                order.Fee,
+		Status:             order.Status,
+	}); err != nil {
+		return err
+	}
 
 	return nil
 }
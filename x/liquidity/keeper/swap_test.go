@@ -3,14 +3,17 @@ package keeper_test
 import (
 	"fmt"
 	"math/rand"
+	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	utils "github.com/crescent-network/crescent/v5/types"
 	"github.com/crescent-network/crescent/v5/x/liquidity"
 	"github.com/crescent-network/crescent/v5/x/liquidity/amm"
+	"github.com/crescent-network/crescent/v5/x/liquidity/keeper"
 	"github.com/crescent-network/crescent/v5/x/liquidity/types"
 
 	_ "github.com/stretchr/testify/suite"
@@ -292,6 +295,380 @@ func (s *KeeperTestSuite) TestMarketOrderWithNoLastPrice() {
 	s.Require().ErrorIs(err, types.ErrNoLastPrice)
 }
 
+func (s *KeeperTestSuite) TestTriggerOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	s.nextBlock()
+
+	// Stop-loss market sell order.
+	orderer := s.addr(3)
+	s.fundAddr(orderer, utils.ParseCoins("10000denom1"))
+	order, err := s.keeper.TriggerOrder(s.ctx, types.NewMsgTriggerOrder(
+		orderer, pair.Id, types.OrderDirectionSell, utils.ParseCoin("10000denom1"), "denom2",
+		nil, sdk.NewInt(10000), utils.ParseDec("0.9"), types.TriggerConditionLTE, time.Hour))
+	s.Require().NoError(err)
+	s.Require().Equal(types.OrderTypeTrigger, order.Type)
+	s.Require().Equal(types.OrderTypeMarket, order.TriggeredOrderType)
+	// The offer coin is escrowed.
+	s.Require().True(s.getBalances(orderer).IsZero())
+
+	// The last price doesn't meet the condition.
+	s.buyLimitOrder(s.addr(4), pair.Id, utils.ParseDec("0.95"), sdk.NewInt(10000), 0, true)
+	s.sellLimitOrder(s.addr(5), pair.Id, utils.ParseDec("0.95"), sdk.NewInt(10000), 0, true)
+	s.nextBlock()
+	order, _ = s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().Equal(types.OrderTypeTrigger, order.Type)
+	s.Require().Equal(types.OrderStatusNotExecuted, order.Status)
+
+	// Now the last price becomes 0.9 and the order gets triggered.
+	s.buyLimitOrder(s.addr(4), pair.Id, utils.ParseDec("0.9"), sdk.NewInt(10000), 0, true)
+	s.sellLimitOrder(s.addr(5), pair.Id, utils.ParseDec("0.9"), sdk.NewInt(10000), 0, true)
+	s.nextBlock()
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(decEq(utils.ParseDec("0.9"), *pair.LastPrice))
+	order, _ = s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().Equal(types.OrderTypeMarket, order.Type)
+	s.Require().Equal(pair.CurrentBatchId, order.BatchId)
+	s.Require().True(decEq(utils.ParseDec("0.81"), order.Price))
+
+	// The triggered order is matched in the next batch.
+	s.buyLimitOrder(s.addr(6), pair.Id, utils.ParseDec("0.85"), sdk.NewInt(10000), 0, true)
+	s.nextBlock()
+	// The order has been completed and deleted.
+	_, found := s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().False(found)
+	s.Require().True(s.getBalance(orderer, "denom1").IsZero())
+	s.Require().True(s.getBalance(orderer, "denom2").IsPositive())
+}
+
+func (s *KeeperTestSuite) TestTriggerOrderLimit() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	s.nextBlock()
+
+	// Take-profit limit sell order.
+	orderer := s.addr(3)
+	s.fundAddr(orderer, utils.ParseCoins("10000denom1"))
+	price := utils.ParseDec("1.06")
+	order, err := s.keeper.TriggerOrder(s.ctx, types.NewMsgTriggerOrder(
+		orderer, pair.Id, types.OrderDirectionSell, utils.ParseCoin("10000denom1"), "denom2",
+		&price, sdk.NewInt(10000), utils.ParseDec("1.05"), types.TriggerConditionGTE, time.Hour))
+	s.Require().NoError(err)
+
+	// The dormant order is not matched even if there's a matchable order.
+	s.buyLimitOrder(s.addr(4), pair.Id, utils.ParseDec("1.08"), sdk.NewInt(10000), 0, true)
+	s.sellLimitOrder(s.addr(5), pair.Id, utils.ParseDec("1.08"), sdk.NewInt(10000), 0, true)
+	s.nextBlock()
+	order, _ = s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().Equal(types.OrderTypeLimit, order.Type)
+	s.Require().True(decEq(price, order.Price))
+	s.Require().True(s.getBalances(orderer).IsZero())
+
+	s.buyLimitOrder(s.addr(6), pair.Id, utils.ParseDec("1.06"), sdk.NewInt(10000), 0, true)
+	s.nextBlock()
+	s.Require().True(coinsEq(utils.ParseCoins("10600denom2"), s.getBalances(orderer)))
+}
+
+func (s *KeeperTestSuite) TestTriggerOrderMarketBuyInsufficientOfferCoin() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	s.nextBlock()
+
+	// The whole offer coin is escrowed for market buy orders.
+	orderer := s.addr(3)
+	s.fundAddr(orderer, utils.ParseCoins("10000denom2"))
+	order, err := s.keeper.TriggerOrder(s.ctx, types.NewMsgTriggerOrder(
+		orderer, pair.Id, types.OrderDirectionBuy, utils.ParseCoin("10000denom2"), "denom1",
+		nil, sdk.NewInt(10000), utils.ParseDec("1.1"), types.TriggerConditionGTE, time.Hour))
+	s.Require().NoError(err)
+	s.Require().True(s.getBalances(orderer).IsZero())
+
+	s.buyLimitOrder(s.addr(4), pair.Id, utils.ParseDec("1.1"), sdk.NewInt(10000), 0, true)
+	s.sellLimitOrder(s.addr(5), pair.Id, utils.ParseDec("1.1"), sdk.NewInt(10000), 0, true)
+	s.nextBlock()
+
+	// The order amount is reduced since the offer coin is not enough
+	// to buy the whole amount at the market order price.
+	order, _ = s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().Equal(types.OrderTypeMarket, order.Type)
+	s.Require().True(decEq(utils.ParseDec("1.21"), order.Price))
+	s.Require().True(intEq(sdk.NewInt(8264), order.Amount))
+	s.Require().True(intEq(sdk.NewInt(8264), order.OpenAmount))
+}
+
+func (s *KeeperTestSuite) TestTriggerOrderMarketBuyMinOrderNotional() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	minOrderNotional := sdk.NewInt(10000)
+	s.handleProposal(types.NewPairOrderRulesProposal(
+		"Change pair order rules", "Description",
+		[]types.PairOrderRulesChange{types.NewPairOrderRulesChange(pair.Id, nil, &minOrderNotional)}))
+
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), 0, true)
+	s.nextBlock()
+
+	orderer := s.addr(3)
+	s.fundAddr(orderer, utils.ParseCoins("10000denom2"))
+	order, err := s.keeper.TriggerOrder(s.ctx, types.NewMsgTriggerOrder(
+		orderer, pair.Id, types.OrderDirectionBuy, utils.ParseCoin("10000denom2"), "denom1",
+		nil, sdk.NewInt(10000), utils.ParseDec("1.1"), types.TriggerConditionGTE, time.Hour))
+	s.Require().NoError(err)
+
+	s.buyLimitOrder(s.addr(4), pair.Id, utils.ParseDec("1.1"), sdk.NewInt(10000), 0, true)
+	s.sellLimitOrder(s.addr(5), pair.Id, utils.ParseDec("1.1"), sdk.NewInt(10000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// The order amount is reduced to 8264 at the price 1.21, whose notional
+	// is smaller than the pair's minimum order notional, so the order is
+	// canceled and the offer coin is refunded.
+	order, _ = s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().Equal(types.OrderStatusCanceled, order.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("10000denom2"), s.getBalances(orderer)))
+}
+
+func (s *KeeperTestSuite) TestCancelTriggerOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	orderer := s.addr(1)
+	s.fundAddr(orderer, utils.ParseCoins("10000denom1"))
+	order, err := s.keeper.TriggerOrder(s.ctx, types.NewMsgTriggerOrder(
+		orderer, pair.Id, types.OrderDirectionSell, utils.ParseCoin("10000denom1"), "denom2",
+		nil, sdk.NewInt(10000), utils.ParseDec("0.9"), types.TriggerConditionLTE, time.Hour))
+	s.Require().NoError(err)
+
+	// Cannot cancel an order within a same batch
+	err = s.keeper.CancelOrder(s.ctx, types.NewMsgCancelOrder(orderer, order.PairId, order.Id))
+	s.Require().ErrorIs(err, types.ErrSameBatch)
+
+	s.nextBlock()
+
+	err = s.keeper.CancelOrder(s.ctx, types.NewMsgCancelOrder(orderer, order.PairId, order.Id))
+	s.Require().NoError(err)
+	order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().Equal(types.OrderStatusCanceled, order.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("10000denom1"), s.getBalances(orderer)))
+}
+
+func (s *KeeperTestSuite) TestTriggerOrderExpiration() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	orderer := s.addr(1)
+	s.fundAddr(orderer, utils.ParseCoins("10000denom1"))
+	order, err := s.keeper.TriggerOrder(s.ctx, types.NewMsgTriggerOrder(
+		orderer, pair.Id, types.OrderDirectionSell, utils.ParseCoin("10000denom1"), "denom2",
+		nil, sdk.NewInt(10000), utils.ParseDec("0.9"), types.TriggerConditionLTE, 10*time.Second))
+	s.Require().NoError(err)
+
+	s.nextBlock()
+	order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().Equal(types.OrderStatusNotExecuted, order.Status)
+
+	s.nextBlock()
+	s.nextBlock()
+	// The order has been expired and deleted.
+	_, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().False(found)
+	s.Require().True(coinsEq(utils.ParseCoins("10000denom1"), s.getBalances(orderer)))
+}
+
+func (s *KeeperTestSuite) timeInForceLimitOrder(
+	orderer sdk.AccAddress, pairId uint64, dir types.OrderDirection,
+	price sdk.Dec, amt sdk.Int, timeInForce types.TimeInForce) types.Order {
+	s.T().Helper()
+	pair, found := s.keeper.GetPair(s.ctx, pairId)
+	s.Require().True(found)
+	var offerCoin sdk.Coin
+	var demandCoinDenom string
+	switch dir {
+	case types.OrderDirectionBuy:
+		offerCoin = sdk.NewCoin(pair.QuoteCoinDenom, amm.OfferCoinAmount(amm.Buy, price, amt))
+		demandCoinDenom = pair.BaseCoinDenom
+	case types.OrderDirectionSell:
+		offerCoin = sdk.NewCoin(pair.BaseCoinDenom, amm.OfferCoinAmount(amm.Sell, price, amt))
+		demandCoinDenom = pair.QuoteCoinDenom
+	}
+	s.fundAddr(orderer, sdk.NewCoins(offerCoin))
+	msg := types.NewMsgLimitOrder(
+		orderer, pairId, dir, offerCoin, demandCoinDenom, price, amt, time.Hour)
+	msg.TimeInForce = timeInForce
+	s.Require().NoError(msg.ValidateBasic())
+	order, err := s.keeper.LimitOrder(s.ctx, msg)
+	s.Require().NoError(err)
+	return order
+}
+
+func (s *KeeperTestSuite) TestImmediateOrCancelOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(5000), time.Hour, true)
+	order := s.timeInForceLimitOrder(
+		s.addr(2), pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), sdk.NewInt(10000),
+		types.TimeInForceImmediateOrCancel)
+	s.Require().Equal(types.TimeInForceImmediateOrCancel, order.TimeInForce)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// The unmatched part of the order has been canceled and refunded.
+	order, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusCanceled, order.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("5000denom1,5000denom2"), s.getBalances(s.addr(2))))
+	s.Require().True(coinsEq(utils.ParseCoins("5000denom2"), s.getBalances(s.addr(1))))
+}
+
+func (s *KeeperTestSuite) TestImmediateOrCancelOrderNoMatch() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	order := s.timeInForceLimitOrder(
+		s.addr(1), pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), sdk.NewInt(10000),
+		types.TimeInForceImmediateOrCancel)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	order, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusCanceled, order.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("10000denom2"), s.getBalances(s.addr(1))))
+
+	s.nextBlock()
+	_, found = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestFillOrKillOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	sellOrder := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(5000), time.Hour, true)
+	fokOrder := s.timeInForceLimitOrder(
+		s.addr(2), pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), sdk.NewInt(10000),
+		types.TimeInForceFillOrKill)
+	buyOrder := s.buyLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(3000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// The fill-or-kill order couldn't be fully matched, so it has been
+	// canceled without being matched at all.
+	fokOrder, _ = s.keeper.GetOrder(s.ctx, fokOrder.PairId, fokOrder.Id)
+	s.Require().Equal(types.OrderStatusCanceled, fokOrder.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("10000denom2"), s.getBalances(s.addr(2))))
+
+	// Other orders have been matched without the fill-or-kill order.
+	buyOrder, _ = s.keeper.GetOrder(s.ctx, buyOrder.PairId, buyOrder.Id)
+	s.Require().Equal(types.OrderStatusCompleted, buyOrder.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("3000denom1"), s.getBalances(s.addr(3))))
+	sellOrder, _ = s.keeper.GetOrder(s.ctx, sellOrder.PairId, sellOrder.Id)
+	s.Require().Equal(types.OrderStatusPartiallyMatched, sellOrder.Status)
+	s.Require().True(intEq(sdk.NewInt(2000), sellOrder.OpenAmount))
+}
+
+func (s *KeeperTestSuite) TestFillOrKillOrderFullyMatched() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour, true)
+	order := s.timeInForceLimitOrder(
+		s.addr(2), pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), sdk.NewInt(10000),
+		types.TimeInForceFillOrKill)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().Equal(types.OrderStatusCompleted, order.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("10000denom1"), s.getBalances(s.addr(2))))
+}
+
+func (s *KeeperTestSuite) TestPostOnlyOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour, true)
+	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.9"), sdk.NewInt(10000), time.Hour, true)
+
+	orderer := s.addr(3)
+	s.fundAddr(orderer, utils.ParseCoins("1000000denom1,1000000denom2"))
+
+	for _, tc := range []struct {
+		name        string
+		dir         types.OrderDirection
+		price       sdk.Dec
+		expectedErr string
+	}{
+		{
+			"buy crossing the lowest sell price",
+			types.OrderDirectionBuy,
+			utils.ParseDec("1.0"),
+			"price 1.000000000000000000 is not lower than the lowest sell price 1.000000000000000000: post-only order would cross the order book",
+		},
+		{
+			"buy below the lowest sell price",
+			types.OrderDirectionBuy,
+			utils.ParseDec("0.99"),
+			"",
+		},
+		{
+			"sell crossing the highest buy price",
+			types.OrderDirectionSell,
+			utils.ParseDec("0.99"),
+			"price 0.990000000000000000 is not higher than the highest buy price 0.990000000000000000: post-only order would cross the order book",
+		},
+		{
+			"sell above the highest buy price",
+			types.OrderDirectionSell,
+			utils.ParseDec("0.995"),
+			"",
+		},
+	} {
+		s.Run(tc.name, func() {
+			var offerCoin sdk.Coin
+			var demandCoinDenom string
+			switch tc.dir {
+			case types.OrderDirectionBuy:
+				offerCoin = sdk.NewCoin("denom2", amm.OfferCoinAmount(amm.Buy, tc.price, sdk.NewInt(1000)))
+				demandCoinDenom = "denom1"
+			case types.OrderDirectionSell:
+				offerCoin = utils.ParseCoin("1000denom1")
+				demandCoinDenom = "denom2"
+			}
+			msg := types.NewMsgLimitOrder(
+				orderer, pair.Id, tc.dir, offerCoin, demandCoinDenom, tc.price, sdk.NewInt(1000), time.Hour)
+			msg.PostOnly = true
+			s.Require().NoError(msg.ValidateBasic())
+			order, err := s.keeper.LimitOrder(s.ctx, msg)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				s.Require().True(order.PostOnly)
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPostOnlyOrderWithPool() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	orderer := s.addr(1)
+	s.fundAddr(orderer, utils.ParseCoins("10000denom1"))
+
+	// The pool sells and buys coins around its price, 1.0.
+	msg := types.NewMsgMMOrder(
+		orderer, pair.Id, types.OrderDirectionSell, utils.ParseCoin("1000denom1"), "denom2",
+		utils.ParseDec("0.99"), sdk.NewInt(1000), time.Hour)
+	msg.PostOnly = true
+	_, err := s.keeper.MMOrder(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrPostOnlyOrderWouldCross)
+
+	msg.Price = utils.ParseDec("1.01")
+	order, err := s.keeper.MMOrder(s.ctx, msg)
+	s.Require().NoError(err)
+
+	s.nextBlock()
+	order, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusNotMatched, order.Status)
+}
+
 func (s *KeeperTestSuite) TestSingleOrderNoMatch() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 
@@ -444,6 +821,341 @@ func (s *KeeperTestSuite) TestCancelOrder() {
 	s.Require().False(found)
 }
 
+func (s *KeeperTestSuite) TestAllocationPolicy() {
+	for _, tc := range []struct {
+		name     string
+		policy   types.AllocationPolicy
+		received []sdk.Coin // received coins of each orderer
+	}{
+		{
+			"priority",
+			types.AllocationPolicyPriority,
+			[]sdk.Coin{utils.ParseCoin("6000denom2"), utils.ParseCoin("0denom2")},
+		},
+		{
+			"pro-rata",
+			types.AllocationPolicyProRata,
+			[]sdk.Coin{utils.ParseCoin("4000denom2"), utils.ParseCoin("2000denom2")},
+		},
+	} {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.fundAddr(s.addr(0), s.keeper.GetPairCreationFee(s.ctx))
+			msg := types.NewMsgCreatePair(s.addr(0), "denom1", "denom2")
+			msg.AllocationPolicy = tc.policy
+			pair, err := s.keeper.CreatePair(s.ctx, msg)
+			s.Require().NoError(err)
+			pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+			s.Require().Equal(tc.policy, pair.AllocationPolicy)
+
+			s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), newInt(10000), time.Hour, true)
+			s.nextBlock()
+			s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), newInt(5000), time.Hour, true)
+			s.nextBlock()
+
+			s.buyLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), newInt(6000), 0, true)
+			s.nextBlock()
+
+			for i, received := range tc.received {
+				s.Require().True(coinEq(received, s.getBalance(s.addr(i+1), "denom2")))
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestBatchOrders() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.limitOrder(s.addr(2), pair.Id, types.OrderDirectionBuy, utils.ParseDec("0.9"), newInt(10000), 0, true)
+
+	msg := types.NewMsgBatchOrders(
+		s.addr(1), pair.Id, types.OrderTypeLimit, []types.BatchOrderEntry{
+			types.NewBatchOrderEntry(types.OrderDirectionBuy, utils.ParseDec("0.99"), newInt(10000)),
+			types.NewBatchOrderEntry(types.OrderDirectionBuy, utils.ParseDec("0.98"), newInt(10000)),
+			types.NewBatchOrderEntry(types.OrderDirectionSell, utils.ParseDec("1.01"), newInt(10000)),
+			types.NewBatchOrderEntry(types.OrderDirectionSell, utils.ParseDec("1.02"), newInt(10000)),
+		}, time.Hour)
+
+	// Insufficient funds
+	s.fundAddr(s.addr(1), utils.ParseCoins("20000denom1,19699denom2"))
+	_, err := s.keeper.BatchOrders(s.ctx, msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	s.fundAddr(s.addr(1), utils.ParseCoins("1denom2"))
+	escrowBalances := s.getBalances(pair.GetEscrowAddress())
+	orders, err := s.keeper.BatchOrders(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Len(orders, 4)
+	s.Require().True(s.getBalances(s.addr(1)).IsZero())
+	s.Require().True(coinsEq(
+		escrowBalances.Add(utils.ParseCoins("20000denom1,19700denom2")...),
+		s.getBalances(pair.GetEscrowAddress())))
+
+	// Orders get contiguous ids.
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	for i, order := range orders {
+		s.Require().Equal(uint64(2+i), order.Id)
+		s.Require().Equal(types.OrderTypeLimit, order.Type)
+		s.Require().Equal(msg.Orders[i].Direction, order.Direction)
+		s.Require().Equal(pair.CurrentBatchId, order.BatchId)
+		stored, found := s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+		s.Require().True(found)
+		s.Require().Equal(order, stored)
+	}
+	s.Require().Equal(uint64(5), pair.LastOrderId)
+
+	// Post-only orders which cross the order book are rejected.
+	s.fundAddr(s.addr(1), utils.ParseCoins("10000denom1"))
+	msg = types.NewMsgBatchOrders(
+		s.addr(1), pair.Id, types.OrderTypeLimit, []types.BatchOrderEntry{
+			types.NewBatchOrderEntry(types.OrderDirectionSell, utils.ParseDec("0.9"), newInt(10000)),
+		}, time.Hour)
+	msg.PostOnly = true
+	_, err = s.keeper.BatchOrders(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrPostOnlyOrderWouldCross)
+
+	// Post-only orders which cross each other are rejected as well.
+	s.fundAddr(s.addr(1), utils.ParseCoins("10000denom2"))
+	msg.Orders = []types.BatchOrderEntry{
+		types.NewBatchOrderEntry(types.OrderDirectionBuy, utils.ParseDec("0.96"), newInt(10000)),
+		types.NewBatchOrderEntry(types.OrderDirectionSell, utils.ParseDec("0.95"), newInt(10000)),
+	}
+	_, err = s.keeper.BatchOrders(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrPostOnlyOrderWouldCross)
+}
+
+func (s *KeeperTestSuite) TestBatchOrders_MMOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	maxNumMMOrders := s.keeper.GetMaxNumMarketMakingOrdersPerPair(s.ctx)
+
+	s.mmOrder(s.addr(1), pair.Id, types.OrderDirectionBuy, utils.ParseDec("0.9"), newInt(10000), time.Hour, true)
+
+	var entries []types.BatchOrderEntry
+	for i := uint32(0); i < maxNumMMOrders; i++ {
+		price := sdk.NewDecWithPrec(int64(1010+i*10), 3)
+		entries = append(entries, types.NewBatchOrderEntry(types.OrderDirectionSell, price, newInt(10000)))
+	}
+	s.fundAddr(s.addr(1), utils.ParseCoins("1000000denom1"))
+	msg := types.NewMsgBatchOrders(s.addr(1), pair.Id, types.OrderTypeMM, entries, time.Hour)
+	_, err := s.keeper.BatchOrders(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrMaxNumMMOrdersExceeded)
+
+	msg.Orders = entries[:maxNumMMOrders-1]
+	_, err = s.keeper.BatchOrders(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(maxNumMMOrders, s.keeper.GetNumMMOrders(s.ctx, s.addr(1), pair.Id))
+}
+
+func (s *KeeperTestSuite) TestBatchOrders_Gas() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	var entries []types.BatchOrderEntry
+	for i := 0; i < 10; i++ {
+		price := sdk.NewDecWithPrec(int64(900+i*10), 3)
+		entries = append(entries, types.NewBatchOrderEntry(types.OrderDirectionBuy, price, newInt(10000)))
+	}
+
+	// Place orders one by one.
+	s.fundAddr(s.addr(1), utils.ParseCoins("1000000denom2"))
+	ctx := s.ctx
+	s.ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	for _, entry := range entries {
+		s.limitOrder(s.addr(1), pair.Id, entry.Direction, entry.Price, entry.Amount, time.Hour, false)
+	}
+	singleGas := s.ctx.GasMeter().GasConsumed()
+
+	// Place orders at once.
+	s.fundAddr(s.addr(2), utils.ParseCoins("1000000denom2"))
+	s.ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err := s.keeper.BatchOrders(s.ctx, types.NewMsgBatchOrders(
+		s.addr(2), pair.Id, types.OrderTypeLimit, entries, time.Hour))
+	s.Require().NoError(err)
+	batchGas := s.ctx.GasMeter().GasConsumed()
+
+	// Place a single order in a batch.
+	s.fundAddr(s.addr(3), utils.ParseCoins("1000000denom2"))
+	s.ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = s.keeper.BatchOrders(s.ctx, types.NewMsgBatchOrders(
+		s.addr(3), pair.Id, types.OrderTypeLimit, entries[:1], time.Hour))
+	s.Require().NoError(err)
+	oneOrderBatchGas := s.ctx.GasMeter().GasConsumed()
+
+	// The order extra gas is paid per order, but the fixed overhead is paid
+	// only once.
+	s.Require().GreaterOrEqual(batchGas-oneOrderBatchGas, 9*s.keeper.GetOrderExtraGas(s.ctx))
+	s.Require().Less(batchGas, singleGas)
+}
+
+func (s *KeeperTestSuite) TestReplaceOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	order := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), newInt(10000), time.Hour, true)
+
+	// Cannot replace an order within a same batch
+	_, err := s.keeper.ReplaceOrder(s.ctx, types.NewMsgReplaceOrder(
+		s.addr(1), pair.Id, order.Id, utils.ParseDec("1.0"), newInt(5000), time.Hour))
+	s.Require().ErrorIs(err, types.ErrSameBatch)
+
+	s.nextBlock()
+
+	// Only the orderer can replace the order
+	_, err = s.keeper.ReplaceOrder(s.ctx, types.NewMsgReplaceOrder(
+		s.addr(2), pair.Id, order.Id, utils.ParseDec("1.0"), newInt(5000), time.Hour))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// Increasing the order size requires additional coins
+	_, err = s.keeper.ReplaceOrder(s.ctx, types.NewMsgReplaceOrder(
+		s.addr(1), pair.Id, order.Id, utils.ParseDec("1.1"), newInt(10000), time.Hour))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// Shrink the order; the difference is refunded
+	newOrder, err := s.keeper.ReplaceOrder(s.ctx, types.NewMsgReplaceOrder(
+		s.addr(1), pair.Id, order.Id, utils.ParseDec("1.0"), newInt(6000), time.Hour))
+	s.Require().NoError(err)
+	s.Require().True(coinsEq(utils.ParseCoins("4000denom2"), s.getBalances(s.addr(1))))
+	s.Require().True(coinEq(utils.ParseCoin("6000denom2"), newOrder.RemainingOfferCoin))
+	s.Require().Equal(order.BatchId, newOrder.BatchId)
+	s.Require().Equal(order.Direction, newOrder.Direction)
+	s.Require().Equal(order.Type, newOrder.Type)
+
+	order, _ = s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().Equal(types.OrderStatusCanceled, order.Status)
+	s.Require().True(order.RemainingOfferCoin.IsZero())
+
+	// The canceled order cannot be replaced again
+	_, err = s.keeper.ReplaceOrder(s.ctx, types.NewMsgReplaceOrder(
+		s.addr(1), pair.Id, order.Id, utils.ParseDec("1.0"), newInt(5000), time.Hour))
+	s.Require().ErrorIs(err, types.ErrAlreadyCanceled)
+
+	// Raise the price; only the difference is transferred
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	newOrder2, err := s.keeper.ReplaceOrder(s.ctx, types.NewMsgReplaceOrder(
+		s.addr(1), pair.Id, newOrder.Id, utils.ParseDec("1.5"), newInt(6000), time.Hour))
+	s.Require().NoError(err)
+	s.Require().True(coinsEq(utils.ParseCoins("1000denom2"), s.getBalances(s.addr(1))))
+	s.Require().True(coinEq(utils.ParseCoin("9000denom2"), newOrder2.RemainingOfferCoin))
+	s.Require().Equal(pair.CurrentBatchId, newOrder2.BatchId)
+	s.Require().True(coinsEq(
+		utils.ParseCoins("9000denom2"), s.getBalances(pair.GetEscrowAddress())))
+
+	s.nextBlock()
+
+	// Replaced orders are deleted
+	_, found := s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().False(found)
+	_, found = s.keeper.GetOrder(s.ctx, pair.Id, newOrder.Id)
+	s.Require().False(found)
+	_, found = s.keeper.GetOrder(s.ctx, pair.Id, newOrder2.Id)
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) TestReplaceOrder_FinishOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	order := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), newInt(10000), time.Hour, true)
+	s.nextBlock()
+	// Make the resting order an IOC order to check that the time in force
+	// is carried over to the new order.
+	order, _ = s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	order.TimeInForce = types.TimeInForceImmediateOrCancel
+	s.keeper.SetOrder(s.ctx, order)
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	newOrder, err := s.keeper.ReplaceOrder(s.ctx, types.NewMsgReplaceOrder(
+		s.addr(1), pair.Id, order.Id, utils.ParseDec("1.0"), newInt(6000), time.Hour))
+	s.Require().NoError(err)
+	s.Require().Equal(types.TimeInForceImmediateOrCancel, newOrder.TimeInForce)
+
+	// The replaced order is finished like a canceled order, without refunding
+	// its offer coin which has been moved to the new order.
+	found := false
+	for _, ev := range s.ctx.EventManager().ABCIEvents() {
+		if ev.Type != types.EventTypeOrderResult {
+			continue
+		}
+		found = true
+		for _, attr := range ev.Attributes {
+			switch string(attr.Key) {
+			case types.AttributeKeyOrderId:
+				s.Require().Equal(strconv.FormatUint(order.Id, 10), string(attr.Value))
+			case types.AttributeKeyStatus:
+				s.Require().Equal(types.OrderStatusCanceled.String(), string(attr.Value))
+			case types.AttributeKeyRemainingOfferCoin:
+				s.Require().Equal("0denom2", string(attr.Value))
+			}
+		}
+	}
+	s.Require().True(found)
+	s.Require().True(coinsEq(utils.ParseCoins("4000denom2"), s.getBalances(s.addr(1))))
+}
+
+func (s *KeeperTestSuite) TestReplaceOrder_Priority() {
+	for _, tc := range []struct {
+		name        string
+		amt         sdk.Int
+		movePrice   bool // whether to move the price away and back before the final replace
+		filledFirst bool // whether the replaced order is filled first
+	}{
+		{"shrink", newInt(6000), false, true},
+		{"same amount", newInt(10000), false, true},
+		{"grow", newInt(15000), false, false},
+		{"price changed", newInt(6000), true, false},
+	} {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+			order := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), newInt(10000), time.Hour, true)
+			s.nextBlock()
+			s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), newInt(10000), time.Hour, true)
+			s.nextBlock()
+
+			s.fundAddr(s.addr(1), utils.ParseCoins("5000denom1"))
+			if tc.movePrice {
+				var err error
+				order, err = s.keeper.ReplaceOrder(s.ctx, types.NewMsgReplaceOrder(
+					s.addr(1), pair.Id, order.Id, utils.ParseDec("1.1"), newInt(10000), time.Hour))
+				s.Require().NoError(err)
+				s.nextBlock()
+			}
+			_, err := s.keeper.ReplaceOrder(s.ctx, types.NewMsgReplaceOrder(
+				s.addr(1), pair.Id, order.Id, utils.ParseDec("1.0"), tc.amt, time.Hour))
+			s.Require().NoError(err)
+
+			// Only one of the sell orders can be filled.
+			s.buyLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), newInt(5000), 0, true)
+			s.nextBlock()
+
+			s.Require().Equal(tc.filledFirst, s.getBalance(s.addr(1), "denom2").IsPositive())
+			s.Require().Equal(!tc.filledFirst, s.getBalance(s.addr(2), "denom2").IsPositive())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestReplaceOrder_MMOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	order := s.mmOrder(s.addr(1), pair.Id, types.OrderDirectionSell, utils.ParseDec("1.0"), newInt(10000), time.Hour, true)
+	s.nextBlock()
+
+	newOrder, err := s.keeper.ReplaceOrder(s.ctx, types.NewMsgReplaceOrder(
+		s.addr(1), pair.Id, order.Id, utils.ParseDec("1.1"), newInt(8000), time.Hour))
+	s.Require().NoError(err)
+	s.Require().Equal(types.OrderTypeMM, newOrder.Type)
+	s.Require().Equal(uint32(1), s.keeper.GetNumMMOrders(s.ctx, s.addr(1), pair.Id))
+	s.Require().True(coinsEq(utils.ParseCoins("2000denom1"), s.getBalances(s.addr(1))))
+}
+
+func (s *KeeperTestSuite) TestReplaceOrder_MarketOrder() {
+	pair := s.createPairWithLastPrice("denom1", "denom2", utils.ParseDec("1.0"))
+
+	order := s.sellMarketOrder(s.addr(1), pair.Id, newInt(10000), time.Hour, true)
+	s.nextBlock()
+
+	_, err := s.keeper.ReplaceOrder(s.ctx, types.NewMsgReplaceOrder(
+		s.addr(1), pair.Id, order.Id, utils.ParseDec("1.0"), newInt(5000), time.Hour))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
 func (s *KeeperTestSuite) TestCancelAllOrders() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 
@@ -743,6 +1455,24 @@ func (s *KeeperTestSuite) TestExpireSmallOrders() {
 	liquidity.EndBlocker(s.ctx, s.keeper)
 }
 
+func (s *KeeperTestSuite) TestExpireSmallOrders_MinOrderNotional() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	minOrderNotional := sdk.NewInt(10000)
+	s.handleProposal(types.NewPairOrderRulesProposal(
+		"Change pair order rules", "Description",
+		[]types.PairOrderRulesChange{types.NewPairOrderRulesChange(pair.Id, nil, &minOrderNotional)}))
+
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(15000), time.Hour, true)
+	// This order has 5000 open amount after matching, which is smaller than
+	// the pair's minimum order notional.
+	order := s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(20000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	order, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusExpired, order.Status)
+	s.Require().True(coinEq(utils.ParseCoin("5000denom1"), s.getBalance(s.addr(2), "denom1")))
+}
+
 func (s *KeeperTestSuite) TestPoolOrderOverflow() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 	i, _ := sdk.NewIntFromString("10000000000000000000000000")
@@ -1050,3 +1780,197 @@ func (s *KeeperTestSuite) TestPoolPreserveK() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestMakerTakerFees() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	makerFeeRate, takerFeeRate := utils.ParseDec("0.001"), utils.ParseDec("0.003")
+	s.handleProposal(types.NewPairFeeRatesProposal(
+		"Change pair fee rates", "Description",
+		[]types.PairFeeRatesChange{types.NewPairFeeRatesChange(pair.Id, &makerFeeRate, &takerFeeRate)}))
+
+	feeCollector := s.keeper.GetFeeCollector(s.ctx)
+	feeCollectorBalances := s.getBalances(feeCollector)
+
+	// The sell order rests on the order book, so it becomes a maker.
+	sellOrder := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	s.nextBlock()
+	buyOrder := s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	sellOrder, _ = s.keeper.GetOrder(s.ctx, pair.Id, sellOrder.Id)
+	s.Require().Equal(types.OrderStatusCompleted, sellOrder.Status)
+	s.Require().True(coinEq(utils.ParseCoin("999000denom2"), sellOrder.ReceivedCoin))
+	s.Require().True(coinEq(utils.ParseCoin("1000denom2"), sellOrder.Fee))
+	buyOrder, _ = s.keeper.GetOrder(s.ctx, pair.Id, buyOrder.Id)
+	s.Require().Equal(types.OrderStatusCompleted, buyOrder.Status)
+	s.Require().True(coinEq(utils.ParseCoin("997000denom1"), buyOrder.ReceivedCoin))
+	s.Require().True(coinEq(utils.ParseCoin("3000denom1"), buyOrder.Fee))
+
+	s.Require().True(coinsEq(utils.ParseCoins("999000denom2"), s.getBalances(s.addr(1))))
+	s.Require().True(coinsEq(utils.ParseCoins("997000denom1"), s.getBalances(s.addr(2))))
+	s.Require().True(coinsEq(
+		utils.ParseCoins("3000denom1,1000denom2"), s.getBalances(feeCollector).Sub(feeCollectorBalances)))
+}
+
+// matchWithAllOrders matches the order book built from all the orders of
+// the pair, without looking up the price index, and returns the matched
+// amount of each order by its id.
+// It doesn't apply the match result.
+func matchWithAllOrders(ctx sdk.Context, k keeper.Keeper, pair types.Pair) (matchPrice sdk.Dec, matchedAmts map[uint64]sdk.Int, matched bool) {
+	ob := amm.NewOrderBook()
+	ob.SetAllocationPolicy(pair.AllocationPolicy.AMMAllocationPolicy())
+	_ = k.IterateOrdersByPair(ctx, pair.Id, func(order types.Order) (stop bool, err error) {
+		if !order.Status.IsMatchable() || order.Type == types.OrderTypeTrigger {
+			return false, nil
+		}
+		if order.Status != types.OrderStatusNotExecuted && order.ExpiredAt(ctx.BlockTime()) {
+			return false, nil
+		}
+		ob.AddOrder(types.NewUserOrder(order))
+		return false, nil
+	})
+	var pools []*types.PoolOrderer
+	_ = k.IteratePoolsByPair(ctx, pair.Id, func(pool types.Pool) (stop bool, err error) {
+		rx, ry := k.GetPoolBalances(ctx, pool)
+		ps := k.GetPoolCoinSupply(ctx, pool)
+		pools = append(pools, types.NewPoolOrderer(
+			pool.AMMPool(rx.Amount, ry.Amount, ps),
+			pool.Id, pool.GetReserveAddress(), pair.BaseCoinDenom, pair.QuoteCoinDenom))
+		return false, nil
+	})
+	matchPrice, _, matched = k.Match(ctx, pair, ob, pools, pair.LastPrice)
+	matchedAmts = map[uint64]sdk.Int{}
+	for _, order := range ob.Orders() {
+		if order, ok := order.(*types.UserOrder); ok && order.IsMatched() {
+			matchedAmts[order.OrderId] = order.Amount.Sub(order.OpenAmount)
+		}
+	}
+	return
+}
+
+func (s *KeeperTestSuite) TestMatchingWithPriceIndex() {
+	r := rand.New(rand.NewSource(0))
+
+	s.ctx = s.ctx.WithBlockTime(utils.ParseTime("2022-03-01T12:00:00Z"))
+	for i := 0; i < 30; i++ {
+		pair := s.createPair(s.addr(0), fmt.Sprintf("base%d", i), fmt.Sprintf("quote%d", i), true)
+		if r.Intn(2) == 0 {
+			s.createPool(s.addr(0), pair.Id, sdk.NewCoins(
+				sdk.NewInt64Coin(pair.BaseCoinDenom, 1000_000000),
+				sdk.NewInt64Coin(pair.QuoteCoinDenom, 1000_000000)), true)
+		}
+		tickPrec := s.keeper.GetPairTickPrecision(s.ctx, pair)
+
+		// Orders are set directly to the store, so that resting orders
+		// beyond the price limits can cross each other.
+		// Resting orders are placed around a random price, while new orders
+		// are placed within the price limits.
+		restingPrice := utils.RandomDec(r, utils.ParseDec("0.5"), utils.ParseDec("2.0"))
+		orders := map[uint64]types.Order{}
+		for j := 0; j < 50; j++ {
+			resting := r.Intn(2) == 0
+			var price sdk.Dec
+			if resting {
+				price = utils.RandomDec(r, restingPrice.Mul(utils.ParseDec("0.9")), restingPrice.Mul(utils.ParseDec("1.1")))
+			} else {
+				price = utils.RandomDec(r, utils.ParseDec("0.9"), utils.ParseDec("1.1"))
+			}
+			price = amm.PriceToDownTick(price, tickPrec)
+			amt := utils.RandomInt(r, sdk.NewInt(1000000), sdk.NewInt(10000000))
+			var offerCoin sdk.Coin
+			if r.Intn(2) == 0 {
+				offerCoin = sdk.NewCoin(pair.QuoteCoinDenom, amm.OfferCoinAmount(amm.Buy, price, amt))
+			} else {
+				offerCoin = sdk.NewCoin(pair.BaseCoinDenom, amt)
+			}
+			expireAt := s.ctx.BlockTime().Add(time.Hour)
+			if r.Intn(5) == 0 {
+				expireAt = s.ctx.BlockTime()
+			}
+			pair.LastOrderId++
+			order := types.NewOrder(
+				types.OrderTypeLimit, pair.LastOrderId, pair, s.addr(1+j%5), offerCoin, price, amt, expireAt, 0)
+			if resting {
+				order.SetStatus(types.OrderStatusNotMatched)
+			}
+			s.keeper.SetOrder(s.ctx, order)
+			s.keeper.SetOrderIndex(s.ctx, order)
+			s.fundAddr(pair.GetEscrowAddress(), sdk.NewCoins(offerCoin))
+			orders[order.Id] = order
+		}
+		lastPrice := utils.ParseDec("1.0")
+		pair.LastPrice = &lastPrice
+		s.keeper.SetPair(s.ctx, pair)
+
+		cacheCtx, _ := s.ctx.CacheContext()
+		matchPrice, matchedAmts, matched := matchWithAllOrders(cacheCtx, s.keeper, pair)
+
+		s.Require().NoError(s.keeper.ExecuteMatching(s.ctx, pair))
+
+		pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+		if matched {
+			s.Require().True(decEq(matchPrice, *pair.LastPrice))
+		} else {
+			s.Require().True(decEq(lastPrice, *pair.LastPrice))
+		}
+		for _, order := range orders {
+			order2, _ := s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+			matchedAmt, ok := matchedAmts[order.Id]
+			if !ok {
+				matchedAmt = sdk.ZeroInt()
+			}
+			s.Require().True(intEq(matchedAmt, order.OpenAmount.Sub(order2.OpenAmount)))
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestTypedEvents() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	order1 := s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour, true)
+	order2 := s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(10000), time.Hour, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	var limitOrderEvts []*types.EventLimitOrder
+	var matchedEvts []*types.EventUserOrderMatched
+	var resultEvts []*types.EventOrderResult
+	numLegacyMatchedEvts := 0
+	for _, evt := range s.ctx.EventManager().Events() {
+		if evt.Type == types.EventTypeUserOrderMatched {
+			numLegacyMatchedEvts++
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(evt))
+		if err != nil { // legacy event
+			continue
+		}
+		switch msg := msg.(type) {
+		case *types.EventLimitOrder:
+			limitOrderEvts = append(limitOrderEvts, msg)
+		case *types.EventUserOrderMatched:
+			matchedEvts = append(matchedEvts, msg)
+		case *types.EventOrderResult:
+			resultEvts = append(resultEvts, msg)
+		}
+	}
+
+	s.Require().Len(limitOrderEvts, 2)
+	s.Require().Equal(order1.Id, limitOrderEvts[0].OrderId)
+	s.Require().Equal(types.OrderDirectionBuy, limitOrderEvts[0].Direction)
+	s.Require().Equal(order2.Id, limitOrderEvts[1].OrderId)
+	s.Require().Equal(types.OrderDirectionSell, limitOrderEvts[1].Direction)
+	s.Require().True(decEq(utils.ParseDec("1.0"), limitOrderEvts[1].Price))
+
+	// The typed events are emitted next to the legacy events.
+	s.Require().Len(matchedEvts, 2)
+	s.Require().Equal(numLegacyMatchedEvts, len(matchedEvts))
+	for _, evt := range matchedEvts {
+		s.Require().True(intEq(sdk.NewInt(10000), evt.MatchedAmount))
+	}
+	s.Require().Len(resultEvts, 2)
+	for _, evt := range resultEvts {
+		s.Require().Equal(types.OrderStatusCompleted, evt.Status)
+		s.Require().True(evt.OpenAmount.IsZero())
+	}
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+// UpdateTWAPAccumulator accumulates the pair's previous last price into the
+// pair's twap accumulator and sets the pair's current last price to it.
+// A snapshot of the accumulator is taken if types.TWAPSnapshotInterval has
+// passed since the last snapshot.
+func (k Keeper) UpdateTWAPAccumulator(ctx sdk.Context, pair types.Pair) {
+	if pair.LastPrice == nil { // No price history yet.
+		return
+	}
+	k.updateTWAPAccumulator(ctx, pair.Id, *pair.LastPrice)
+}
+
+// updateTWAPAccumulator accumulates the previous price into the pair's twap
+// accumulator and sets the given price to it.
+// It is also used to record the price of swaps made after the batch, which
+// don't change the pair's last price.
+func (k Keeper) updateTWAPAccumulator(ctx sdk.Context, pairId uint64, price sdk.Dec) {
+	now := ctx.BlockTime()
+	acc, found := k.GetTWAPAccumulator(ctx, pairId)
+	if found {
+		acc.Update(price, now)
+	} else {
+		acc = types.NewTWAPAccumulator(pairId, price, now)
+	}
+
+	takeSnapshot := true
+	if lastSnapshot, found := k.GetTWAPSnapshot(ctx, pairId, acc.LastSnapshotSeq); found {
+		takeSnapshot = !now.Before(lastSnapshot.Time.Add(types.TWAPSnapshotInterval))
+	}
+	if takeSnapshot {
+		acc.LastSnapshotSeq++
+		k.SetTWAPSnapshot(ctx, acc.Snapshot(acc.LastSnapshotSeq, now))
+		k.pruneTWAPSnapshots(ctx, acc)
+	}
+	k.SetTWAPAccumulator(ctx, acc)
+}
+
+// pruneTWAPSnapshots deletes the oldest snapshots of the pair so that
+// only the latest MaxNumTWAPSnapshots snapshots are kept.
+func (k Keeper) pruneTWAPSnapshots(ctx sdk.Context, acc types.TWAPAccumulator) {
+	maxNumSnapshots := uint64(k.GetMaxNumTWAPSnapshots(ctx))
+	var outdated []types.TWAPSnapshot
+	_ = k.IterateTWAPSnapshotsByPair(ctx, acc.PairId, false, func(snapshot types.TWAPSnapshot) (stop bool, err error) {
+		if snapshot.Seq+maxNumSnapshots > acc.LastSnapshotSeq {
+			return true, nil
+		}
+		outdated = append(outdated, snapshot)
+		return false, nil
+	})
+	for _, snapshot := range outdated {
+		k.DeleteTWAPSnapshot(ctx, snapshot)
+	}
+}
+
+// TWAP returns the time-weighted average price of the pair over the window
+// ending at the current block time.
+// The average is calculated from the latest snapshot taken at or before the
+// window's start, so the actual window can be longer than the given window
+// by up to types.TWAPSnapshotInterval, or more if the pair had no batch for
+// a while.
+// It returns types.ErrInsufficientTWAPHistory if there is no such snapshot.
+func (k Keeper) TWAP(ctx sdk.Context, pairId uint64, window time.Duration) (sdk.Dec, error) {
+	if window <= 0 {
+		return sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "window must be positive: %s", window)
+	}
+
+	acc, found := k.GetTWAPAccumulator(ctx, pairId)
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInsufficientTWAPHistory, "pair %d has no price history", pairId)
+	}
+
+	now := ctx.BlockTime()
+	start := now.Add(-window)
+	var base types.TWAPSnapshot
+	found = false
+	_ = k.IterateTWAPSnapshotsByPair(ctx, pairId, true, func(snapshot types.TWAPSnapshot) (stop bool, err error) {
+		if !snapshot.Time.After(start) {
+			base, found = snapshot, true
+			return true, nil
+		}
+		return false, nil
+	})
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrapf(
+			types.ErrInsufficientTWAPHistory, "pair %d has no snapshot at or before %s", pairId, start)
+	}
+
+	return base.TWAPBetween(acc.CumulativePriceAt(now), now), nil
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/liquidity"
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestTWAP() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	// The pair has no price history yet.
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	_, found := s.keeper.GetTWAPAccumulator(s.ctx, pair.Id)
+	s.Require().False(found)
+
+	setLastPrice := func(price sdk.Dec) {
+		pair, _ := s.keeper.GetPair(s.ctx, pair.Id)
+		pair.LastPrice = &price
+		s.keeper.SetPair(s.ctx, pair)
+	}
+	twap := func(window time.Duration) sdk.Dec {
+		resp, err := s.querier.TWAP(sdk.WrapSDKContext(s.ctx), &types.QueryTWAPRequest{
+			PairId: pair.Id,
+			Window: window,
+		})
+		s.Require().NoError(err)
+		return resp.TWAP
+	}
+
+	t0 := s.ctx.BlockTime()
+	setLastPrice(utils.ParseDec("1.0"))
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	s.ctx = s.ctx.WithBlockTime(t0.Add(10 * time.Minute))
+	setLastPrice(utils.ParseDec("2.0"))
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	s.ctx = s.ctx.WithBlockTime(t0.Add(20 * time.Minute))
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	s.Require().Len(s.keeper.GetAllTWAPSnapshots(s.ctx), 3)
+
+	s.Require().Equal(utils.ParseDec("1.5"), twap(20*time.Minute))
+	s.Require().Equal(utils.ParseDec("2.0"), twap(10*time.Minute))
+	// The snapshot taken at or before the window's start is used.
+	s.Require().Equal(utils.ParseDec("2.0"), twap(5*time.Minute))
+
+	// The last price is assumed to last until now.
+	s.ctx = s.ctx.WithBlockTime(t0.Add(25 * time.Minute))
+	s.Require().Equal(utils.ParseDec("1.6"), twap(25*time.Minute))
+	s.Require().Equal(utils.ParseDec("2.0"), twap(15*time.Minute))
+
+	_, err := s.keeper.TWAP(s.ctx, pair.Id, 30*time.Minute)
+	s.Require().ErrorIs(err, types.ErrInsufficientTWAPHistory)
+	_, err = s.querier.TWAP(sdk.WrapSDKContext(s.ctx), &types.QueryTWAPRequest{PairId: pair.Id})
+	s.Require().EqualError(err, "rpc error: code = InvalidArgument desc = window must be positive")
+	_, err = s.querier.TWAP(sdk.WrapSDKContext(s.ctx), &types.QueryTWAPRequest{PairId: 2, Window: time.Minute})
+	s.Require().EqualError(err, "rpc error: code = NotFound desc = pair 2 doesn't exist")
+}
+
+func (s *KeeperTestSuite) TestTWAPSnapshots() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.keeper.SetMaxNumTWAPSnapshots(s.ctx, 3)
+
+	price := utils.ParseDec("1.0")
+	pair.LastPrice = &price
+	s.keeper.SetPair(s.ctx, pair)
+
+	t0 := s.ctx.BlockTime()
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// A snapshot is not taken within the interval.
+	s.ctx = s.ctx.WithBlockTime(t0.Add(types.TWAPSnapshotInterval - time.Second))
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	s.Require().Len(s.keeper.GetAllTWAPSnapshots(s.ctx), 1)
+
+	for i := 1; i <= 4; i++ {
+		s.ctx = s.ctx.WithBlockTime(t0.Add(time.Duration(i) * types.TWAPSnapshotInterval))
+		liquidity.EndBlocker(s.ctx, s.keeper)
+	}
+
+	// Only the latest snapshots are kept.
+	snapshots := s.keeper.GetAllTWAPSnapshots(s.ctx)
+	s.Require().Len(snapshots, 3)
+	s.Require().EqualValues(3, snapshots[0].Seq)
+	s.Require().EqualValues(5, snapshots[2].Seq)
+	acc, _ := s.keeper.GetTWAPAccumulator(s.ctx, pair.Id)
+	s.Require().EqualValues(5, acc.LastSnapshotSeq)
+	s.Require().Equal(utils.ParseDec("240"), acc.CumulativePrice)
+
+	twap, err := s.keeper.TWAP(s.ctx, pair.Id, 2*types.TWAPSnapshotInterval)
+	s.Require().NoError(err)
+	s.Require().Equal(price, twap)
+	_, err = s.keeper.TWAP(s.ctx, pair.Id, 3*types.TWAPSnapshotInterval)
+	s.Require().ErrorIs(err, types.ErrInsufficientTWAPHistory)
+}
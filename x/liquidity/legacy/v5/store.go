@@ -0,0 +1,40 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+// MigrateOrders sets the fee of the orders, which is newly introduced,
+// to zero coin in each order's demand coin denom.
+func MigrateOrders(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iter := sdk.KVStorePrefixIterator(store, types.OrderKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var order types.Order
+		if err := cdc.Unmarshal(iter.Value(), &order); err != nil {
+			return err
+		}
+
+		order.Fee = sdk.NewCoin(order.ReceivedCoin.Denom, sdk.ZeroInt())
+
+		bz, err := cdc.Marshal(&order)
+		if err != nil {
+			return err
+		}
+		store.Set(iter.Key(), bz)
+	}
+
+	return nil
+}
+
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	if err := MigrateOrders(store, cdc); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	chain "github.com/crescent-network/crescent/v5/app"
+	utils "github.com/crescent-network/crescent/v5/types"
+	v5liquidity "github.com/crescent-network/crescent/v5/x/liquidity/legacy/v5"
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+func TestMigrateOrders(t *testing.T) {
+	cdc := chain.MakeTestEncodingConfig().Marshaler
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	order := types.Order{
+		Type:               types.OrderTypeLimit,
+		Id:                 1,
+		PairId:             1,
+		MsgHeight:          10000,
+		Orderer:            utils.TestAddress(0).String(),
+		Direction:          types.OrderDirectionSell,
+		OfferCoin:          utils.ParseCoin("1000000denom1"),
+		RemainingOfferCoin: utils.ParseCoin("500000denom1"),
+		ReceivedCoin:       utils.ParseCoin("250000denom2"),
+		Price:              utils.ParseDec("2"),
+		Amount:             sdk.NewInt(500000),
+		OpenAmount:         sdk.NewInt(250000),
+		BatchId:            1000,
+		ExpireAt:           utils.ParseTime("2022-01-01T12:00:00Z"),
+		Status:             types.OrderStatusPartiallyMatched,
+	}
+	key := types.GetOrderKey(order.PairId, order.Id)
+	store.Set(key, cdc.MustMarshal(&order))
+
+	require.NoError(t, v5liquidity.MigrateStore(ctx, storeKey, cdc))
+
+	order.Fee = utils.ParseCoin("0denom2")
+	require.Equal(t, cdc.MustMarshal(&order), store.Get(key))
+}
@@ -0,0 +1,88 @@
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+// MigrateOrders sets the expiration time index, the status index and the
+// price index of the orders, which are newly introduced.
+// Only orders which can be added to the order book are indexed by price.
+func MigrateOrders(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iter := sdk.KVStorePrefixIterator(store, types.OrderKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var order types.Order
+		if err := cdc.Unmarshal(iter.Value(), &order); err != nil {
+			return err
+		}
+
+		store.Set(types.GetOrderByExpireAtIndexKey(order.ExpireAt, order.PairId, order.Id), []byte{})
+		store.Set(types.GetOrderByStatusIndexKey(order.Status, order.PairId, order.Id), []byte{})
+		if order.Type != types.OrderTypeTrigger && order.Status.IsMatchable() {
+			store.Set(types.GetOrderByPriceIndexKey(order.PairId, order.Direction, order.Price, order.Id), []byte{})
+		}
+	}
+
+	return nil
+}
+
+// MigrateRequests sets the status index of the deposit, single-sided
+// deposit, withdraw and swap requests, which is newly introduced.
+func MigrateRequests(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	depositIter := sdk.KVStorePrefixIterator(store, types.DepositRequestKeyPrefix)
+	defer depositIter.Close()
+	for ; depositIter.Valid(); depositIter.Next() {
+		var req types.DepositRequest
+		if err := cdc.Unmarshal(depositIter.Value(), &req); err != nil {
+			return err
+		}
+		store.Set(types.GetDepositRequestByStatusIndexKey(req.Status, req.PoolId, req.Id), []byte{})
+	}
+
+	singleSidedDepositIter := sdk.KVStorePrefixIterator(store, types.SingleSidedDepositRequestKeyPrefix)
+	defer singleSidedDepositIter.Close()
+	for ; singleSidedDepositIter.Valid(); singleSidedDepositIter.Next() {
+		var req types.SingleSidedDepositRequest
+		if err := cdc.Unmarshal(singleSidedDepositIter.Value(), &req); err != nil {
+			return err
+		}
+		store.Set(types.GetSingleSidedDepositRequestByStatusIndexKey(req.Status, req.PoolId, req.Id), []byte{})
+	}
+
+	withdrawIter := sdk.KVStorePrefixIterator(store, types.WithdrawRequestKeyPrefix)
+	defer withdrawIter.Close()
+	for ; withdrawIter.Valid(); withdrawIter.Next() {
+		var req types.WithdrawRequest
+		if err := cdc.Unmarshal(withdrawIter.Value(), &req); err != nil {
+			return err
+		}
+		store.Set(types.GetWithdrawRequestByStatusIndexKey(req.Status, req.PoolId, req.Id), []byte{})
+	}
+
+	swapIter := sdk.KVStorePrefixIterator(store, types.SwapRequestKeyPrefix)
+	defer swapIter.Close()
+	for ; swapIter.Valid(); swapIter.Next() {
+		var req types.SwapRequest
+		if err := cdc.Unmarshal(swapIter.Value(), &req); err != nil {
+			return err
+		}
+		store.Set(types.GetSwapRequestByStatusIndexKey(req.Status, req.Id), []byte{})
+	}
+
+	return nil
+}
+
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	if err := MigrateOrders(store, cdc); err != nil {
+		return err
+	}
+	if err := MigrateRequests(store, cdc); err != nil {
+		return err
+	}
+	return nil
+}
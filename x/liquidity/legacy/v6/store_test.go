@@ -0,0 +1,104 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	chain "github.com/crescent-network/crescent/v5/app"
+	utils "github.com/crescent-network/crescent/v5/types"
+	v6liquidity "github.com/crescent-network/crescent/v5/x/liquidity/legacy/v6"
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+func TestMigrateOrders(t *testing.T) {
+	cdc := chain.MakeTestEncodingConfig().Marshaler
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	order := types.Order{
+		Type:               types.OrderTypeLimit,
+		Id:                 1,
+		PairId:             1,
+		MsgHeight:          10000,
+		Orderer:            utils.TestAddress(0).String(),
+		Direction:          types.OrderDirectionSell,
+		OfferCoin:          utils.ParseCoin("1000000denom1"),
+		RemainingOfferCoin: utils.ParseCoin("500000denom1"),
+		ReceivedCoin:       utils.ParseCoin("250000denom2"),
+		Price:              utils.ParseDec("2"),
+		Amount:             sdk.NewInt(500000),
+		OpenAmount:         sdk.NewInt(250000),
+		BatchId:            1000,
+		ExpireAt:           utils.ParseTime("2022-01-01T12:00:00Z"),
+		Status:             types.OrderStatusPartiallyMatched,
+		Fee:                utils.ParseCoin("0denom2"),
+	}
+	key := types.GetOrderKey(order.PairId, order.Id)
+	bz := cdc.MustMarshal(&order)
+	store.Set(key, bz)
+
+	require.NoError(t, v6liquidity.MigrateStore(ctx, storeKey, cdc))
+
+	require.Equal(t, bz, store.Get(key))
+	require.True(t, store.Has(types.GetOrderByExpireAtIndexKey(order.ExpireAt, order.PairId, order.Id)))
+	require.True(t, store.Has(types.GetOrderByStatusIndexKey(order.Status, order.PairId, order.Id)))
+	require.True(t, store.Has(types.GetOrderByPriceIndexKey(order.PairId, order.Direction, order.Price, order.Id)))
+
+	// Finished orders are not indexed by price.
+	order.Id = 2
+	order.Status = types.OrderStatusCompleted
+	store.Set(types.GetOrderKey(order.PairId, order.Id), cdc.MustMarshal(&order))
+
+	require.NoError(t, v6liquidity.MigrateStore(ctx, storeKey, cdc))
+
+	require.True(t, store.Has(types.GetOrderByStatusIndexKey(order.Status, order.PairId, order.Id)))
+	require.False(t, store.Has(types.GetOrderByPriceIndexKey(order.PairId, order.Direction, order.Price, order.Id)))
+}
+
+func TestMigrateRequests(t *testing.T) {
+	cdc := chain.MakeTestEncodingConfig().Marshaler
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	depositReq := types.DepositRequest{
+		Id:        1,
+		PoolId:    1,
+		Depositor: utils.TestAddress(0).String(),
+		Status:    types.RequestStatusSucceeded,
+	}
+	store.Set(types.GetDepositRequestKey(depositReq.PoolId, depositReq.Id), cdc.MustMarshal(&depositReq))
+	singleSidedDepositReq := types.SingleSidedDepositRequest{
+		Id:        2,
+		PoolId:    1,
+		Depositor: utils.TestAddress(0).String(),
+		Status:    types.RequestStatusNotExecuted,
+	}
+	store.Set(
+		types.GetSingleSidedDepositRequestKey(singleSidedDepositReq.PoolId, singleSidedDepositReq.Id),
+		cdc.MustMarshal(&singleSidedDepositReq))
+	withdrawReq := types.WithdrawRequest{
+		Id:         3,
+		PoolId:     1,
+		Withdrawer: utils.TestAddress(0).String(),
+		Status:     types.RequestStatusFailed,
+	}
+	store.Set(types.GetWithdrawRequestKey(withdrawReq.PoolId, withdrawReq.Id), cdc.MustMarshal(&withdrawReq))
+	swapReq := types.SwapRequest{
+		Id:      4,
+		Orderer: utils.TestAddress(0).String(),
+		Status:  types.RequestStatusSucceeded,
+	}
+	store.Set(types.GetSwapRequestKey(swapReq.Id), cdc.MustMarshal(&swapReq))
+
+	require.NoError(t, v6liquidity.MigrateStore(ctx, storeKey, cdc))
+
+	require.True(t, store.Has(types.GetDepositRequestByStatusIndexKey(types.RequestStatusSucceeded, 1, 1)))
+	require.True(t, store.Has(types.GetSingleSidedDepositRequestByStatusIndexKey(types.RequestStatusNotExecuted, 1, 2)))
+	require.True(t, store.Has(types.GetWithdrawRequestByStatusIndexKey(types.RequestStatusFailed, 1, 3)))
+	require.True(t, store.Has(types.GetSwapRequestByStatusIndexKey(types.RequestStatusSucceeded, 4)))
+}
@@ -290,7 +290,7 @@ func SimulateMsgCreateRangedPool(ak types.AccountKeeper, bk types.BankKeeper, k
 
 		poolCreationFee := k.GetPoolCreationFee(ctx)
 		minDepositAmt := k.GetMinInitialDepositAmount(ctx)
-		tickPrec := amm.TickPrecision(k.GetTickPrecision(ctx))
+		tickPrec := amm.TickPrecision(k.GetPairTickPrecision(ctx, pair))
 		var (
 			x, y                             sdk.Int
 			minPrice, maxPrice, initialPrice sdk.Dec
@@ -493,7 +493,7 @@ func SimulateMsgLimitOrder(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 		if pair.LastPrice != nil {
 			minPrice, maxPrice = minMaxPrice(k, ctx, *pair.LastPrice)
 		} else {
-			if pool != (types.Pool{}) {
+			if pool.Id != 0 {
 				rx, ry := k.GetPoolBalances(ctx, pool)
 				ammPool := pool.AMMPool(rx.Amount, ry.Amount, sdk.Int{})
 				minPrice, maxPrice = minMaxPrice(k, ctx, ammPool.Price())
@@ -501,7 +501,7 @@ func SimulateMsgLimitOrder(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 				minPrice, maxPrice = utils.ParseDec("0.5"), utils.ParseDec("5.0")
 			}
 		}
-		price := amm.PriceToDownTick(utils.RandomDec(r, minPrice, maxPrice), int(params.TickPrecision))
+		price := amm.PriceToDownTick(utils.RandomDec(r, minPrice, maxPrice), k.GetPairTickPrecision(ctx, pair))
 
 		minAmt := sdk.MaxInt(
 			amm.MinCoinAmount,
@@ -579,7 +579,7 @@ func SimulateMsgMarketOrder(ak types.AccountKeeper, bk types.BankKeeper, k keepe
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMarketOrder, "no account to make a market order"), nil, nil
 		}
 
-		minPrice, maxPrice := types.PriceLimits(*pair.LastPrice, k.GetMaxPriceLimitRatio(ctx), int(k.GetTickPrecision(ctx)))
+		minPrice, maxPrice := types.PriceLimits(*pair.LastPrice, k.GetMaxPriceLimitRatio(ctx), k.GetPairTickPrecision(ctx, pair))
 
 		minAmt := sdk.MaxInt(
 			amm.MinCoinAmount,
@@ -662,7 +662,7 @@ func SimulateMsgMMOrder(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Ke
 		if pair.LastPrice != nil {
 			minPrice, maxPrice = minMaxPrice(k, ctx, *pair.LastPrice)
 		} else {
-			if pool != (types.Pool{}) {
+			if pool.Id != 0 {
 				rx, ry := k.GetPoolBalances(ctx, pool)
 				ammPool := pool.AMMPool(rx.Amount, ry.Amount, sdk.Int{})
 				minPrice, maxPrice = minMaxPrice(k, ctx, ammPool.Price())
@@ -670,7 +670,7 @@ func SimulateMsgMMOrder(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Ke
 				minPrice, maxPrice = utils.ParseDec("0.5"), utils.ParseDec("5.0")
 			}
 		}
-		price := amm.PriceToDownTick(utils.RandomDec(r, minPrice, maxPrice), int(params.TickPrecision))
+		price := amm.PriceToDownTick(utils.RandomDec(r, minPrice, maxPrice), k.GetPairTickPrecision(ctx, pair))
 
 		minAmt := sdk.MaxInt(
 			amm.MinCoinAmount,
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewCandle returns a new Candle opened with the match price and the matched
+// amounts.
+func NewCandle(pairId uint64, interval time.Duration, openTime time.Time, price sdk.Dec, baseVolume, quoteVolume sdk.Int) Candle {
+	return Candle{
+		PairId:      pairId,
+		Interval:    interval,
+		OpenTime:    openTime,
+		Open:        price,
+		High:        price,
+		Low:         price,
+		Close:       price,
+		BaseVolume:  baseVolume,
+		QuoteVolume: quoteVolume,
+	}
+}
+
+// CandleOpenTime returns the open time of the candle with the interval
+// which contains t.
+func CandleOpenTime(t time.Time, interval time.Duration) time.Time {
+	return t.Truncate(interval)
+}
+
+// Update updates the candle with the match price and the matched amounts.
+func (candle *Candle) Update(price sdk.Dec, baseVolume, quoteVolume sdk.Int) {
+	candle.High = sdk.MaxDec(candle.High, price)
+	candle.Low = sdk.MinDec(candle.Low, price)
+	candle.Close = price
+	candle.BaseVolume = candle.BaseVolume.Add(baseVolume)
+	candle.QuoteVolume = candle.QuoteVolume.Add(quoteVolume)
+}
+
+// Validate validates Candle for genesis.
+func (candle Candle) Validate() error {
+	if candle.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if candle.Interval <= 0 {
+		return fmt.Errorf("interval must be positive: %s", candle.Interval)
+	}
+	if !CandleOpenTime(candle.OpenTime, candle.Interval).Equal(candle.OpenTime) {
+		return fmt.Errorf("open time must be a multiple of the interval: %s", candle.OpenTime)
+	}
+	for _, price := range []sdk.Dec{candle.Open, candle.High, candle.Low, candle.Close} {
+		if price.IsNil() || !price.IsPositive() {
+			return fmt.Errorf("price must be positive: %s", price)
+		}
+	}
+	if candle.High.LT(sdk.MaxDec(candle.Open, candle.Close)) || candle.Low.GT(sdk.MinDec(candle.Open, candle.Close)) {
+		return fmt.Errorf("open and close must be between low and high")
+	}
+	if candle.BaseVolume.IsNegative() {
+		return fmt.Errorf("base volume must not be negative: %s", candle.BaseVolume)
+	}
+	if candle.QuoteVolume.IsNegative() {
+		return fmt.Errorf("quote volume must not be negative: %s", candle.QuoteVolume)
+	}
+	return nil
+}
+
+// MustMarshalCandle returns the candle bytes.
+// It throws panic if it fails.
+func MustMarshalCandle(cdc codec.BinaryCodec, candle Candle) []byte {
+	return cdc.MustMarshal(&candle)
+}
+
+// MustUnmarshalCandle return the unmarshalled candle from bytes.
+// It throws panic if it fails.
+func MustUnmarshalCandle(cdc codec.BinaryCodec, value []byte) Candle {
+	candle, err := UnmarshalCandle(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return candle
+}
+
+// UnmarshalCandle returns the candle from bytes.
+func UnmarshalCandle(cdc codec.BinaryCodec, value []byte) (candle Candle, err error) {
+	err = cdc.Unmarshal(value, &candle)
+	return candle, err
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/liquidity interfaces and concrete types
@@ -14,13 +15,29 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreatePair{}, "liquidity/MsgCreatePair", nil)
 	cdc.RegisterConcrete(&MsgCreatePool{}, "liquidity/MsgCreatePool", nil)
 	cdc.RegisterConcrete(&MsgCreateRangedPool{}, "liquidity/MsgCreateRangedPool", nil)
+	cdc.RegisterConcrete(&MsgCreateStablePool{}, "liquidity/MsgCreateStablePool", nil)
+	cdc.RegisterConcrete(&MsgCreateWeightedPool{}, "liquidity/MsgCreateWeightedPool", nil)
+	cdc.RegisterConcrete(&MsgCreateConcentratedPool{}, "liquidity/MsgCreateConcentratedPool", nil)
+	cdc.RegisterConcrete(&MsgOpenPosition{}, "liquidity/MsgOpenPosition", nil)
+	cdc.RegisterConcrete(&MsgClosePosition{}, "liquidity/MsgClosePosition", nil)
+	cdc.RegisterConcrete(&MsgCollectFees{}, "liquidity/MsgCollectFees", nil)
 	cdc.RegisterConcrete(&MsgDeposit{}, "liquidity/MsgDeposit", nil)
+	cdc.RegisterConcrete(&MsgDepositSingleSided{}, "liquidity/MsgDepositSingleSided", nil)
 	cdc.RegisterConcrete(&MsgWithdraw{}, "liquidity/MsgWithdraw", nil)
+	cdc.RegisterConcrete(&MsgWithdrawSingleSided{}, "liquidity/MsgWithdrawSingleSided", nil)
 	cdc.RegisterConcrete(&MsgLimitOrder{}, "liquidity/MsgLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgMarketOrder{}, "liquidity/MsgMarketOrder", nil)
 	cdc.RegisterConcrete(&MsgMMOrder{}, "liquidity/MsgMMOrder", nil)
+	cdc.RegisterConcrete(&MsgBatchOrders{}, "liquidity/MsgBatchOrders", nil)
+	cdc.RegisterConcrete(&MsgTriggerOrder{}, "liquidity/MsgTriggerOrder", nil)
+	cdc.RegisterConcrete(&MsgSwapExactIn{}, "liquidity/MsgSwapExactIn", nil)
 	cdc.RegisterConcrete(&MsgCancelOrder{}, "liquidity/MsgCancelOrder", nil)
+	cdc.RegisterConcrete(&MsgReplaceOrder{}, "liquidity/MsgReplaceOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelAllOrders{}, "liquidity/MsgCancelAllOrders", nil)
+	cdc.RegisterConcrete(&PairFeeRatesProposal{}, "liquidity/PairFeeRatesProposal", nil)
+	cdc.RegisterConcrete(&StablePoolAmplificationProposal{}, "liquidity/StablePoolAmplificationProposal", nil)
+	cdc.RegisterConcrete(&PoolManagementProposal{}, "liquidity/PoolManagementProposal", nil)
+	cdc.RegisterConcrete(&PairOrderRulesProposal{}, "liquidity/PairOrderRulesProposal", nil)
 }
 
 // RegisterInterfaces registers the x/liquidity interfaces types with the
@@ -31,14 +48,33 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreatePair{},
 		&MsgCreatePool{},
 		&MsgCreateRangedPool{},
+		&MsgCreateStablePool{},
+		&MsgCreateWeightedPool{},
+		&MsgCreateConcentratedPool{},
+		&MsgOpenPosition{},
+		&MsgClosePosition{},
+		&MsgCollectFees{},
 		&MsgDeposit{},
+		&MsgDepositSingleSided{},
 		&MsgWithdraw{},
+		&MsgWithdrawSingleSided{},
 		&MsgLimitOrder{},
 		&MsgMarketOrder{},
 		&MsgMMOrder{},
+		&MsgBatchOrders{},
+		&MsgTriggerOrder{},
+		&MsgSwapExactIn{},
 		&MsgCancelOrder{},
+		&MsgReplaceOrder{},
 		&MsgCancelAllOrders{},
 	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&PairFeeRatesProposal{},
+		&StablePoolAmplificationProposal{},
+		&PoolManagementProposal{},
+		&PairOrderRulesProposal{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
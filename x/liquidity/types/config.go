@@ -0,0 +1,36 @@
+package types
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// Flags for the node-local configuration of the liquidity module.
+const (
+	FlagParallelMatching = "liquidity.parallel-matching"
+)
+
+// Config defines the node-local configuration of the liquidity module.
+// It doesn't affect the state, so each node can set it differently.
+type Config struct {
+	// ParallelMatching enables computing the matching of the pairs
+	// concurrently in the batch.
+	ParallelMatching bool `mapstructure:"parallel-matching"`
+}
+
+// DefaultConfig returns the default configuration of the liquidity module.
+func DefaultConfig() Config {
+	return Config{
+		ParallelMatching: false,
+	}
+}
+
+// ReadConfig reads the configuration of the liquidity module from the
+// app options.
+func ReadConfig(opts servertypes.AppOptions) Config {
+	config := DefaultConfig()
+	if v := opts.Get(FlagParallelMatching); v != nil {
+		config.ParallelMatching = cast.ToBool(v)
+	}
+	return config
+}
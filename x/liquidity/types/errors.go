@@ -27,4 +27,13 @@ var (
 	ErrTooManyPools              = sdkerrors.Register(ModuleName, 19, "too many pools in the pair")
 	ErrPriceNotOnTicks           = sdkerrors.Register(ModuleName, 20, "price is not on ticks")
 	ErrMaxNumMMOrdersExceeded    = sdkerrors.Register(ModuleName, 21, "number of MM orders exceeded the limit")
+	ErrPostOnlyOrderWouldCross   = sdkerrors.Register(ModuleName, 22, "post-only order would cross the order book")
+	ErrInsufficientLiquidity     = sdkerrors.Register(ModuleName, 23, "insufficient liquidity")
+	ErrTooSmallSwapOutput        = sdkerrors.Register(ModuleName, 24, "swap output is smaller than the minimum")
+	ErrWrongPoolType             = sdkerrors.Register(ModuleName, 25, "wrong pool type")
+	ErrTooSmallMintedPoolCoin    = sdkerrors.Register(ModuleName, 26, "minted pool coin is smaller than the minimum")
+	ErrTooSmallWithdrawnCoin     = sdkerrors.Register(ModuleName, 27, "withdrawn coin is smaller than the minimum")
+	ErrInsufficientTWAPHistory   = sdkerrors.Register(ModuleName, 28, "insufficient price history for the twap window")
+	ErrDepositsPaused            = sdkerrors.Register(ModuleName, 29, "deposits are paused")
+	ErrOrdersPaused              = sdkerrors.Register(ModuleName, 30, "orders are paused")
 )
@@ -1,23 +1,46 @@
 package types
 
 // Event types for the liquidity module.
+// The events with these types are deprecated in favor of the typed events
+// defined in events.proto, which are emitted next to them.
 const (
-	EventTypeCreatePair       = "create_pair"
-	EventTypeCreatePool       = "create_pool"
-	EventTypeCreateRangedPool = "create_ranged_pool"
-	EventTypeDeposit          = "deposit"
-	EventTypeWithdraw         = "withdraw"
-	EventTypeLimitOrder       = "limit_order"
-	EventTypeMarketOrder      = "market_order"
-	EventTypeMMOrder          = "mm_order"
-	EventTypeCancelOrder      = "cancel_order"
-	EventTypeCancelAllOrders  = "cancel_all_orders"
-	EventTypeCancelMMOrder    = "cancel_mm_order"
-	EventTypeDepositResult    = "deposit_result"
-	EventTypeWithdrawalResult = "withdrawal_result"
-	EventTypeOrderResult      = "order_result"
-	EventTypeUserOrderMatched = "user_order_matched"
-	EventTypePoolOrderMatched = "pool_order_matched"
+	EventTypeCreatePair               = "create_pair"
+	EventTypeCreatePool               = "create_pool"
+	EventTypeCreateRangedPool         = "create_ranged_pool"
+	EventTypeCreateStablePool         = "create_stable_pool"
+	EventTypeCreateWeightedPool       = "create_weighted_pool"
+	EventTypeCreateConcentratedPool   = "create_concentrated_pool"
+	EventTypeOpenPosition             = "open_position"
+	EventTypeClosePosition            = "close_position"
+	EventTypeCollectFees              = "collect_fees"
+	EventTypeDeposit                  = "deposit"
+	EventTypeDepositSingleSided       = "deposit_single_sided"
+	EventTypeWithdraw                 = "withdraw"
+	EventTypeWithdrawSingleSided      = "withdraw_single_sided"
+	EventTypeLimitOrder               = "limit_order"
+	EventTypeMarketOrder              = "market_order"
+	EventTypeMMOrder                  = "mm_order"
+	EventTypeBatchOrders              = "batch_orders"
+	EventTypeTriggerOrder             = "trigger_order"
+	EventTypeSwapExactIn              = "swap_exact_in"
+	EventTypeCancelOrder              = "cancel_order"
+	EventTypeReplaceOrder             = "replace_order"
+	EventTypeCancelAllOrders          = "cancel_all_orders"
+	EventTypeCancelMMOrder            = "cancel_mm_order"
+	EventTypeDepositResult            = "deposit_result"
+	EventTypeSingleSidedDepositResult = "single_sided_deposit_result"
+	EventTypeWithdrawalResult         = "withdrawal_result"
+	EventTypeOrderResult              = "order_result"
+	EventTypeSwapResult               = "swap_result"
+	EventTypeUserOrderMatched         = "user_order_matched"
+	EventTypePoolOrderMatched         = "pool_order_matched"
+	EventTypeOrderTriggered           = "order_triggered"
+	EventTypePairFeeRates             = "pair_fee_rates"
+	EventTypePoolAmplification        = "pool_amplification"
+	EventTypePairStatus               = "pair_status"
+	EventTypePoolStatus               = "pool_status"
+	EventTypeMigratePool              = "migrate_pool"
+	EventTypePairOrderRules           = "pair_order_rules"
 
 	AttributeKeyCreator            = "creator"
 	AttributeKeyDepositor          = "depositor"
@@ -26,6 +49,10 @@ const (
 	AttributeKeyBaseCoinDenom      = "base_coin_denom"
 	AttributeKeyQuoteCoinDenom     = "quote_coin_denom"
 	AttributeKeyDepositCoins       = "deposit_coins"
+	AttributeKeyDepositCoin        = "deposit_coin"
+	AttributeKeyMinMintedPoolCoin  = "min_minted_pool_coin"
+	AttributeKeySwappedCoin        = "swapped_coin"
+	AttributeKeyMinWithdrawnCoin   = "min_withdrawn_coin"
 	AttributeKeyAcceptedCoins      = "accepted_coins"
 	AttributeKeyMintedPoolCoin     = "minted_pool_coin"
 	AttributeKeyPoolCoin           = "pool_coin"
@@ -33,14 +60,17 @@ const (
 	AttributeKeyRefundedCoins      = "refunded_coins"
 	AttributeKeyReserveAddress     = "reserve_address"
 	AttributeKeyEscrowAddress      = "escrow_address"
+	AttributeKeyAllocationPolicy   = "allocation_policy"
 	AttributeKeyRequestId          = "request_id"
 	AttributeKeyPoolId             = "pool_id"
 	AttributeKeyPairId             = "pair_id"
 	AttributeKeyBatchId            = "batch_id"
 	AttributeKeyOrderId            = "order_id"
 	AttributeKeyOrderIds           = "order_ids"
+	AttributeKeyReplacedOrderId    = "replaced_order_id"
 	AttributeKeyOrderDirection     = "order_direction"
 	AttributeKeyOfferCoin          = "offer_coin"
+	AttributeKeyOfferCoins         = "offer_coins"
 	AttributeKeyDemandCoinDenom    = "demand_coin_denom"
 	AttributeKeyPrice              = "price"
 	AttributeKeyAmount             = "amount"
@@ -53,4 +83,30 @@ const (
 	AttributeKeyStatus             = "status"
 	AttributeKeyMatchedAmount      = "matched_amount"
 	AttributeKeyPaidCoin           = "paid_coin"
+	AttributeKeyOrderType          = "order_type"
+	AttributeKeyTriggerPrice       = "trigger_price"
+	AttributeKeyTriggerCondition   = "trigger_condition"
+	AttributeKeyLastPrice          = "last_price"
+	AttributeKeyMinOutCoin         = "min_out_coin"
+	AttributeKeyOutCoin            = "out_coin"
+	AttributeKeyFee                = "fee"
+	AttributeKeyMakerFeeRate       = "maker_fee_rate"
+	AttributeKeyTakerFeeRate       = "taker_fee_rate"
+	AttributeKeyAmplification      = "amplification"
+	AttributeKeyBaseCoinWeight     = "base_coin_weight"
+	AttributeKeyQuoteCoinWeight    = "quote_coin_weight"
+	AttributeKeyOwner              = "owner"
+	AttributeKeyPositionId         = "position_id"
+	AttributeKeyLowerPrice         = "lower_price"
+	AttributeKeyUpperPrice         = "upper_price"
+	AttributeKeyLiquidity          = "liquidity"
+	AttributeKeyFees               = "fees"
+	AttributeKeyDepositsPaused     = "deposits_paused"
+	AttributeKeyOrdersPaused       = "orders_paused"
+	AttributeKeyDisabled           = "disabled"
+	AttributeKeySourcePoolId       = "source_pool_id"
+	AttributeKeyTargetPoolId       = "target_pool_id"
+	AttributeKeyMigratedCoins      = "migrated_coins"
+	AttributeKeyTickPrecision      = "tick_precision"
+	AttributeKeyMinOrderNotional   = "min_order_notional"
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 )
 
 // DefaultGenesis returns the default Capability genesis state
@@ -16,6 +17,15 @@ func DefaultGenesis() *GenesisState {
 		WithdrawRequests:             []WithdrawRequest{},
 		Orders:                       []Order{},
 		NumMarketMakingOrdersRecords: []NumMMOrdersRecord{},
+		LastSwapRequestId:            0,
+		SwapRequests:                 []SwapRequest{},
+		Positions:                    []Position{},
+		SingleSidedDepositRequests:   []SingleSidedDepositRequest{},
+		PoolAccruals:                 []PoolAccrual{},
+		PoolAccrualSnapshots:         []PoolAccrualSnapshot{},
+		TWAPAccumulators:             []TWAPAccumulator{},
+		TWAPSnapshots:                []TWAPSnapshot{},
+		Candles:                      []Candle{},
 	}
 }
 
@@ -80,6 +90,31 @@ func (genState GenesisState) Validate() error {
 		}
 		depositReqSet[req.PoolId][req.Id] = struct{}{}
 	}
+	// Single-sided deposit requests share the id sequence with deposit requests.
+	for i, req := range genState.SingleSidedDepositRequests {
+		if err := req.Validate(); err != nil {
+			return fmt.Errorf("invalid single-sided deposit request at index %d: %w", i, err)
+		}
+		pool, ok := poolMap[req.PoolId]
+		if !ok {
+			return fmt.Errorf("single-sided deposit request at index %d has unknown pool id: %d", i, req.PoolId)
+		}
+		if req.MinMintedPoolCoin.Denom != pool.PoolCoinDenom || req.MintedPoolCoin.Denom != pool.PoolCoinDenom {
+			return fmt.Errorf("single-sided deposit request at index %d has wrong pool coin denom", i)
+		}
+		pair := pairMap[pool.PairId]
+		if req.DepositCoin.Denom != pair.BaseCoinDenom && req.DepositCoin.Denom != pair.QuoteCoinDenom {
+			return fmt.Errorf("single-sided deposit request at index %d has wrong deposit coin: %s", i, req.DepositCoin)
+		}
+		if set, ok := depositReqSet[req.PoolId]; ok {
+			if _, ok := set[req.Id]; ok {
+				return fmt.Errorf("single-sided deposit request at index %d has a duplicate id: %d", i, req.Id)
+			}
+		} else {
+			depositReqSet[req.PoolId] = map[uint64]struct{}{}
+		}
+		depositReqSet[req.PoolId][req.Id] = struct{}{}
+	}
 	withdrawReqSet := map[uint64]map[uint64]struct{}{}
 	for i, req := range genState.WithdrawRequests {
 		if err := req.Validate(); err != nil {
@@ -92,6 +127,13 @@ func (genState GenesisState) Validate() error {
 		if req.PoolCoin.Denom != pool.PoolCoinDenom {
 			return fmt.Errorf("withdraw request at index %d has wrong pool coin: %s", i, req.PoolCoin)
 		}
+		if req.SingleSided != nil {
+			pair := pairMap[pool.PairId]
+			denom := req.SingleSided.MinWithdrawnCoin.Denom
+			if denom != pair.BaseCoinDenom && denom != pair.QuoteCoinDenom {
+				return fmt.Errorf("withdraw request at index %d has wrong min withdrawn coin: %s", i, req.SingleSided.MinWithdrawnCoin)
+			}
+		}
 		if set, ok := withdrawReqSet[req.PoolId]; ok {
 			if _, ok := set[req.Id]; ok {
 				return fmt.Errorf("withdraw request at index %d has a duplicate id: %d", i, req.Id)
@@ -140,5 +182,125 @@ func (genState GenesisState) Validate() error {
 			return fmt.Errorf("number of MM order must be positive")
 		}
 	}
+	swapReqSet := map[uint64]struct{}{}
+	for i, req := range genState.SwapRequests {
+		if err := req.Validate(); err != nil {
+			return fmt.Errorf("invalid swap request at index %d: %w", i, err)
+		}
+		if req.Id > genState.LastSwapRequestId {
+			return fmt.Errorf("swap request at index %d has an id greater than last swap request id: %d", i, req.Id)
+		}
+		for _, pairId := range req.PairIds {
+			if _, ok := pairMap[pairId]; !ok {
+				return fmt.Errorf("swap request at index %d has unknown pair id: %d", i, pairId)
+			}
+		}
+		if _, ok := swapReqSet[req.Id]; ok {
+			return fmt.Errorf("swap request at index %d has a duplicate id: %d", i, req.Id)
+		}
+		swapReqSet[req.Id] = struct{}{}
+	}
+	positionSet := map[uint64]map[uint64]struct{}{}
+	for i, position := range genState.Positions {
+		if err := position.Validate(); err != nil {
+			return fmt.Errorf("invalid position at index %d: %w", i, err)
+		}
+		pool, ok := poolMap[position.PoolId]
+		if !ok {
+			return fmt.Errorf("position at index %d has unknown pool id: %d", i, position.PoolId)
+		}
+		if pool.Type != PoolTypeConcentrated {
+			return fmt.Errorf("position at index %d is in a non-concentrated pool: %d", i, position.PoolId)
+		}
+		if position.Id > pool.LastPositionId {
+			return fmt.Errorf("position at index %d has an id greater than its pool's last position id: %d", i, position.Id)
+		}
+		if set, ok := positionSet[position.PoolId]; ok {
+			if _, ok := set[position.Id]; ok {
+				return fmt.Errorf("position at index %d has a duplicate id: %d", i, position.Id)
+			}
+		} else {
+			positionSet[position.PoolId] = map[uint64]struct{}{}
+		}
+		positionSet[position.PoolId][position.Id] = struct{}{}
+	}
+	poolAccrualSet := map[uint64]struct{}{}
+	for i, accrual := range genState.PoolAccruals {
+		if err := accrual.Validate(); err != nil {
+			return fmt.Errorf("invalid pool accrual at index %d: %w", i, err)
+		}
+		if _, ok := poolMap[accrual.PoolId]; !ok {
+			return fmt.Errorf("pool accrual at index %d has unknown pool id: %d", i, accrual.PoolId)
+		}
+		if _, ok := poolAccrualSet[accrual.PoolId]; ok {
+			return fmt.Errorf("pool accrual at index %d has a duplicate pool id: %d", i, accrual.PoolId)
+		}
+		poolAccrualSet[accrual.PoolId] = struct{}{}
+	}
+	snapshotSet := map[uint64]map[time.Time]struct{}{}
+	for i, snapshot := range genState.PoolAccrualSnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return fmt.Errorf("invalid pool accrual snapshot at index %d: %w", i, err)
+		}
+		if _, ok := poolMap[snapshot.PoolId]; !ok {
+			return fmt.Errorf("pool accrual snapshot at index %d has unknown pool id: %d", i, snapshot.PoolId)
+		}
+		if set, ok := snapshotSet[snapshot.PoolId]; ok {
+			if _, ok := set[snapshot.Time]; ok {
+				return fmt.Errorf("pool accrual snapshot at index %d has a duplicate time: %s", i, snapshot.Time)
+			}
+		} else {
+			snapshotSet[snapshot.PoolId] = map[time.Time]struct{}{}
+		}
+		snapshotSet[snapshot.PoolId][snapshot.Time] = struct{}{}
+	}
+	twapAccumulatorMap := map[uint64]TWAPAccumulator{}
+	for i, acc := range genState.TWAPAccumulators {
+		if err := acc.Validate(); err != nil {
+			return fmt.Errorf("invalid twap accumulator at index %d: %w", i, err)
+		}
+		if _, ok := pairMap[acc.PairId]; !ok {
+			return fmt.Errorf("twap accumulator at index %d has unknown pair id: %d", i, acc.PairId)
+		}
+		if _, ok := twapAccumulatorMap[acc.PairId]; ok {
+			return fmt.Errorf("twap accumulator at index %d has a duplicate pair id: %d", i, acc.PairId)
+		}
+		twapAccumulatorMap[acc.PairId] = acc
+	}
+	twapSnapshotSet := map[uint64]map[uint64]struct{}{}
+	for i, snapshot := range genState.TWAPSnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return fmt.Errorf("invalid twap snapshot at index %d: %w", i, err)
+		}
+		acc, ok := twapAccumulatorMap[snapshot.PairId]
+		if !ok {
+			return fmt.Errorf("twap snapshot at index %d has unknown pair id: %d", i, snapshot.PairId)
+		}
+		if snapshot.Seq > acc.LastSnapshotSeq {
+			return fmt.Errorf("twap snapshot at index %d has a seq greater than last snapshot seq: %d", i, snapshot.Seq)
+		}
+		if set, ok := twapSnapshotSet[snapshot.PairId]; ok {
+			if _, ok := set[snapshot.Seq]; ok {
+				return fmt.Errorf("twap snapshot at index %d has a duplicate seq: %d", i, snapshot.Seq)
+			}
+		} else {
+			twapSnapshotSet[snapshot.PairId] = map[uint64]struct{}{}
+		}
+		twapSnapshotSet[snapshot.PairId][snapshot.Seq] = struct{}{}
+	}
+	candleSet := map[string]struct{}{}
+	for i, candle := range genState.Candles {
+		if err := candle.Validate(); err != nil {
+			return fmt.Errorf("invalid candle at index %d: %w", i, err)
+		}
+		if _, ok := pairMap[candle.PairId]; !ok {
+			return fmt.Errorf("candle at index %d has unknown pair id: %d", i, candle.PairId)
+		}
+		key := string(GetCandleKey(candle.PairId, candle.Interval, candle.OpenTime))
+		if _, ok := candleSet[key]; ok {
+			return fmt.Errorf("candle at index %d is a duplicate", i)
+		}
+		candleSet[key] = struct{}{}
+	}
 	return nil
 }
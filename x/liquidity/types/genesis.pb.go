@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,15 +29,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the liquidity module's genesis state.
 type GenesisState struct {
-	Params                       Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	LastPairId                   uint64              `protobuf:"varint,2,opt,name=last_pair_id,json=lastPairId,proto3" json:"last_pair_id,omitempty"`
-	LastPoolId                   uint64              `protobuf:"varint,3,opt,name=last_pool_id,json=lastPoolId,proto3" json:"last_pool_id,omitempty"`
-	Pairs                        []Pair              `protobuf:"bytes,4,rep,name=pairs,proto3" json:"pairs"`
-	Pools                        []Pool              `protobuf:"bytes,5,rep,name=pools,proto3" json:"pools"`
-	DepositRequests              []DepositRequest    `protobuf:"bytes,6,rep,name=deposit_requests,json=depositRequests,proto3" json:"deposit_requests"`
-	WithdrawRequests             []WithdrawRequest   `protobuf:"bytes,7,rep,name=withdraw_requests,json=withdrawRequests,proto3" json:"withdraw_requests"`
-	Orders                       []Order             `protobuf:"bytes,8,rep,name=orders,proto3" json:"orders"`
-	NumMarketMakingOrdersRecords []NumMMOrdersRecord `protobuf:"bytes,9,rep,name=num_market_making_orders_records,json=numMarketMakingOrdersRecords,proto3" json:"num_market_making_orders_records"`
+	Params                       Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LastPairId                   uint64                      `protobuf:"varint,2,opt,name=last_pair_id,json=lastPairId,proto3" json:"last_pair_id,omitempty"`
+	LastPoolId                   uint64                      `protobuf:"varint,3,opt,name=last_pool_id,json=lastPoolId,proto3" json:"last_pool_id,omitempty"`
+	Pairs                        []Pair                      `protobuf:"bytes,4,rep,name=pairs,proto3" json:"pairs"`
+	Pools                        []Pool                      `protobuf:"bytes,5,rep,name=pools,proto3" json:"pools"`
+	DepositRequests              []DepositRequest            `protobuf:"bytes,6,rep,name=deposit_requests,json=depositRequests,proto3" json:"deposit_requests"`
+	WithdrawRequests             []WithdrawRequest           `protobuf:"bytes,7,rep,name=withdraw_requests,json=withdrawRequests,proto3" json:"withdraw_requests"`
+	Orders                       []Order                     `protobuf:"bytes,8,rep,name=orders,proto3" json:"orders"`
+	NumMarketMakingOrdersRecords []NumMMOrdersRecord         `protobuf:"bytes,9,rep,name=num_market_making_orders_records,json=numMarketMakingOrdersRecords,proto3" json:"num_market_making_orders_records"`
+	LastSwapRequestId            uint64                      `protobuf:"varint,10,opt,name=last_swap_request_id,json=lastSwapRequestId,proto3" json:"last_swap_request_id,omitempty"`
+	SwapRequests                 []SwapRequest               `protobuf:"bytes,11,rep,name=swap_requests,json=swapRequests,proto3" json:"swap_requests"`
+	Positions                    []Position                  `protobuf:"bytes,12,rep,name=positions,proto3" json:"positions"`
+	SingleSidedDepositRequests   []SingleSidedDepositRequest `protobuf:"bytes,13,rep,name=single_sided_deposit_requests,json=singleSidedDepositRequests,proto3" json:"single_sided_deposit_requests"`
+	PoolAccruals                 []PoolAccrual               `protobuf:"bytes,14,rep,name=pool_accruals,json=poolAccruals,proto3" json:"pool_accruals"`
+	PoolAccrualSnapshots         []PoolAccrualSnapshot       `protobuf:"bytes,15,rep,name=pool_accrual_snapshots,json=poolAccrualSnapshots,proto3" json:"pool_accrual_snapshots"`
+	LastPoolAccrualSnapshotTime  *time.Time                  `protobuf:"bytes,16,opt,name=last_pool_accrual_snapshot_time,json=lastPoolAccrualSnapshotTime,proto3,stdtime" json:"last_pool_accrual_snapshot_time,omitempty"`
+	TWAPAccumulators             []TWAPAccumulator           `protobuf:"bytes,17,rep,name=twap_accumulators,json=twapAccumulators,proto3" json:"twap_accumulators"`
+	TWAPSnapshots                []TWAPSnapshot              `protobuf:"bytes,18,rep,name=twap_snapshots,json=twapSnapshots,proto3" json:"twap_snapshots"`
+	Candles                      []Candle                    `protobuf:"bytes,19,rep,name=candles,proto3" json:"candles"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -120,38 +134,57 @@ func init() {
 }
 
 var fileDescriptor_6a6239844d27c73b = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x37, 0x76, 0x37, 0x6b, 0xa7, 0x15, 0xdb, 0x41, 0x71, 0x58, 0x24, 0xc6, 0x3d, 0x2d,
-	0x95, 0x26, 0xb4, 0x22, 0x22, 0x08, 0x4a, 0x11, 0xa4, 0x87, 0xd5, 0x12, 0x0f, 0x05, 0x05, 0xc3,
-	0xec, 0xce, 0x90, 0x0e, 0x9b, 0x64, 0xd2, 0x79, 0x93, 0xae, 0xc5, 0x83, 0x7f, 0x80, 0x17, 0xff,
-	0xac, 0x3d, 0xf6, 0xe8, 0x49, 0x74, 0xf7, 0x1f, 0x91, 0x4c, 0xb2, 0xbf, 0xd0, 0x86, 0xde, 0x92,
-	0x97, 0xef, 0xe7, 0xf3, 0xde, 0x90, 0x37, 0xa8, 0x37, 0x54, 0x1c, 0x86, 0x3c, 0xd5, 0x7e, 0x2c,
-	0xce, 0x73, 0xc1, 0x84, 0xbe, 0xf4, 0x2f, 0x0e, 0x06, 0x5c, 0xd3, 0x03, 0x3f, 0xe2, 0x29, 0x07,
-	0x01, 0x5e, 0xa6, 0xa4, 0x96, 0xb8, 0x33, 0x4f, 0x7a, 0x8b, 0xa4, 0x57, 0x25, 0x3b, 0xf7, 0x22,
-	0x19, 0x49, 0x13, 0xf3, 0x8b, 0xa7, 0x92, 0xe8, 0xec, 0xd5, 0xb8, 0x97, 0x0e, 0x93, 0xed, 0x7e,
-	0x6f, 0xa1, 0xed, 0xb7, 0x65, 0xbf, 0x0f, 0x9a, 0x6a, 0x8e, 0x5f, 0x23, 0x3b, 0xa3, 0x8a, 0x26,
-	0x40, 0x2c, 0xd7, 0xea, 0x6d, 0x1d, 0x76, 0xbd, 0xeb, 0xfb, 0x7b, 0x27, 0x26, 0x79, 0xd4, 0x9c,
-	0xfc, 0x7a, 0xd4, 0x08, 0x2a, 0x0e, 0xbb, 0x68, 0x3b, 0xa6, 0xa0, 0xc3, 0x8c, 0x0a, 0x15, 0x0a,
-	0x46, 0x6e, 0xb9, 0x56, 0xaf, 0x19, 0xa0, 0xa2, 0x76, 0x42, 0x85, 0x3a, 0x66, 0xcb, 0x84, 0x94,
-	0x71, 0x91, 0xd8, 0x58, 0x49, 0x48, 0x19, 0x1f, 0x33, 0xfc, 0x12, 0xb5, 0x0a, 0x1c, 0x48, 0xd3,
-	0xdd, 0xe8, 0x6d, 0x1d, 0xba, 0xf5, 0x43, 0x08, 0x55, 0x8d, 0x50, 0x42, 0x86, 0x96, 0x32, 0x06,
-	0xd2, 0xba, 0x01, 0x2d, 0x65, 0xbc, 0xa0, 0x0b, 0x08, 0x7f, 0x42, 0x3b, 0x8c, 0x67, 0x12, 0x84,
-	0x0e, 0x15, 0x3f, 0xcf, 0x39, 0x68, 0x20, 0xb6, 0x11, 0xed, 0xd5, 0x89, 0xde, 0x94, 0x4c, 0x50,
-	0x22, 0x95, 0xf2, 0x2e, 0x5b, 0xab, 0x02, 0xfe, 0x8c, 0x76, 0xc7, 0x42, 0x9f, 0x31, 0x45, 0xc7,
-	0x4b, 0x7b, 0xdb, 0xd8, 0x9f, 0xd4, 0xd9, 0x4f, 0x2b, 0x68, 0x5d, 0xbf, 0x33, 0x5e, 0x2f, 0x03,
-	0x7e, 0x85, 0x6c, 0xa9, 0x18, 0x57, 0x40, 0x6e, 0x1b, 0xe9, 0xe3, 0x3a, 0xe9, 0xfb, 0x22, 0x39,
-	0xff, 0x7b, 0x25, 0x86, 0xbf, 0x22, 0x37, 0xcd, 0x93, 0x30, 0xa1, 0x6a, 0xc4, 0x75, 0x98, 0xd0,
-	0x91, 0x48, 0xa3, 0xb0, 0xfc, 0x16, 0x2a, 0x3e, 0x94, 0x8a, 0x01, 0xd9, 0x34, 0xea, 0xfd, 0x3a,
-	0xf5, 0xbb, 0x3c, 0xe9, 0xf7, 0x8d, 0x1f, 0x02, 0x43, 0x55, 0x6d, 0x1e, 0xa6, 0x79, 0xd2, 0x37,
-	0xee, 0xbe, 0x51, 0xaf, 0x46, 0xa0, 0xfb, 0x0d, 0xed, 0xfe, 0x03, 0x62, 0x82, 0xda, 0xa6, 0x3f,
-	0x57, 0x66, 0x25, 0x37, 0x83, 0xf9, 0x2b, 0x7e, 0x80, 0xda, 0xeb, 0x4b, 0x66, 0x67, 0xe5, 0x82,
-	0x3d, 0x47, 0xe4, 0xba, 0x43, 0x98, 0x65, 0xbb, 0x13, 0xdc, 0xff, 0xef, 0x1c, 0x47, 0xa7, 0x93,
-	0x3f, 0x4e, 0x63, 0x32, 0x75, 0xac, 0xab, 0xa9, 0x63, 0xfd, 0x9e, 0x3a, 0xd6, 0x8f, 0x99, 0xd3,
-	0xb8, 0x9a, 0x39, 0x8d, 0x9f, 0x33, 0xa7, 0xf1, 0xf1, 0x45, 0x24, 0xf4, 0x59, 0x3e, 0xf0, 0x86,
-	0x32, 0xf1, 0xe7, 0x67, 0xdf, 0x4f, 0xb9, 0x1e, 0x4b, 0x35, 0x5a, 0x14, 0xfc, 0x8b, 0x67, 0xfe,
-	0x97, 0x95, 0x9b, 0xa7, 0x2f, 0x33, 0x0e, 0x03, 0xdb, 0x5c, 0xb7, 0xa7, 0x7f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x1f, 0x94, 0x92, 0x74, 0xf8, 0x03, 0x00, 0x00,
+	// 798 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xd1, 0x6e, 0xe3, 0x44,
+	0x14, 0x4d, 0x68, 0x37, 0xa5, 0xd3, 0x64, 0x37, 0x19, 0xba, 0x30, 0x0a, 0x90, 0x84, 0x0a, 0x89,
+	0x68, 0xd1, 0xda, 0xda, 0x45, 0x2b, 0x84, 0x84, 0x04, 0x0d, 0x48, 0xd0, 0x87, 0x42, 0xe5, 0xac,
+	0x54, 0x09, 0x24, 0xac, 0x89, 0x3d, 0x75, 0x47, 0xb1, 0x3d, 0xde, 0xb9, 0xe3, 0x0d, 0x2b, 0x24,
+	0xf8, 0x85, 0xfd, 0x07, 0x7e, 0xa6, 0x8f, 0xfb, 0xc8, 0xd3, 0x02, 0xe9, 0x8f, 0xac, 0x66, 0x6c,
+	0xc7, 0x4e, 0xbb, 0x75, 0xfa, 0x66, 0xdf, 0x39, 0xe7, 0xdc, 0x3b, 0x77, 0xee, 0x9c, 0x41, 0x63,
+	0x4f, 0x32, 0xf0, 0x58, 0xac, 0xec, 0x90, 0x3f, 0x4b, 0xb9, 0xcf, 0xd5, 0x0b, 0xfb, 0xf9, 0xa3,
+	0x19, 0x53, 0xf4, 0x91, 0x1d, 0xb0, 0x98, 0x01, 0x07, 0x2b, 0x91, 0x42, 0x09, 0xdc, 0x2f, 0x90,
+	0xd6, 0x0a, 0x69, 0xe5, 0xc8, 0xfe, 0x7e, 0x20, 0x02, 0x61, 0x60, 0xb6, 0xfe, 0xca, 0x18, 0xfd,
+	0x61, 0x20, 0x44, 0x10, 0x32, 0xdb, 0xfc, 0xcd, 0xd2, 0x33, 0x5b, 0xf1, 0x88, 0x81, 0xa2, 0x51,
+	0x92, 0x03, 0x1e, 0xd4, 0x24, 0x2f, 0x93, 0x18, 0xec, 0xc1, 0xdf, 0x6d, 0xd4, 0xfe, 0x21, 0x2b,
+	0x68, 0xaa, 0xa8, 0x62, 0xf8, 0x5b, 0xd4, 0x4a, 0xa8, 0xa4, 0x11, 0x90, 0xe6, 0xa8, 0x39, 0xde,
+	0x7b, 0x7c, 0x60, 0xdd, 0x5c, 0xa0, 0x75, 0x62, 0x90, 0x93, 0xed, 0x8b, 0xd7, 0xc3, 0x86, 0x93,
+	0xf3, 0xf0, 0x08, 0xb5, 0x43, 0x0a, 0xca, 0x4d, 0x28, 0x97, 0x2e, 0xf7, 0xc9, 0x3b, 0xa3, 0xe6,
+	0x78, 0xdb, 0x41, 0x3a, 0x76, 0x42, 0xb9, 0x3c, 0xf2, 0x4b, 0x84, 0x10, 0xa1, 0x46, 0x6c, 0x55,
+	0x10, 0x42, 0x84, 0x47, 0x3e, 0xfe, 0x1a, 0xdd, 0xd1, 0x74, 0x20, 0xdb, 0xa3, 0xad, 0xf1, 0xde,
+	0xe3, 0x51, 0x7d, 0x11, 0x5c, 0xe6, 0x25, 0x64, 0x24, 0xc3, 0x16, 0x22, 0x04, 0x72, 0xe7, 0x16,
+	0x6c, 0x21, 0xc2, 0x15, 0x5b, 0x93, 0xf0, 0xaf, 0xa8, 0xeb, 0xb3, 0x44, 0x00, 0x57, 0xae, 0x64,
+	0xcf, 0x52, 0x06, 0x0a, 0x48, 0xcb, 0x08, 0x3d, 0xa8, 0x13, 0xfa, 0x3e, 0xe3, 0x38, 0x19, 0x25,
+	0x97, 0xbc, 0xe7, 0xaf, 0x45, 0x01, 0xff, 0x86, 0x7a, 0x0b, 0xae, 0xce, 0x7d, 0x49, 0x17, 0xa5,
+	0xfa, 0x8e, 0x51, 0xff, 0xbc, 0x4e, 0xfd, 0x34, 0x27, 0xad, 0xcb, 0x77, 0x17, 0xeb, 0x61, 0xc0,
+	0xdf, 0xa0, 0x96, 0x90, 0x3e, 0x93, 0x40, 0xde, 0x35, 0xa2, 0x9f, 0xd4, 0x89, 0xfe, 0xac, 0x91,
+	0xc5, 0xe9, 0x65, 0x34, 0xfc, 0x07, 0x1a, 0xc5, 0x69, 0xe4, 0x46, 0x54, 0xce, 0x99, 0x72, 0x23,
+	0x3a, 0xe7, 0x71, 0xe0, 0x66, 0x6b, 0xae, 0x64, 0x9e, 0x90, 0x3e, 0x90, 0x5d, 0x23, 0xfd, 0xb0,
+	0x4e, 0xfa, 0xa7, 0x34, 0x3a, 0x3e, 0x36, 0xfa, 0xe0, 0x18, 0x56, 0x9e, 0xe6, 0xa3, 0x38, 0x8d,
+	0x8e, 0x8d, 0xf6, 0xb1, 0x91, 0xae, 0x42, 0x00, 0xdb, 0x68, 0xdf, 0x0c, 0x06, 0x2c, 0x68, 0x52,
+	0xb4, 0x47, 0x0f, 0x08, 0x32, 0x03, 0xd2, 0xd3, 0x6b, 0xd3, 0x05, 0x4d, 0xf2, 0xdd, 0x1e, 0xf9,
+	0xd8, 0x41, 0x9d, 0x2a, 0x16, 0xc8, 0x9e, 0x29, 0xed, 0xb3, 0xba, 0xd2, 0x2a, 0x0a, 0x79, 0x51,
+	0x6d, 0x28, 0x43, 0x80, 0x7f, 0x44, 0xbb, 0xe6, 0xcc, 0xb8, 0x88, 0x81, 0xb4, 0x8d, 0xde, 0xa7,
+	0xf5, 0x13, 0x94, 0x81, 0x73, 0xb1, 0x92, 0x8c, 0xff, 0x44, 0x1f, 0x03, 0x8f, 0x83, 0x90, 0xb9,
+	0xc0, 0x7d, 0xe6, 0xbb, 0xd7, 0xc6, 0xaa, 0x63, 0xd4, 0x9f, 0xd4, 0x56, 0x6b, 0x04, 0xa6, 0x9a,
+	0xff, 0xd6, 0x09, 0xeb, 0xc3, 0x4d, 0x00, 0xd0, 0xdd, 0x31, 0x57, 0x8c, 0x7a, 0x9e, 0x4c, 0x69,
+	0x08, 0xe4, 0xee, 0xe6, 0xee, 0xe8, 0xfb, 0x70, 0x98, 0xe1, 0x8b, 0xee, 0x24, 0x65, 0x08, 0xf0,
+	0x1c, 0xbd, 0x5f, 0xd5, 0x74, 0x21, 0xa6, 0x09, 0x9c, 0x0b, 0x05, 0xe4, 0x9e, 0x11, 0xb7, 0x6f,
+	0x29, 0x3e, 0xcd, 0x79, 0x79, 0x92, 0xfd, 0xe4, 0xfa, 0x12, 0xe0, 0x33, 0x34, 0x2c, 0x8d, 0xe2,
+	0x6a, 0x46, 0x57, 0xfb, 0x1e, 0xe9, 0x1a, 0x97, 0xea, 0x5b, 0x99, 0x29, 0x5a, 0x85, 0x29, 0x5a,
+	0x4f, 0x0b, 0x53, 0x9c, 0x6c, 0xbf, 0xfc, 0x77, 0xd8, 0x74, 0x3e, 0x2c, 0xdc, 0xe5, 0x4a, 0x12,
+	0x8d, 0xc3, 0x12, 0xf5, 0x94, 0x1e, 0x23, 0xea, 0x79, 0x69, 0x94, 0x86, 0x54, 0x09, 0x09, 0xa4,
+	0xb7, 0xf9, 0x56, 0x3e, 0x3d, 0x3d, 0x3c, 0x39, 0x2c, 0x39, 0x13, 0xa2, 0xf7, 0xb2, 0x7c, 0x3d,
+	0xec, 0x5e, 0x59, 0x00, 0xa7, 0xab, 0xf5, 0xab, 0x11, 0x7c, 0x86, 0xee, 0x9a, 0x9c, 0x65, 0x03,
+	0xb1, 0x49, 0x38, 0xde, 0x94, 0x70, 0xd5, 0xb9, 0xfb, 0x79, 0xb6, 0x4e, 0x35, 0x0a, 0x4e, 0x47,
+	0xcb, 0x96, 0x3d, 0x9c, 0xa0, 0x1d, 0x8f, 0xc6, 0x7e, 0xc8, 0x80, 0xbc, 0x37, 0xda, 0xda, 0xe4,
+	0xe8, 0xdf, 0x19, 0x68, 0x7e, 0x28, 0x05, 0xf1, 0xe0, 0x2f, 0xd4, 0xbb, 0x76, 0xa1, 0x31, 0x41,
+	0x3b, 0xc6, 0x17, 0x98, 0x34, 0x4f, 0xc5, 0xae, 0x53, 0xfc, 0xe2, 0x0f, 0xd0, 0xce, 0xba, 0xf9,
+	0xb7, 0x92, 0xcc, 0xf8, 0xbf, 0x44, 0xe4, 0x26, 0x73, 0x31, 0x8f, 0x40, 0xc7, 0xb9, 0xff, 0x56,
+	0x7f, 0x98, 0x9c, 0x5e, 0xfc, 0x3f, 0x68, 0x5c, 0x2c, 0x07, 0xcd, 0x57, 0xcb, 0x41, 0xf3, 0xbf,
+	0xe5, 0xa0, 0xf9, 0xf2, 0x72, 0xd0, 0x78, 0x75, 0x39, 0x68, 0xfc, 0x73, 0x39, 0x68, 0xfc, 0xf2,
+	0x55, 0xc0, 0xd5, 0x79, 0x3a, 0xb3, 0x3c, 0x11, 0xd9, 0xc5, 0xde, 0x1e, 0xc6, 0x4c, 0x2d, 0x84,
+	0x9c, 0xaf, 0x02, 0xf6, 0xf3, 0x27, 0xf6, 0xef, 0x95, 0x17, 0x51, 0xbd, 0x48, 0x18, 0xcc, 0x5a,
+	0x66, 0x60, 0xbe, 0x78, 0x33, 0x00, 0x41, 0x3c, 0x4f, 0xe9, 0xb1, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -174,6 +207,141 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.TWAPSnapshots) > 0 {
+		for iNdEx := len(m.TWAPSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TWAPSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TWAPAccumulators) > 0 {
+		for iNdEx := len(m.TWAPAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TWAPAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.LastPoolAccrualSnapshotTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastPoolAccrualSnapshotTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastPoolAccrualSnapshotTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGenesis(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.PoolAccrualSnapshots) > 0 {
+		for iNdEx := len(m.PoolAccrualSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolAccrualSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PoolAccruals) > 0 {
+		for iNdEx := len(m.PoolAccruals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolAccruals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.SingleSidedDepositRequests) > 0 {
+		for iNdEx := len(m.SingleSidedDepositRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SingleSidedDepositRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SwapRequests) > 0 {
+		for iNdEx := len(m.SwapRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.LastSwapRequestId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSwapRequestId))
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.NumMarketMakingOrdersRecords) > 0 {
 		for iNdEx := len(m.NumMarketMakingOrdersRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -382,6 +550,61 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.LastSwapRequestId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSwapRequestId))
+	}
+	if len(m.SwapRequests) > 0 {
+		for _, e := range m.SwapRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SingleSidedDepositRequests) > 0 {
+		for _, e := range m.SingleSidedDepositRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolAccruals) > 0 {
+		for _, e := range m.PoolAccruals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolAccrualSnapshots) > 0 {
+		for _, e := range m.PoolAccrualSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastPoolAccrualSnapshotTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastPoolAccrualSnapshotTime)
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.TWAPAccumulators) > 0 {
+		for _, e := range m.TWAPAccumulators {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TWAPSnapshots) > 0 {
+		for _, e := range m.TWAPSnapshots {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -714,6 +937,333 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSwapRequestId", wireType)
+			}
+			m.LastSwapRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSwapRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRequests = append(m.SwapRequests, SwapRequest{})
+			if err := m.SwapRequests[len(m.SwapRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleSidedDepositRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SingleSidedDepositRequests = append(m.SingleSidedDepositRequests, SingleSidedDepositRequest{})
+			if err := m.SingleSidedDepositRequests[len(m.SingleSidedDepositRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAccruals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAccruals = append(m.PoolAccruals, PoolAccrual{})
+			if err := m.PoolAccruals[len(m.PoolAccruals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAccrualSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAccrualSnapshots = append(m.PoolAccrualSnapshots, PoolAccrualSnapshot{})
+			if err := m.PoolAccrualSnapshots[len(m.PoolAccrualSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPoolAccrualSnapshotTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastPoolAccrualSnapshotTime == nil {
+				m.LastPoolAccrualSnapshotTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastPoolAccrualSnapshotTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TWAPAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TWAPAccumulators = append(m.TWAPAccumulators, TWAPAccumulator{})
+			if err := m.TWAPAccumulators[len(m.TWAPAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TWAPSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TWAPSnapshots = append(m.TWAPSnapshots, TWAPSnapshot{})
+			if err := m.TWAPSnapshots[len(m.TWAPSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		OfferCoin:          sdk.NewInt64Coin("denom1", 1000000),
 		RemainingOfferCoin: sdk.NewInt64Coin("denom1", 500000),
 		ReceivedCoin:       sdk.NewInt64Coin("denom2", 500000),
+		Fee:                sdk.NewInt64Coin("denom2", 0),
 		Price:              utils.ParseDec("1.0"),
 		Amount:             sdk.NewInt(1000000),
 		OpenAmount:         sdk.NewInt(500000),
@@ -87,6 +89,14 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"pair at index 1 has a duplicate id: 1",
 		},
+		{
+			"invalid pair fee rate",
+			func(genState *types.GenesisState) {
+				feeRate := utils.ParseDec("1.0")
+				genState.Pairs[0].TakerFeeRate = &feeRate
+			},
+			"invalid pair at index 0: invalid taker fee rate: fee rate must be less than 1: 1.000000000000000000",
+		},
 		{
 			"invalid pool",
 			func(genState *types.GenesisState) {
@@ -211,9 +221,17 @@ func TestGenesisState_Validate(t *testing.T) {
 			"wrong demand coin denom",
 			func(genState *types.GenesisState) {
 				genState.Orders[0].ReceivedCoin.Denom = "denom4"
+				genState.Orders[0].Fee.Denom = "denom4"
 			},
 			"order at index 0 has wrong demand coin denom: denom1 != denom2",
 		},
+		{
+			"wrong fee denom",
+			func(genState *types.GenesisState) {
+				genState.Orders[0].Fee.Denom = "denom1"
+			},
+			"invalid order at index 0: received coin denom denom2 != fee denom denom1",
+		},
 		{
 			"duplicate order",
 			func(genState *types.GenesisState) {
@@ -221,6 +239,66 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"order at index 1 has a duplicate id: 1",
 		},
+		{
+			"valid twap accumulator and snapshot",
+			func(genState *types.GenesisState) {
+				acc := types.NewTWAPAccumulator(1, utils.ParseDec("1.0"), utils.ParseTime("2022-01-01T00:00:00Z"))
+				acc.LastSnapshotSeq = 1
+				genState.TWAPAccumulators = []types.TWAPAccumulator{acc}
+				genState.TWAPSnapshots = []types.TWAPSnapshot{acc.Snapshot(1, acc.LastUpdatedAt)}
+			},
+			"",
+		},
+		{
+			"twap snapshot with too big seq",
+			func(genState *types.GenesisState) {
+				acc := types.NewTWAPAccumulator(1, utils.ParseDec("1.0"), utils.ParseTime("2022-01-01T00:00:00Z"))
+				genState.TWAPAccumulators = []types.TWAPAccumulator{acc}
+				genState.TWAPSnapshots = []types.TWAPSnapshot{acc.Snapshot(1, acc.LastUpdatedAt)}
+			},
+			"twap snapshot at index 0 has a seq greater than last snapshot seq: 1",
+		},
+		{
+			"twap accumulator with unknown pair",
+			func(genState *types.GenesisState) {
+				genState.TWAPAccumulators = []types.TWAPAccumulator{
+					types.NewTWAPAccumulator(2, utils.ParseDec("1.0"), utils.ParseTime("2022-01-01T00:00:00Z")),
+				}
+			},
+			"twap accumulator at index 0 has unknown pair id: 2",
+		},
+		{
+			"valid candle",
+			func(genState *types.GenesisState) {
+				genState.Candles = []types.Candle{
+					types.NewCandle(
+						1, time.Hour, utils.ParseTime("2022-01-01T01:00:00Z"),
+						utils.ParseDec("1.0"), sdk.NewInt(1000), sdk.NewInt(1000)),
+				}
+			},
+			"",
+		},
+		{
+			"candle with unaligned open time",
+			func(genState *types.GenesisState) {
+				genState.Candles = []types.Candle{
+					types.NewCandle(
+						1, time.Hour, utils.ParseTime("2022-01-01T01:30:00Z"),
+						utils.ParseDec("1.0"), sdk.NewInt(1000), sdk.NewInt(1000)),
+				}
+			},
+			"invalid candle at index 0: open time must be a multiple of the interval: 2022-01-01 01:30:00 +0000 UTC",
+		},
+		{
+			"duplicate candle",
+			func(genState *types.GenesisState) {
+				candle := types.NewCandle(
+					1, time.Hour, utils.ParseTime("2022-01-01T01:00:00Z"),
+					utils.ParseDec("1.0"), sdk.NewInt(1000), sdk.NewInt(1000))
+				genState.Candles = []types.Candle{candle, candle}
+			},
+			"candle at index 1 is a duplicate",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			genState := types.DefaultGenesis()
@@ -0,0 +1,66 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LiquidityHooks defines the hooks to be notified of the lifecycle of
+// pools, orders and requests in the liquidity module.
+// Hooks invoked in the batch must not change the orders and pools of other
+// pairs, since the matching of the pairs may be computed in parallel.
+type LiquidityHooks interface {
+	AfterPoolCreated(ctx sdk.Context, pool Pool)
+	AfterOrderPlaced(ctx sdk.Context, order Order)
+	AfterOrderMatched(ctx sdk.Context, order Order, paidCoin, receivedCoin sdk.Coin)
+	AfterDepositExecuted(ctx sdk.Context, req DepositRequest)
+	AfterWithdrawExecuted(ctx sdk.Context, req WithdrawRequest)
+}
+
+var _ LiquidityHooks = MultiLiquidityHooks{}
+
+// MultiLiquidityHooks combines multiple liquidity hooks, which are called
+// in the order they are given.
+type MultiLiquidityHooks []LiquidityHooks
+
+// NewMultiLiquidityHooks returns a new MultiLiquidityHooks.
+func NewMultiLiquidityHooks(hooks ...LiquidityHooks) MultiLiquidityHooks {
+	return hooks
+}
+
+// AfterPoolCreated is called after a pool is created.
+func (h MultiLiquidityHooks) AfterPoolCreated(ctx sdk.Context, pool Pool) {
+	for i := range h {
+		h[i].AfterPoolCreated(ctx, pool)
+	}
+}
+
+// AfterOrderPlaced is called after an order is placed, including an order
+// replacing another one.
+func (h MultiLiquidityHooks) AfterOrderPlaced(ctx sdk.Context, order Order) {
+	for i := range h {
+		h[i].AfterOrderPlaced(ctx, order)
+	}
+}
+
+// AfterOrderMatched is called after an order is matched in the batch and
+// the coins are sent, with the paid coin and the received coin excluding
+// the fee in the batch.
+func (h MultiLiquidityHooks) AfterOrderMatched(ctx sdk.Context, order Order, paidCoin, receivedCoin sdk.Coin) {
+	for i := range h {
+		h[i].AfterOrderMatched(ctx, order, paidCoin, receivedCoin)
+	}
+}
+
+// AfterDepositExecuted is called after a deposit request succeeds.
+func (h MultiLiquidityHooks) AfterDepositExecuted(ctx sdk.Context, req DepositRequest) {
+	for i := range h {
+		h[i].AfterDepositExecuted(ctx, req)
+	}
+}
+
+// AfterWithdrawExecuted is called after a withdraw request succeeds.
+func (h MultiLiquidityHooks) AfterWithdrawExecuted(ctx sdk.Context, req WithdrawRequest) {
+	for i := range h {
+		h[i].AfterWithdrawExecuted(ctx, req)
+	}
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"bytes"
+	"math/big"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -25,6 +27,8 @@ var (
 	LastPairIdKey = []byte{0xa0} // key for the latest pair id
 	LastPoolIdKey = []byte{0xa1} // key for the latest pool id
 
+	LastSwapRequestIdKey = []byte{0xa2} // key for the latest swap request id
+
 	PairKeyPrefix               = []byte{0xa5}
 	PairIndexKeyPrefix          = []byte{0xa6}
 	PairsByDenomsIndexKeyPrefix = []byte{0xa7}
@@ -40,6 +44,29 @@ var (
 	OrderKeyPrefix                = []byte{0xb2}
 	OrderIndexKeyPrefix           = []byte{0xb3}
 	NumMMOrdersKeyPrefix          = []byte{0xb7}
+	SwapRequestKeyPrefix          = []byte{0xb8}
+	PositionKeyPrefix             = []byte{0xb9}
+	PositionIndexKeyPrefix        = []byte{0xba}
+
+	SingleSidedDepositRequestKeyPrefix      = []byte{0xbb}
+	SingleSidedDepositRequestIndexKeyPrefix = []byte{0xbc}
+
+	PoolAccrualKeyPrefix           = []byte{0xbd}
+	PoolAccrualSnapshotKeyPrefix   = []byte{0xbe}
+	LastPoolAccrualSnapshotTimeKey = []byte{0xbf} // key for the last time pool accrual snapshots were taken
+
+	TWAPAccumulatorKeyPrefix = []byte{0xc0}
+	TWAPSnapshotKeyPrefix    = []byte{0xc1}
+
+	CandleKeyPrefix = []byte{0xc2}
+
+	OrderByExpireAtIndexKeyPrefix                   = []byte{0xc3}
+	OrderByStatusIndexKeyPrefix                     = []byte{0xc4}
+	DepositRequestByStatusIndexKeyPrefix            = []byte{0xc5}
+	SingleSidedDepositRequestByStatusIndexKeyPrefix = []byte{0xc6}
+	WithdrawRequestByStatusIndexKeyPrefix           = []byte{0xc7}
+	SwapRequestByStatusIndexKeyPrefix               = []byte{0xc8}
+	OrderByPriceIndexKeyPrefix                      = []byte{0xc9}
 )
 
 // GetPairKey returns the store key to retrieve pair object from the pair id.
@@ -105,6 +132,99 @@ func GetDepositRequestIndexKeyPrefix(depositor sdk.AccAddress) []byte {
 	return append(DepositRequestIndexKeyPrefix, address.MustLengthPrefix(depositor)...)
 }
 
+// GetDepositRequestByStatusIndexKey returns the index key to iterate
+// deposit requests by status.
+func GetDepositRequestByStatusIndexKey(status RequestStatus, poolId, reqId uint64) []byte {
+	return append(append(GetDepositRequestsByStatusIndexKeyPrefix(status),
+		sdk.Uint64ToBigEndian(poolId)...), sdk.Uint64ToBigEndian(reqId)...)
+}
+
+// GetDepositRequestsByStatusIndexKeyPrefix returns the index key prefix
+// to iterate deposit requests with the status.
+func GetDepositRequestsByStatusIndexKeyPrefix(status RequestStatus) []byte {
+	return append(DepositRequestByStatusIndexKeyPrefix, byte(status))
+}
+
+// GetSingleSidedDepositRequestKey returns the store key to retrieve
+// single-sided deposit request object from the pool id and request id.
+func GetSingleSidedDepositRequestKey(poolId, id uint64) []byte {
+	return append(append(SingleSidedDepositRequestKeyPrefix, sdk.Uint64ToBigEndian(poolId)...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSingleSidedDepositRequestIndexKey returns the index key to map
+// single-sided deposit requests with a depositor.
+func GetSingleSidedDepositRequestIndexKey(depositor sdk.AccAddress, poolId, reqId uint64) []byte {
+	return append(append(append(SingleSidedDepositRequestIndexKeyPrefix, address.MustLengthPrefix(depositor)...),
+		sdk.Uint64ToBigEndian(poolId)...), sdk.Uint64ToBigEndian(reqId)...)
+}
+
+// GetSingleSidedDepositRequestIndexKeyPrefix returns the index key prefix
+// to iterate single-sided deposit requests by a depositor.
+func GetSingleSidedDepositRequestIndexKeyPrefix(depositor sdk.AccAddress) []byte {
+	return append(SingleSidedDepositRequestIndexKeyPrefix, address.MustLengthPrefix(depositor)...)
+}
+
+// GetSingleSidedDepositRequestByStatusIndexKey returns the index key to
+// iterate single-sided deposit requests by status.
+func GetSingleSidedDepositRequestByStatusIndexKey(status RequestStatus, poolId, reqId uint64) []byte {
+	return append(append(GetSingleSidedDepositRequestsByStatusIndexKeyPrefix(status),
+		sdk.Uint64ToBigEndian(poolId)...), sdk.Uint64ToBigEndian(reqId)...)
+}
+
+// GetSingleSidedDepositRequestsByStatusIndexKeyPrefix returns the index key
+// prefix to iterate single-sided deposit requests with the status.
+func GetSingleSidedDepositRequestsByStatusIndexKeyPrefix(status RequestStatus) []byte {
+	return append(SingleSidedDepositRequestByStatusIndexKeyPrefix, byte(status))
+}
+
+// GetPoolAccrualKey returns the store key to retrieve the pool accrual
+// from the pool id.
+func GetPoolAccrualKey(poolId uint64) []byte {
+	return append(PoolAccrualKeyPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetPoolAccrualSnapshotKey returns the store key to retrieve the pool
+// accrual snapshot from the pool id and the snapshot time.
+func GetPoolAccrualSnapshotKey(poolId uint64, t time.Time) []byte {
+	return append(GetPoolAccrualSnapshotsByPoolKeyPrefix(poolId), sdk.FormatTimeBytes(t)...)
+}
+
+// GetPoolAccrualSnapshotsByPoolKeyPrefix returns the store key to iterate
+// pool accrual snapshots by pool.
+func GetPoolAccrualSnapshotsByPoolKeyPrefix(poolId uint64) []byte {
+	return append(PoolAccrualSnapshotKeyPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetTWAPAccumulatorKey returns the store key to retrieve the twap
+// accumulator of the pair.
+func GetTWAPAccumulatorKey(pairId uint64) []byte {
+	return append(TWAPAccumulatorKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetTWAPSnapshotKey returns the store key to retrieve the twap snapshot
+// by pair id and sequence.
+func GetTWAPSnapshotKey(pairId, seq uint64) []byte {
+	return append(GetTWAPSnapshotsByPairKeyPrefix(pairId), sdk.Uint64ToBigEndian(seq)...)
+}
+
+// GetTWAPSnapshotsByPairKeyPrefix returns the store key to iterate twap
+// snapshots of the pair.
+func GetTWAPSnapshotsByPairKeyPrefix(pairId uint64) []byte {
+	return append(TWAPSnapshotKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetCandleKey returns the store key to retrieve the candle by pair id,
+// interval and open time.
+func GetCandleKey(pairId uint64, interval time.Duration, openTime time.Time) []byte {
+	return append(GetCandlesByPairAndIntervalKeyPrefix(pairId, interval), sdk.FormatTimeBytes(openTime)...)
+}
+
+// GetCandlesByPairAndIntervalKeyPrefix returns the store key to iterate
+// candles of the pair with the interval.
+func GetCandlesByPairAndIntervalKeyPrefix(pairId uint64, interval time.Duration) []byte {
+	return append(append(CandleKeyPrefix, sdk.Uint64ToBigEndian(pairId)...), sdk.Uint64ToBigEndian(uint64(interval))...)
+}
+
 // GetWithdrawRequestKey returns the store key to retrieve withdraw request object from the pool id and request id.
 func GetWithdrawRequestKey(poolId, id uint64) []byte {
 	return append(append(WithdrawRequestKeyPrefix, sdk.Uint64ToBigEndian(poolId)...), sdk.Uint64ToBigEndian(id)...)
@@ -123,6 +243,19 @@ func GetWithdrawRequestIndexKeyPrefix(depositor sdk.AccAddress) []byte {
 	return append(WithdrawRequestIndexKeyPrefix, address.MustLengthPrefix(depositor)...)
 }
 
+// GetWithdrawRequestByStatusIndexKey returns the index key to iterate
+// withdraw requests by status.
+func GetWithdrawRequestByStatusIndexKey(status RequestStatus, poolId, reqId uint64) []byte {
+	return append(append(GetWithdrawRequestsByStatusIndexKeyPrefix(status),
+		sdk.Uint64ToBigEndian(poolId)...), sdk.Uint64ToBigEndian(reqId)...)
+}
+
+// GetWithdrawRequestsByStatusIndexKeyPrefix returns the index key prefix
+// to iterate withdraw requests with the status.
+func GetWithdrawRequestsByStatusIndexKeyPrefix(status RequestStatus) []byte {
+	return append(WithdrawRequestByStatusIndexKeyPrefix, byte(status))
+}
+
 // GetOrderKey returns the store key to retrieve order object from the pair id and request id.
 func GetOrderKey(pairId, id uint64) []byte {
 	return append(append(OrderKeyPrefix, sdk.Uint64ToBigEndian(pairId)...), sdk.Uint64ToBigEndian(id)...)
@@ -145,12 +278,105 @@ func GetOrderIndexKeyPrefix(orderer sdk.AccAddress) []byte {
 	return append(OrderIndexKeyPrefix, address.MustLengthPrefix(orderer)...)
 }
 
+// GetOrderByExpireAtIndexKey returns the index key to iterate orders
+// by expiration time.
+func GetOrderByExpireAtIndexKey(expireAt time.Time, pairId, orderId uint64) []byte {
+	return append(append(GetOrdersByExpireAtIndexKeyPrefix(expireAt),
+		sdk.Uint64ToBigEndian(pairId)...), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// GetOrdersByExpireAtIndexKeyPrefix returns the index key prefix to iterate
+// orders expiring at the time.
+func GetOrdersByExpireAtIndexKeyPrefix(expireAt time.Time) []byte {
+	return append(OrderByExpireAtIndexKeyPrefix, sdk.FormatTimeBytes(expireAt)...)
+}
+
+// GetOrderByStatusIndexKey returns the index key to iterate orders by status.
+func GetOrderByStatusIndexKey(status OrderStatus, pairId, orderId uint64) []byte {
+	return append(append(GetOrdersByStatusIndexKeyPrefix(status),
+		sdk.Uint64ToBigEndian(pairId)...), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// GetOrdersByStatusIndexKeyPrefix returns the index key prefix to iterate
+// orders with the status.
+func GetOrdersByStatusIndexKeyPrefix(status OrderStatus) []byte {
+	return append(OrderByStatusIndexKeyPrefix, byte(status))
+}
+
+// GetOrdersByPairAndStatusIndexKeyPrefix returns the index key prefix to
+// iterate orders within the pair with the status.
+func GetOrdersByPairAndStatusIndexKeyPrefix(status OrderStatus, pairId uint64) []byte {
+	return append(GetOrdersByStatusIndexKeyPrefix(status), sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetOrderByPriceIndexKey returns the index key to iterate orders within
+// the pair by direction and price.
+func GetOrderByPriceIndexKey(pairId uint64, dir OrderDirection, price sdk.Dec, orderId uint64) []byte {
+	return append(append(GetOrdersByPriceIndexKeyPrefix(pairId, dir),
+		SortablePriceBytes(price)...), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// GetOrdersByPriceIndexKeyPrefix returns the index key prefix to iterate
+// orders within the pair with the direction, sorted by price.
+func GetOrdersByPriceIndexKeyPrefix(pairId uint64, dir OrderDirection) []byte {
+	return append(append(OrderByPriceIndexKeyPrefix, sdk.Uint64ToBigEndian(pairId)...), byte(dir))
+}
+
+// SortablePriceBytes returns a byte representation of a positive price,
+// which sorts in the same order as the price.
+// The big-endian bytes of the price's underlying integer are prefixed by
+// their length, since prices may exceed the range sdk.SortableDecBytes
+// supports.
+func SortablePriceBytes(price sdk.Dec) []byte {
+	bz := price.BigInt().Bytes()
+	return append([]byte{byte(len(bz))}, bz...)
+}
+
 // GetNumMMOrdersKey returns the store key to retrieve the number of MM orders
 // by orderer and pair id.
 func GetNumMMOrdersKey(orderer sdk.AccAddress, pairId uint64) []byte {
 	return append(append(NumMMOrdersKeyPrefix, address.MustLengthPrefix(orderer)...), sdk.Uint64ToBigEndian(pairId)...)
 }
 
+// GetSwapRequestKey returns the store key to retrieve swap request object from the request id.
+func GetSwapRequestKey(id uint64) []byte {
+	return append(SwapRequestKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSwapRequestByStatusIndexKey returns the index key to iterate swap
+// requests by status.
+func GetSwapRequestByStatusIndexKey(status RequestStatus, reqId uint64) []byte {
+	return append(GetSwapRequestsByStatusIndexKeyPrefix(status), sdk.Uint64ToBigEndian(reqId)...)
+}
+
+// GetSwapRequestsByStatusIndexKeyPrefix returns the index key prefix to
+// iterate swap requests with the status.
+func GetSwapRequestsByStatusIndexKeyPrefix(status RequestStatus) []byte {
+	return append(SwapRequestByStatusIndexKeyPrefix, byte(status))
+}
+
+// GetPositionKey returns the store key to retrieve position object from the pool id and position id.
+func GetPositionKey(poolId, id uint64) []byte {
+	return append(append(PositionKeyPrefix, sdk.Uint64ToBigEndian(poolId)...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPositionsByPoolKeyPrefix returns the store key to iterate positions by pool.
+func GetPositionsByPoolKeyPrefix(poolId uint64) []byte {
+	return append(PositionKeyPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetPositionIndexKey returns the index key to map positions with an owner.
+func GetPositionIndexKey(owner sdk.AccAddress, poolId, positionId uint64) []byte {
+	return append(append(append(PositionIndexKeyPrefix, address.MustLengthPrefix(owner)...),
+		sdk.Uint64ToBigEndian(poolId)...), sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// GetPositionIndexKeyPrefix returns the index key prefix to iterate positions
+// by an owner.
+func GetPositionIndexKeyPrefix(owner sdk.AccAddress) []byte {
+	return append(PositionIndexKeyPrefix, address.MustLengthPrefix(owner)...)
+}
+
 // ParsePairsByDenomsIndexKey parses a pair by denom index key.
 func ParsePairsByDenomsIndexKey(key []byte) (denomA, denomB string, pairId uint64) {
 	if !bytes.HasPrefix(key, PairsByDenomsIndexKeyPrefix) {
@@ -190,6 +416,20 @@ func ParseDepositRequestIndexKey(key []byte) (depositor sdk.AccAddress, poolId,
 	return
 }
 
+// ParseSingleSidedDepositRequestIndexKey parses a single-sided deposit
+// request index key.
+func ParseSingleSidedDepositRequestIndexKey(key []byte) (depositor sdk.AccAddress, poolId, reqId uint64) {
+	if !bytes.HasPrefix(key, SingleSidedDepositRequestIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	addrLen := key[1]
+	depositor = key[2 : 2+addrLen]
+	poolId = sdk.BigEndianToUint64(key[2+addrLen : 2+addrLen+8])
+	reqId = sdk.BigEndianToUint64(key[2+addrLen+8:])
+	return
+}
+
 // ParseWithdrawRequestIndexKey parses a withdraw request index key.
 func ParseWithdrawRequestIndexKey(key []byte) (withdrawer sdk.AccAddress, poolId, reqId uint64) {
 	if !bytes.HasPrefix(key, WithdrawRequestIndexKeyPrefix) {
@@ -216,6 +456,109 @@ func ParseOrderIndexKey(key []byte) (orderer sdk.AccAddress, pairId, orderId uin
 	return
 }
 
+// ParseDepositRequestByStatusIndexKey parses a deposit request by status index key.
+func ParseDepositRequestByStatusIndexKey(key []byte) (status RequestStatus, poolId, reqId uint64) {
+	if !bytes.HasPrefix(key, DepositRequestByStatusIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	status = RequestStatus(key[1])
+	poolId = sdk.BigEndianToUint64(key[2:10])
+	reqId = sdk.BigEndianToUint64(key[10:])
+	return
+}
+
+// ParseSingleSidedDepositRequestByStatusIndexKey parses a single-sided
+// deposit request by status index key.
+func ParseSingleSidedDepositRequestByStatusIndexKey(key []byte) (status RequestStatus, poolId, reqId uint64) {
+	if !bytes.HasPrefix(key, SingleSidedDepositRequestByStatusIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	status = RequestStatus(key[1])
+	poolId = sdk.BigEndianToUint64(key[2:10])
+	reqId = sdk.BigEndianToUint64(key[10:])
+	return
+}
+
+// ParseWithdrawRequestByStatusIndexKey parses a withdraw request by status index key.
+func ParseWithdrawRequestByStatusIndexKey(key []byte) (status RequestStatus, poolId, reqId uint64) {
+	if !bytes.HasPrefix(key, WithdrawRequestByStatusIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	status = RequestStatus(key[1])
+	poolId = sdk.BigEndianToUint64(key[2:10])
+	reqId = sdk.BigEndianToUint64(key[10:])
+	return
+}
+
+// ParseSwapRequestByStatusIndexKey parses a swap request by status index key.
+func ParseSwapRequestByStatusIndexKey(key []byte) (status RequestStatus, reqId uint64) {
+	if !bytes.HasPrefix(key, SwapRequestByStatusIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	status = RequestStatus(key[1])
+	reqId = sdk.BigEndianToUint64(key[2:])
+	return
+}
+
+// ParseOrderByExpireAtIndexKey parses an order by expiration time index key.
+func ParseOrderByExpireAtIndexKey(key []byte) (expireAt time.Time, pairId, orderId uint64) {
+	if !bytes.HasPrefix(key, OrderByExpireAtIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	timeLen := len(key) - 17
+	expireAt, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		panic(err)
+	}
+	pairId = sdk.BigEndianToUint64(key[1+timeLen : 1+timeLen+8])
+	orderId = sdk.BigEndianToUint64(key[1+timeLen+8:])
+	return
+}
+
+// ParseOrderByStatusIndexKey parses an order by status index key.
+func ParseOrderByStatusIndexKey(key []byte) (status OrderStatus, pairId, orderId uint64) {
+	if !bytes.HasPrefix(key, OrderByStatusIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	status = OrderStatus(key[1])
+	pairId = sdk.BigEndianToUint64(key[2:10])
+	orderId = sdk.BigEndianToUint64(key[10:])
+	return
+}
+
+// ParseOrderByPriceIndexKey parses an order by price index key.
+func ParseOrderByPriceIndexKey(key []byte) (pairId uint64, dir OrderDirection, price sdk.Dec, orderId uint64) {
+	if !bytes.HasPrefix(key, OrderByPriceIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	pairId = sdk.BigEndianToUint64(key[1:9])
+	dir = OrderDirection(key[9])
+	priceLen := key[10]
+	price = sdk.NewDecFromBigIntWithPrec(new(big.Int).SetBytes(key[11:11+priceLen]), sdk.Precision)
+	orderId = sdk.BigEndianToUint64(key[11+priceLen:])
+	return
+}
+
+// ParsePositionIndexKey parses a position index key.
+func ParsePositionIndexKey(key []byte) (owner sdk.AccAddress, poolId, positionId uint64) {
+	if !bytes.HasPrefix(key, PositionIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	addrLen := key[1]
+	owner = key[2 : 2+addrLen]
+	poolId = sdk.BigEndianToUint64(key[2+addrLen : 2+addrLen+8])
+	positionId = sdk.BigEndianToUint64(key[2+addrLen+8:])
+	return
+}
+
 // ParseNumMMOrdersKey parses NumMMOrdersKey.
 func ParseNumMMOrdersKey(key []byte) (ordererAddr sdk.AccAddress, pairId uint64) {
 	if !bytes.HasPrefix(key, NumMMOrdersKeyPrefix) {
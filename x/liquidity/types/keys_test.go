@@ -3,12 +3,15 @@ package types_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto"
 
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/liquidity/amm"
 	"github.com/crescent-network/crescent/v5/x/liquidity/types"
 )
 
@@ -194,6 +197,91 @@ func (s *keysTestSuite) TestOrderIndexKey() {
 	s.Require().Equal(uint64(1), orderId)
 }
 
+func (s *keysTestSuite) TestOrderByExpireAtIndexKey() {
+	expireAt := utils.ParseTime("2022-01-01T00:00:00Z")
+	key := types.GetOrderByExpireAtIndexKey(expireAt, 1, 2)
+	s.Require().True(bytes.HasPrefix(key, types.GetOrdersByExpireAtIndexKeyPrefix(expireAt)))
+	expireAt2, pairId, orderId := types.ParseOrderByExpireAtIndexKey(key)
+	s.Require().True(expireAt.Equal(expireAt2))
+	s.Require().Equal(uint64(1), pairId)
+	s.Require().Equal(uint64(2), orderId)
+
+	// Keys are sorted by expiration time first.
+	s.Require().Equal(-1, bytes.Compare(
+		types.GetOrderByExpireAtIndexKey(expireAt, 2, 1),
+		types.GetOrderByExpireAtIndexKey(expireAt.Add(time.Nanosecond), 1, 1)))
+}
+
+func (s *keysTestSuite) TestOrderByStatusIndexKey() {
+	key := types.GetOrderByStatusIndexKey(types.OrderStatusCompleted, 1, 2)
+	s.Require().Equal([]byte{0xc4, 0x4, 0, 0, 0, 0, 0, 0, 0, 0x1, 0, 0, 0, 0, 0, 0, 0, 0x2}, key)
+	s.Require().True(bytes.HasPrefix(key, types.GetOrdersByStatusIndexKeyPrefix(types.OrderStatusCompleted)))
+	status, pairId, orderId := types.ParseOrderByStatusIndexKey(key)
+	s.Require().Equal(types.OrderStatusCompleted, status)
+	s.Require().Equal(uint64(1), pairId)
+	s.Require().Equal(uint64(2), orderId)
+}
+
+func (s *keysTestSuite) TestOrderByPriceIndexKey() {
+	price := utils.ParseDec("12.345")
+	key := types.GetOrderByPriceIndexKey(1, types.OrderDirectionSell, price, 2)
+	s.Require().True(bytes.HasPrefix(key, types.GetOrdersByPriceIndexKeyPrefix(1, types.OrderDirectionSell)))
+	pairId, dir, price2, orderId := types.ParseOrderByPriceIndexKey(key)
+	s.Require().Equal(uint64(1), pairId)
+	s.Require().Equal(types.OrderDirectionSell, dir)
+	s.Require().True(price.Equal(price2))
+	s.Require().Equal(uint64(2), orderId)
+
+	// Keys are sorted by price first, including prices beyond the range
+	// of sdk.SortableDecBytes.
+	prices := []sdk.Dec{
+		utils.ParseDec("0.000000000000000001"),
+		utils.ParseDec("0.9"),
+		utils.ParseDec("1"),
+		utils.ParseDec("1.000000000000000001"),
+		utils.ParseDec("255"),
+		utils.ParseDec("256"),
+		amm.HighestTick(4),
+	}
+	for i := 1; i < len(prices); i++ {
+		s.Require().Equal(-1, bytes.Compare(
+			types.GetOrderByPriceIndexKey(1, types.OrderDirectionBuy, prices[i-1], 2),
+			types.GetOrderByPriceIndexKey(1, types.OrderDirectionBuy, prices[i], 1)))
+	}
+	_, _, price2, _ = types.ParseOrderByPriceIndexKey(
+		types.GetOrderByPriceIndexKey(1, types.OrderDirectionBuy, amm.HighestTick(4), 1))
+	s.Require().True(amm.HighestTick(4).Equal(price2))
+}
+
+func (s *keysTestSuite) TestRequestByStatusIndexKeys() {
+	key := types.GetDepositRequestByStatusIndexKey(types.RequestStatusSucceeded, 1, 2)
+	s.Require().True(bytes.HasPrefix(key, types.GetDepositRequestsByStatusIndexKeyPrefix(types.RequestStatusSucceeded)))
+	status, poolId, reqId := types.ParseDepositRequestByStatusIndexKey(key)
+	s.Require().Equal(types.RequestStatusSucceeded, status)
+	s.Require().Equal(uint64(1), poolId)
+	s.Require().Equal(uint64(2), reqId)
+
+	key = types.GetSingleSidedDepositRequestByStatusIndexKey(types.RequestStatusFailed, 3, 4)
+	s.Require().True(bytes.HasPrefix(key, types.GetSingleSidedDepositRequestsByStatusIndexKeyPrefix(types.RequestStatusFailed)))
+	status, poolId, reqId = types.ParseSingleSidedDepositRequestByStatusIndexKey(key)
+	s.Require().Equal(types.RequestStatusFailed, status)
+	s.Require().Equal(uint64(3), poolId)
+	s.Require().Equal(uint64(4), reqId)
+
+	key = types.GetWithdrawRequestByStatusIndexKey(types.RequestStatusNotExecuted, 5, 6)
+	s.Require().True(bytes.HasPrefix(key, types.GetWithdrawRequestsByStatusIndexKeyPrefix(types.RequestStatusNotExecuted)))
+	status, poolId, reqId = types.ParseWithdrawRequestByStatusIndexKey(key)
+	s.Require().Equal(types.RequestStatusNotExecuted, status)
+	s.Require().Equal(uint64(5), poolId)
+	s.Require().Equal(uint64(6), reqId)
+
+	key = types.GetSwapRequestByStatusIndexKey(types.RequestStatusSucceeded, 7)
+	s.Require().True(bytes.HasPrefix(key, types.GetSwapRequestsByStatusIndexKeyPrefix(types.RequestStatusSucceeded)))
+	status, reqId = types.ParseSwapRequestByStatusIndexKey(key)
+	s.Require().Equal(types.RequestStatusSucceeded, status)
+	s.Require().Equal(uint64(7), reqId)
+}
+
 func (s *keysTestSuite) TestNumMMOrdersKey() {
 	orderer := sdk.AccAddress(crypto.AddressHash([]byte("orderer")))
 	key := types.GetNumMMOrdersKey(orderer, 1)
@@ -201,3 +289,19 @@ func (s *keysTestSuite) TestNumMMOrdersKey() {
 		0x5c, 0xbc, 0x50, 0xf2, 0x85, 0xf7, 0x7d, 0xff, 0x52, 0x9f, 0x25, 0x0, 0x0, 0x0, 0x0,
 		0x0, 0x0, 0x0, 0x1}, key)
 }
+
+func (s *keysTestSuite) TestGetPositionKey() {
+	s.Require().Equal([]byte{0xb9, 0, 0, 0, 0, 0, 0, 0, 0x1, 0, 0,
+		0, 0, 0, 0, 0, 0x1}, types.GetPositionKey(1, 1))
+	s.Require().Equal([]byte{0xb9, 0, 0, 0, 0, 0, 0, 0, 0x1}, types.GetPositionsByPoolKeyPrefix(1))
+}
+
+func (s *keysTestSuite) TestPositionIndexKey() {
+	owner := sdk.AccAddress(crypto.AddressHash([]byte("owner")))
+	key := types.GetPositionIndexKey(owner, 1, 2)
+	s.Require().True(bytes.HasPrefix(key, types.GetPositionIndexKeyPrefix(owner)))
+	owner2, poolId, positionId := types.ParsePositionIndexKey(key)
+	s.Require().Equal(owner, owner2)
+	s.Require().Equal(uint64(1), poolId)
+	s.Require().Equal(uint64(2), positionId)
+}
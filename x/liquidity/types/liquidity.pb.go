@@ -12,6 +12,7 @@ import (
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -40,18 +41,30 @@ const (
 	PoolTypeBasic PoolType = 1
 	// POOL_TYPE_RANGED specifies the ranged pool type
 	PoolTypeRanged PoolType = 2
+	// POOL_TYPE_STABLE specifies the stable pool type
+	PoolTypeStable PoolType = 3
+	// POOL_TYPE_WEIGHTED specifies the weighted pool type
+	PoolTypeWeighted PoolType = 4
+	// POOL_TYPE_CONCENTRATED specifies the concentrated liquidity pool type
+	PoolTypeConcentrated PoolType = 5
 )
 
 var PoolType_name = map[int32]string{
 	0: "POOL_TYPE_UNSPECIFIED",
 	1: "POOL_TYPE_BASIC",
 	2: "POOL_TYPE_RANGED",
+	3: "POOL_TYPE_STABLE",
+	4: "POOL_TYPE_WEIGHTED",
+	5: "POOL_TYPE_CONCENTRATED",
 }
 
 var PoolType_value = map[string]int32{
-	"POOL_TYPE_UNSPECIFIED": 0,
-	"POOL_TYPE_BASIC":       1,
-	"POOL_TYPE_RANGED":      2,
+	"POOL_TYPE_UNSPECIFIED":  0,
+	"POOL_TYPE_BASIC":        1,
+	"POOL_TYPE_RANGED":       2,
+	"POOL_TYPE_STABLE":       3,
+	"POOL_TYPE_WEIGHTED":     4,
+	"POOL_TYPE_CONCENTRATED": 5,
 }
 
 func (x PoolType) String() string {
@@ -74,6 +87,8 @@ const (
 	OrderTypeMarket OrderType = 2
 	// ORDER_TYPE_MM specifies MM(market making) order type.
 	OrderTypeMM OrderType = 3
+	// ORDER_TYPE_TRIGGER specifies trigger order type, which is not triggered yet.
+	OrderTypeTrigger OrderType = 4
 )
 
 var OrderType_name = map[int32]string{
@@ -81,6 +96,7 @@ var OrderType_name = map[int32]string{
 	1: "ORDER_TYPE_LIMIT",
 	2: "ORDER_TYPE_MARKET",
 	3: "ORDER_TYPE_MM",
+	4: "ORDER_TYPE_TRIGGER",
 }
 
 var OrderType_value = map[string]int32{
@@ -88,6 +104,7 @@ var OrderType_value = map[string]int32{
 	"ORDER_TYPE_LIMIT":       1,
 	"ORDER_TYPE_MARKET":      2,
 	"ORDER_TYPE_MM":          3,
+	"ORDER_TYPE_TRIGGER":     4,
 }
 
 func (x OrderType) String() string {
@@ -98,6 +115,75 @@ func (OrderType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_c9be4f53a63dce2f, []int{1}
 }
 
+// TimeInForce enumerates time in force policies of orders.
+type TimeInForce int32
+
+const (
+	// TIME_IN_FORCE_UNSPECIFIED specifies the default policy, where the order
+	// remains in the order book until it is completed, canceled or expired
+	TimeInForceUnspecified TimeInForce = 0
+	// TIME_IN_FORCE_IMMEDIATE_OR_CANCEL specifies that the unmatched part of
+	// the order is canceled right after the batch
+	TimeInForceImmediateOrCancel TimeInForce = 1
+	// TIME_IN_FORCE_FILL_OR_KILL specifies that the order is matched only if
+	// the whole amount can be matched in the batch, otherwise the order is canceled
+	TimeInForceFillOrKill TimeInForce = 2
+)
+
+var TimeInForce_name = map[int32]string{
+	0: "TIME_IN_FORCE_UNSPECIFIED",
+	1: "TIME_IN_FORCE_IMMEDIATE_OR_CANCEL",
+	2: "TIME_IN_FORCE_FILL_OR_KILL",
+}
+
+var TimeInForce_value = map[string]int32{
+	"TIME_IN_FORCE_UNSPECIFIED":         0,
+	"TIME_IN_FORCE_IMMEDIATE_OR_CANCEL": 1,
+	"TIME_IN_FORCE_FILL_OR_KILL":        2,
+}
+
+func (x TimeInForce) String() string {
+	return proto.EnumName(TimeInForce_name, int32(x))
+}
+
+func (TimeInForce) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{2}
+}
+
+// TriggerCondition enumerates trigger conditions of trigger orders.
+type TriggerCondition int32
+
+const (
+	// TRIGGER_CONDITION_UNSPECIFIED specifies unknown trigger condition
+	TriggerConditionUnspecified TriggerCondition = 0
+	// TRIGGER_CONDITION_GTE specifies that the order is triggered when
+	// the last price is greater than or equal to the trigger price
+	TriggerConditionGTE TriggerCondition = 1
+	// TRIGGER_CONDITION_LTE specifies that the order is triggered when
+	// the last price is less than or equal to the trigger price
+	TriggerConditionLTE TriggerCondition = 2
+)
+
+var TriggerCondition_name = map[int32]string{
+	0: "TRIGGER_CONDITION_UNSPECIFIED",
+	1: "TRIGGER_CONDITION_GTE",
+	2: "TRIGGER_CONDITION_LTE",
+}
+
+var TriggerCondition_value = map[string]int32{
+	"TRIGGER_CONDITION_UNSPECIFIED": 0,
+	"TRIGGER_CONDITION_GTE":         1,
+	"TRIGGER_CONDITION_LTE":         2,
+}
+
+func (x TriggerCondition) String() string {
+	return proto.EnumName(TriggerCondition_name, int32(x))
+}
+
+func (TriggerCondition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{3}
+}
+
 // OrderDirection enumerates order directions.
 type OrderDirection int32
 
@@ -127,7 +213,7 @@ func (x OrderDirection) String() string {
 }
 
 func (OrderDirection) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c9be4f53a63dce2f, []int{2}
+	return fileDescriptor_c9be4f53a63dce2f, []int{4}
 }
 
 // RequestStatus enumerates request statuses.
@@ -163,7 +249,7 @@ func (x RequestStatus) String() string {
 }
 
 func (RequestStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c9be4f53a63dce2f, []int{3}
+	return fileDescriptor_c9be4f53a63dce2f, []int{5}
 }
 
 // OrderStatus enumerates order statuses.
@@ -211,7 +297,38 @@ func (x OrderStatus) String() string {
 }
 
 func (OrderStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c9be4f53a63dce2f, []int{4}
+	return fileDescriptor_c9be4f53a63dce2f, []int{6}
+}
+
+// AllocationPolicy enumerates the policies of allocating the matched amount
+// to the orders at the same price.
+type AllocationPolicy int32
+
+const (
+	// ALLOCATION_POLICY_PRIORITY allocates the amount to the orders from earlier
+	// batches first; this is the default policy
+	AllocationPolicyPriority AllocationPolicy = 0
+	// ALLOCATION_POLICY_PRO_RATA allocates the amount to the orders proportional
+	// to their open amounts
+	AllocationPolicyProRata AllocationPolicy = 1
+)
+
+var AllocationPolicy_name = map[int32]string{
+	0: "ALLOCATION_POLICY_PRIORITY",
+	1: "ALLOCATION_POLICY_PRO_RATA",
+}
+
+var AllocationPolicy_value = map[string]int32{
+	"ALLOCATION_POLICY_PRIORITY": 0,
+	"ALLOCATION_POLICY_PRO_RATA": 1,
+}
+
+func (x AllocationPolicy) String() string {
+	return proto.EnumName(AllocationPolicy_name, int32(x))
+}
+
+func (AllocationPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{7}
 }
 
 // Params defines the parameters for the liquidity module.
@@ -234,6 +351,9 @@ type Params struct {
 	WithdrawExtraGas                github_com_cosmos_cosmos_sdk_types.Gas   `protobuf:"varint,16,opt,name=withdraw_extra_gas,json=withdrawExtraGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"withdraw_extra_gas"`
 	OrderExtraGas                   github_com_cosmos_cosmos_sdk_types.Gas   `protobuf:"varint,17,opt,name=order_extra_gas,json=orderExtraGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"order_extra_gas"`
 	MaxNumActivePoolsPerPair        uint32                                   `protobuf:"varint,18,opt,name=max_num_active_pools_per_pair,json=maxNumActivePoolsPerPair,proto3" json:"max_num_active_pools_per_pair,omitempty"`
+	MaxNumTWAPSnapshots             uint32                                   `protobuf:"varint,19,opt,name=max_num_twap_snapshots,json=maxNumTwapSnapshots,proto3" json:"max_num_twap_snapshots,omitempty"`
+	CandleIntervals                 []time.Duration                          `protobuf:"bytes,20,rep,name=candle_intervals,json=candleIntervals,proto3,stdduration" json:"candle_intervals"`
+	MaxNumCandles                   uint32                                   `protobuf:"varint,21,opt,name=max_num_candles,json=maxNumCandles,proto3" json:"max_num_candles,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -271,13 +391,33 @@ var xxx_messageInfo_Params proto.InternalMessageInfo
 
 // Pair defines a coin pair.
 type Pair struct {
-	Id             uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BaseCoinDenom  string                                  `protobuf:"bytes,2,opt,name=base_coin_denom,json=baseCoinDenom,proto3" json:"base_coin_denom,omitempty"`
-	QuoteCoinDenom string                                  `protobuf:"bytes,3,opt,name=quote_coin_denom,json=quoteCoinDenom,proto3" json:"quote_coin_denom,omitempty"`
-	EscrowAddress  string                                  `protobuf:"bytes,4,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
-	LastOrderId    uint64                                  `protobuf:"varint,5,opt,name=last_order_id,json=lastOrderId,proto3" json:"last_order_id,omitempty"`
-	LastPrice      *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price,omitempty"`
-	CurrentBatchId uint64                                  `protobuf:"varint,7,opt,name=current_batch_id,json=currentBatchId,proto3" json:"current_batch_id,omitempty"`
+	Id               uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BaseCoinDenom    string                                  `protobuf:"bytes,2,opt,name=base_coin_denom,json=baseCoinDenom,proto3" json:"base_coin_denom,omitempty"`
+	QuoteCoinDenom   string                                  `protobuf:"bytes,3,opt,name=quote_coin_denom,json=quoteCoinDenom,proto3" json:"quote_coin_denom,omitempty"`
+	EscrowAddress    string                                  `protobuf:"bytes,4,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
+	LastOrderId      uint64                                  `protobuf:"varint,5,opt,name=last_order_id,json=lastOrderId,proto3" json:"last_order_id,omitempty"`
+	LastPrice        *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price,omitempty"`
+	CurrentBatchId   uint64                                  `protobuf:"varint,7,opt,name=current_batch_id,json=currentBatchId,proto3" json:"current_batch_id,omitempty"`
+	AllocationPolicy AllocationPolicy                        `protobuf:"varint,8,opt,name=allocation_policy,json=allocationPolicy,proto3,enum=crescent.liquidity.v1beta1.AllocationPolicy" json:"allocation_policy,omitempty"`
+	// maker_fee_rate specifies the fee rate applied to the orders which have
+	// been resting on the order book since earlier batches; no fee is charged
+	// if not set
+	MakerFeeRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate,omitempty"`
+	// taker_fee_rate specifies the fee rate applied to the orders which are
+	// matched in the same batch they were placed; no fee is charged if not set
+	TakerFeeRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate,omitempty"`
+	// deposits_paused specifies whether deposits to the pools of the pair are
+	// paused by governance
+	DepositsPaused bool `protobuf:"varint,11,opt,name=deposits_paused,json=depositsPaused,proto3" json:"deposits_paused,omitempty"`
+	// orders_paused specifies whether placing orders and matching in the pair
+	// are paused by governance
+	OrdersPaused bool `protobuf:"varint,12,opt,name=orders_paused,json=ordersPaused,proto3" json:"orders_paused,omitempty"`
+	// tick_precision specifies the tick precision of the pair; the
+	// tick_precision param is used if not set
+	TickPrecision *uint32 `protobuf:"bytes,13,opt,name=tick_precision,json=tickPrecision,proto3,wktptr" json:"tick_precision,omitempty"`
+	// min_order_notional specifies the minimum quote coin amount of an order
+	// placed in the pair; only the module-wide minimum is applied if not set
+	MinOrderNotional *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=min_order_notional,json=minOrderNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_order_notional,omitempty"`
 }
 
 func (m *Pair) Reset()         { *m = Pair{} }
@@ -313,8 +453,8 @@ func (m *Pair) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pair proto.InternalMessageInfo
 
-// Pool defines generic liquidity pool object which can be either a basic pool or a
-// ranged pool.
+// Pool defines generic liquidity pool object which can be either a basic pool, a
+// ranged pool, a stable pool, a weighted pool or a concentrated pool.
 type Pool struct {
 	Type                  PoolType                                `protobuf:"varint,1,opt,name=type,proto3,enum=crescent.liquidity.v1beta1.PoolType" json:"type,omitempty"`
 	Id                    uint64                                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -327,6 +467,28 @@ type Pool struct {
 	LastDepositRequestId  uint64                                  `protobuf:"varint,9,opt,name=last_deposit_request_id,json=lastDepositRequestId,proto3" json:"last_deposit_request_id,omitempty"`
 	LastWithdrawRequestId uint64                                  `protobuf:"varint,10,opt,name=last_withdraw_request_id,json=lastWithdrawRequestId,proto3" json:"last_withdraw_request_id,omitempty"`
 	Disabled              bool                                    `protobuf:"varint,11,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// amplification specifies the amplification coefficient of a stable pool
+	Amplification uint32 `protobuf:"varint,12,opt,name=amplification,proto3" json:"amplification,omitempty"`
+	// base_coin_weight specifies the weight of the base coin of a weighted pool
+	BaseCoinWeight uint32 `protobuf:"varint,13,opt,name=base_coin_weight,json=baseCoinWeight,proto3" json:"base_coin_weight,omitempty"`
+	// quote_coin_weight specifies the weight of the quote coin of a weighted pool
+	QuoteCoinWeight uint32 `protobuf:"varint,14,opt,name=quote_coin_weight,json=quoteCoinWeight,proto3" json:"quote_coin_weight,omitempty"`
+	// price specifies the current price of a concentrated pool
+	Price *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price,omitempty"`
+	// liquidity_ticks specifies the ticks of a concentrated pool's liquidity curve,
+	// sorted by price
+	LiquidityTicks []LiquidityTick `protobuf:"bytes,16,rep,name=liquidity_ticks,json=liquidityTicks,proto3" json:"liquidity_ticks"`
+	// last_position_id specifies the id of the last position in a concentrated pool
+	LastPositionId uint64 `protobuf:"varint,17,opt,name=last_position_id,json=lastPositionId,proto3" json:"last_position_id,omitempty"`
+	// deposits_paused specifies whether deposits to the pool are paused by
+	// governance
+	DepositsPaused bool `protobuf:"varint,18,opt,name=deposits_paused,json=depositsPaused,proto3" json:"deposits_paused,omitempty"`
+	// orders_paused specifies whether the pool is excluded from matching by
+	// governance
+	OrdersPaused bool `protobuf:"varint,19,opt,name=orders_paused,json=ordersPaused,proto3" json:"orders_paused,omitempty"`
+	// migrated_to_pool_id specifies the id of the pool which the pool's
+	// liquidity has been migrated to by governance; 0 if not migrated
+	MigratedToPoolId uint64 `protobuf:"varint,20,opt,name=migrated_to_pool_id,json=migratedToPoolId,proto3" json:"migrated_to_pool_id,omitempty"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -362,6 +524,313 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// LiquidityTick defines a price at which the liquidity of a concentrated pool changes.
+type LiquidityTick struct {
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// liquidity_net specifies the liquidity added when the price crosses the tick upward
+	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net"`
+}
+
+func (m *LiquidityTick) Reset()         { *m = LiquidityTick{} }
+func (m *LiquidityTick) String() string { return proto.CompactTextString(m) }
+func (*LiquidityTick) ProtoMessage()    {}
+func (*LiquidityTick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{3}
+}
+func (m *LiquidityTick) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityTick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityTick.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityTick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityTick.Merge(m, src)
+}
+func (m *LiquidityTick) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityTick) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityTick.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityTick proto.InternalMessageInfo
+
+// Position defines a concentrated liquidity position owned by a liquidity provider.
+type Position struct {
+	Id         uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PoolId     uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Owner      string                                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	LowerPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=lower_price,json=lowerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lower_price"`
+	UpperPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=upper_price,json=upperPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upper_price"`
+	Liquidity  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity"`
+	// fees specifies the fees accrued to the position, which are not collected yet
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{4}
+}
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+// PoolAccrual defines the cumulative trading volume and fees of a pool.
+// Both are denominated in the quote coin of the pair.
+type PoolAccrual struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// volume specifies the cumulative amount of quote coin the pool traded
+	Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume"`
+	// fees specifies the cumulative amount of fees the pool earned from spreads, in quote coin
+	Fees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=fees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fees"`
+}
+
+func (m *PoolAccrual) Reset()         { *m = PoolAccrual{} }
+func (m *PoolAccrual) String() string { return proto.CompactTextString(m) }
+func (*PoolAccrual) ProtoMessage()    {}
+func (*PoolAccrual) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{5}
+}
+func (m *PoolAccrual) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolAccrual) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolAccrual.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolAccrual) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolAccrual.Merge(m, src)
+}
+func (m *PoolAccrual) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolAccrual) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolAccrual.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolAccrual proto.InternalMessageInfo
+
+// PoolAccrualSnapshot defines a snapshot of a pool's accrual taken periodically.
+type PoolAccrualSnapshot struct {
+	PoolId uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Time   time.Time                              `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume"`
+	Fees   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=fees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fees"`
+}
+
+func (m *PoolAccrualSnapshot) Reset()         { *m = PoolAccrualSnapshot{} }
+func (m *PoolAccrualSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PoolAccrualSnapshot) ProtoMessage()    {}
+func (*PoolAccrualSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{6}
+}
+func (m *PoolAccrualSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolAccrualSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolAccrualSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolAccrualSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolAccrualSnapshot.Merge(m, src)
+}
+func (m *PoolAccrualSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolAccrualSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolAccrualSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolAccrualSnapshot proto.InternalMessageInfo
+
+// TWAPAccumulator defines the cumulative price of a pair, which is the sum of
+// the pair's last price multiplied by the seconds it lasted.
+type TWAPAccumulator struct {
+	PairId          uint64                                 `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price"`
+	// last_price specifies the pair's last price at the last update
+	LastPrice     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price"`
+	LastUpdatedAt time.Time                              `protobuf:"bytes,4,opt,name=last_updated_at,json=lastUpdatedAt,proto3,stdtime" json:"last_updated_at"`
+	// last_snapshot_seq specifies the sequence of the latest snapshot
+	LastSnapshotSeq uint64 `protobuf:"varint,5,opt,name=last_snapshot_seq,json=lastSnapshotSeq,proto3" json:"last_snapshot_seq,omitempty"`
+}
+
+func (m *TWAPAccumulator) Reset()         { *m = TWAPAccumulator{} }
+func (m *TWAPAccumulator) String() string { return proto.CompactTextString(m) }
+func (*TWAPAccumulator) ProtoMessage()    {}
+func (*TWAPAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{7}
+}
+func (m *TWAPAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TWAPAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TWAPAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TWAPAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TWAPAccumulator.Merge(m, src)
+}
+func (m *TWAPAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *TWAPAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TWAPAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TWAPAccumulator proto.InternalMessageInfo
+
+// TWAPSnapshot defines a snapshot of a pair's cumulative price taken
+// periodically.
+type TWAPSnapshot struct {
+	PairId          uint64                                 `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Seq             uint64                                 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Time            time.Time                              `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price"`
+}
+
+func (m *TWAPSnapshot) Reset()         { *m = TWAPSnapshot{} }
+func (m *TWAPSnapshot) String() string { return proto.CompactTextString(m) }
+func (*TWAPSnapshot) ProtoMessage()    {}
+func (*TWAPSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{8}
+}
+func (m *TWAPSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TWAPSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TWAPSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TWAPSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TWAPSnapshot.Merge(m, src)
+}
+func (m *TWAPSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *TWAPSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_TWAPSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TWAPSnapshot proto.InternalMessageInfo
+
+// Candle defines an OHLCV(open, high, low, close and volume) aggregate of a
+// pair's match prices and matched amounts during an interval.
+type Candle struct {
+	PairId   uint64        `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Interval time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval"`
+	// open_time specifies the start time of the candle, which is a multiple of
+	// the interval
+	OpenTime time.Time                              `protobuf:"bytes,3,opt,name=open_time,json=openTime,proto3,stdtime" json:"open_time"`
+	Open     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=open,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open"`
+	High     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=high,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high"`
+	Low      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=low,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low"`
+	Close    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=close,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close"`
+	// base_volume specifies the matched amount of base coin
+	BaseVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=base_volume,json=baseVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_volume"`
+	// quote_volume specifies the matched amount of quote coin
+	QuoteVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=quote_volume,json=quoteVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_volume"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{9}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
 // DepositRequest defines a deposit request.
 type DepositRequest struct {
 	// id specifies the id for the request
@@ -384,7 +853,7 @@ func (m *DepositRequest) Reset()         { *m = DepositRequest{} }
 func (m *DepositRequest) String() string { return proto.CompactTextString(m) }
 func (*DepositRequest) ProtoMessage()    {}
 func (*DepositRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9be4f53a63dce2f, []int{3}
+	return fileDescriptor_c9be4f53a63dce2f, []int{10}
 }
 func (m *DepositRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,6 +883,66 @@ func (m *DepositRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_DepositRequest proto.InternalMessageInfo
 
 // WithdrawRequest defines a withdraw request.
+// SingleSidedDepositRequest defines a single-sided deposit request.
+type SingleSidedDepositRequest struct {
+	// id specifies the id for the request.
+	// It shares the sequence with the pool's deposit requests.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pool_id specifies the pool id
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// msg_height specifies the block height when the request is stored for the batch execution
+	MsgHeight int64 `protobuf:"varint,3,opt,name=msg_height,json=msgHeight,proto3" json:"msg_height,omitempty"`
+	// depositor specifies the bech32-encoded address that makes a deposit to the pool
+	Depositor string `protobuf:"bytes,4,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// deposit_coin specifies the amount of coin to deposit
+	DepositCoin types.Coin `protobuf:"bytes,5,opt,name=deposit_coin,json=depositCoin,proto3" json:"deposit_coin"`
+	// min_minted_pool_coin specifies the minimum amount of pool coin the depositor wants to receive
+	MinMintedPoolCoin types.Coin `protobuf:"bytes,6,opt,name=min_minted_pool_coin,json=minMintedPoolCoin,proto3" json:"min_minted_pool_coin"`
+	// swapped_coin specifies the amount of the deposit coin swapped against the pool
+	SwappedCoin types.Coin `protobuf:"bytes,7,opt,name=swapped_coin,json=swappedCoin,proto3" json:"swapped_coin"`
+	// received_coin specifies the amount of coin received from the swap
+	ReceivedCoin types.Coin `protobuf:"bytes,8,opt,name=received_coin,json=receivedCoin,proto3" json:"received_coin"`
+	// accepted_coins specifies the amount of coins that are accepted
+	AcceptedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=accepted_coins,json=acceptedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accepted_coins"`
+	// refunded_coins specifies the amount of coins refunded to the depositor
+	RefundedCoins  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=refunded_coins,json=refundedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_coins"`
+	MintedPoolCoin types.Coin                               `protobuf:"bytes,11,opt,name=minted_pool_coin,json=mintedPoolCoin,proto3" json:"minted_pool_coin"`
+	Status         RequestStatus                            `protobuf:"varint,12,opt,name=status,proto3,enum=crescent.liquidity.v1beta1.RequestStatus" json:"status,omitempty"`
+}
+
+func (m *SingleSidedDepositRequest) Reset()         { *m = SingleSidedDepositRequest{} }
+func (m *SingleSidedDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*SingleSidedDepositRequest) ProtoMessage()    {}
+func (*SingleSidedDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{11}
+}
+func (m *SingleSidedDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SingleSidedDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SingleSidedDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SingleSidedDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleSidedDepositRequest.Merge(m, src)
+}
+func (m *SingleSidedDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SingleSidedDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleSidedDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleSidedDepositRequest proto.InternalMessageInfo
+
 type WithdrawRequest struct {
 	// id specifies the id for the request
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -428,13 +957,16 @@ type WithdrawRequest struct {
 	// withdrawn_coins specifies the amount of coins that are withdrawn.
 	WithdrawnCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=withdrawn_coins,json=withdrawnCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn_coins"`
 	Status         RequestStatus                            `protobuf:"varint,7,opt,name=status,proto3,enum=crescent.liquidity.v1beta1.RequestStatus" json:"status,omitempty"`
+	// single_sided specifies the details of the single-sided withdrawal.
+	// It is set only for the requests made by MsgWithdrawSingleSided.
+	SingleSided *SingleSidedWithdrawal `protobuf:"bytes,8,opt,name=single_sided,json=singleSided,proto3" json:"single_sided,omitempty"`
 }
 
 func (m *WithdrawRequest) Reset()         { *m = WithdrawRequest{} }
 func (m *WithdrawRequest) String() string { return proto.CompactTextString(m) }
 func (*WithdrawRequest) ProtoMessage()    {}
 func (*WithdrawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9be4f53a63dce2f, []int{4}
+	return fileDescriptor_c9be4f53a63dce2f, []int{12}
 }
 func (m *WithdrawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -463,42 +995,154 @@ func (m *WithdrawRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WithdrawRequest proto.InternalMessageInfo
 
-// Order defines an order.
-type Order struct {
-	// type specifies the typo of the order
-	Type OrderType `protobuf:"varint,1,opt,name=type,proto3,enum=crescent.liquidity.v1beta1.OrderType" json:"type,omitempty"`
-	// id specifies the id of the order
-	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	// pair_id specifies the pair id
-	PairId uint64 `protobuf:"varint,3,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
-	// msg_height specifies the block height when the order is stored for the batch execution
-	MsgHeight int64 `protobuf:"varint,4,opt,name=msg_height,json=msgHeight,proto3" json:"msg_height,omitempty"`
-	// orderer specifies the bech32-encoded address that makes an order
-	Orderer string `protobuf:"bytes,5,opt,name=orderer,proto3" json:"orderer,omitempty"`
-	// direction specifies the order direction; either buy or sell
-	Direction OrderDirection `protobuf:"varint,6,opt,name=direction,proto3,enum=crescent.liquidity.v1beta1.OrderDirection" json:"direction,omitempty"`
-	OfferCoin types.Coin     `protobuf:"bytes,7,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin"`
-	// remaining_offer_coin specifies the remaining offer coin
-	RemainingOfferCoin types.Coin `protobuf:"bytes,8,opt,name=remaining_offer_coin,json=remainingOfferCoin,proto3" json:"remaining_offer_coin"`
-	// received_coin specifies the received coin after the swap
-	ReceivedCoin types.Coin `protobuf:"bytes,9,opt,name=received_coin,json=receivedCoin,proto3" json:"received_coin"`
-	// price specifies the price that an orderer is willing to swap
-	Price      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
-	Amount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
-	OpenAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=open_amount,json=openAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"open_amount"`
-	// batch_id specifies the pair's batch id when the request is stored
-	BatchId  uint64      `protobuf:"varint,13,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
-	ExpireAt time.Time   `protobuf:"bytes,14,opt,name=expire_at,json=expireAt,proto3,stdtime" json:"expire_at"`
-	Status   OrderStatus `protobuf:"varint,15,opt,name=status,proto3,enum=crescent.liquidity.v1beta1.OrderStatus" json:"status,omitempty"`
+// SingleSidedWithdrawal defines the details of a single-sided withdrawal,
+// which swaps the unwanted coin withdrawn from the pool through the pair.
+type SingleSidedWithdrawal struct {
+	// min_withdrawn_coin specifies the minimum amount of coin the withdrawer wants to receive
+	MinWithdrawnCoin types.Coin `protobuf:"bytes,1,opt,name=min_withdrawn_coin,json=minWithdrawnCoin,proto3" json:"min_withdrawn_coin"`
+	// swapped_coin specifies the amount of withdrawn coin swapped through the pair
+	SwappedCoin types.Coin `protobuf:"bytes,2,opt,name=swapped_coin,json=swappedCoin,proto3" json:"swapped_coin"`
+	// received_coin specifies the amount of coin received from the swap
+	ReceivedCoin types.Coin `protobuf:"bytes,3,opt,name=received_coin,json=receivedCoin,proto3" json:"received_coin"`
 }
 
-func (m *Order) Reset()         { *m = Order{} }
-func (m *Order) String() string { return proto.CompactTextString(m) }
-func (*Order) ProtoMessage()    {}
-func (*Order) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9be4f53a63dce2f, []int{5}
+func (m *SingleSidedWithdrawal) Reset()         { *m = SingleSidedWithdrawal{} }
+func (m *SingleSidedWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*SingleSidedWithdrawal) ProtoMessage()    {}
+func (*SingleSidedWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{13}
 }
-func (m *Order) XXX_Unmarshal(b []byte) error {
+func (m *SingleSidedWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SingleSidedWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SingleSidedWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SingleSidedWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SingleSidedWithdrawal.Merge(m, src)
+}
+func (m *SingleSidedWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SingleSidedWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SingleSidedWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SingleSidedWithdrawal proto.InternalMessageInfo
+
+// SwapRequest defines a multi-hop swap request.
+type SwapRequest struct {
+	// id specifies the id for the request
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// msg_height specifies the block height when the request is stored for the batch execution
+	MsgHeight int64 `protobuf:"varint,2,opt,name=msg_height,json=msgHeight,proto3" json:"msg_height,omitempty"`
+	// orderer specifies the bech32-encoded address that makes the swap
+	Orderer string `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	// pair_ids specifies the route of the swap, in order
+	PairIds []uint64 `protobuf:"varint,4,rep,packed,name=pair_ids,json=pairIds,proto3" json:"pair_ids,omitempty"`
+	// offer_coin specifies the amount of coin the orderer offers
+	OfferCoin types.Coin `protobuf:"bytes,5,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin"`
+	// min_out_coin specifies the minimum amount of coin the orderer wants to receive
+	MinOutCoin types.Coin `protobuf:"bytes,6,opt,name=min_out_coin,json=minOutCoin,proto3" json:"min_out_coin"`
+	// out_coin specifies the amount of coin the orderer received
+	OutCoin types.Coin `protobuf:"bytes,7,opt,name=out_coin,json=outCoin,proto3" json:"out_coin"`
+	// refunded_coins specifies the amount of coins which were not used while
+	// swapping through the route and refunded to the orderer
+	RefundedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=refunded_coins,json=refundedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_coins"`
+	Status        RequestStatus                            `protobuf:"varint,9,opt,name=status,proto3,enum=crescent.liquidity.v1beta1.RequestStatus" json:"status,omitempty"`
+}
+
+func (m *SwapRequest) Reset()         { *m = SwapRequest{} }
+func (m *SwapRequest) String() string { return proto.CompactTextString(m) }
+func (*SwapRequest) ProtoMessage()    {}
+func (*SwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{14}
+}
+func (m *SwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRequest.Merge(m, src)
+}
+func (m *SwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapRequest proto.InternalMessageInfo
+
+// Order defines an order.
+type Order struct {
+	// type specifies the typo of the order
+	Type OrderType `protobuf:"varint,1,opt,name=type,proto3,enum=crescent.liquidity.v1beta1.OrderType" json:"type,omitempty"`
+	// id specifies the id of the order
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// pair_id specifies the pair id
+	PairId uint64 `protobuf:"varint,3,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// msg_height specifies the block height when the order is stored for the batch execution
+	MsgHeight int64 `protobuf:"varint,4,opt,name=msg_height,json=msgHeight,proto3" json:"msg_height,omitempty"`
+	// orderer specifies the bech32-encoded address that makes an order
+	Orderer string `protobuf:"bytes,5,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	// direction specifies the order direction; either buy or sell
+	Direction OrderDirection `protobuf:"varint,6,opt,name=direction,proto3,enum=crescent.liquidity.v1beta1.OrderDirection" json:"direction,omitempty"`
+	OfferCoin types.Coin     `protobuf:"bytes,7,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin"`
+	// remaining_offer_coin specifies the remaining offer coin
+	RemainingOfferCoin types.Coin `protobuf:"bytes,8,opt,name=remaining_offer_coin,json=remainingOfferCoin,proto3" json:"remaining_offer_coin"`
+	// received_coin specifies the received coin after the swap
+	ReceivedCoin types.Coin `protobuf:"bytes,9,opt,name=received_coin,json=receivedCoin,proto3" json:"received_coin"`
+	// price specifies the price that an orderer is willing to swap
+	Price      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Amount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	OpenAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=open_amount,json=openAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"open_amount"`
+	// batch_id specifies the pair's batch id when the request is stored
+	BatchId  uint64      `protobuf:"varint,13,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	ExpireAt time.Time   `protobuf:"bytes,14,opt,name=expire_at,json=expireAt,proto3,stdtime" json:"expire_at"`
+	Status   OrderStatus `protobuf:"varint,15,opt,name=status,proto3,enum=crescent.liquidity.v1beta1.OrderStatus" json:"status,omitempty"`
+	// trigger_price specifies the price at which the trigger order is triggered
+	TriggerPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price,omitempty"`
+	// trigger_condition specifies the condition on which the trigger order is triggered
+	TriggerCondition TriggerCondition `protobuf:"varint,17,opt,name=trigger_condition,json=triggerCondition,proto3,enum=crescent.liquidity.v1beta1.TriggerCondition" json:"trigger_condition,omitempty"`
+	// triggered_order_type specifies the order type after the trigger order is triggered;
+	// either limit or market
+	TriggeredOrderType OrderType `protobuf:"varint,18,opt,name=triggered_order_type,json=triggeredOrderType,proto3,enum=crescent.liquidity.v1beta1.OrderType" json:"triggered_order_type,omitempty"`
+	// time_in_force specifies how long the order remains in the order book
+	TimeInForce TimeInForce `protobuf:"varint,19,opt,name=time_in_force,json=timeInForce,proto3,enum=crescent.liquidity.v1beta1.TimeInForce" json:"time_in_force,omitempty"`
+	// post_only specifies whether the order was placed only to rest on the order book
+	PostOnly bool `protobuf:"varint,20,opt,name=post_only,json=postOnly,proto3" json:"post_only,omitempty"`
+	// fee specifies the fee paid from the demand coin received so far
+	Fee types.Coin `protobuf:"bytes,21,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *Order) Reset()         { *m = Order{} }
+func (m *Order) String() string { return proto.CompactTextString(m) }
+func (*Order) ProtoMessage()    {}
+func (*Order) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9be4f53a63dce2f, []int{15}
+}
+func (m *Order) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 func (m *Order) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
@@ -528,14 +1172,27 @@ var xxx_messageInfo_Order proto.InternalMessageInfo
 func init() {
 	proto.RegisterEnum("crescent.liquidity.v1beta1.PoolType", PoolType_name, PoolType_value)
 	proto.RegisterEnum("crescent.liquidity.v1beta1.OrderType", OrderType_name, OrderType_value)
+	proto.RegisterEnum("crescent.liquidity.v1beta1.TimeInForce", TimeInForce_name, TimeInForce_value)
+	proto.RegisterEnum("crescent.liquidity.v1beta1.TriggerCondition", TriggerCondition_name, TriggerCondition_value)
 	proto.RegisterEnum("crescent.liquidity.v1beta1.OrderDirection", OrderDirection_name, OrderDirection_value)
 	proto.RegisterEnum("crescent.liquidity.v1beta1.RequestStatus", RequestStatus_name, RequestStatus_value)
 	proto.RegisterEnum("crescent.liquidity.v1beta1.OrderStatus", OrderStatus_name, OrderStatus_value)
+	proto.RegisterEnum("crescent.liquidity.v1beta1.AllocationPolicy", AllocationPolicy_name, AllocationPolicy_value)
 	proto.RegisterType((*Params)(nil), "crescent.liquidity.v1beta1.Params")
 	proto.RegisterType((*Pair)(nil), "crescent.liquidity.v1beta1.Pair")
 	proto.RegisterType((*Pool)(nil), "crescent.liquidity.v1beta1.Pool")
+	proto.RegisterType((*LiquidityTick)(nil), "crescent.liquidity.v1beta1.LiquidityTick")
+	proto.RegisterType((*Position)(nil), "crescent.liquidity.v1beta1.Position")
+	proto.RegisterType((*PoolAccrual)(nil), "crescent.liquidity.v1beta1.PoolAccrual")
+	proto.RegisterType((*PoolAccrualSnapshot)(nil), "crescent.liquidity.v1beta1.PoolAccrualSnapshot")
+	proto.RegisterType((*TWAPAccumulator)(nil), "crescent.liquidity.v1beta1.TWAPAccumulator")
+	proto.RegisterType((*TWAPSnapshot)(nil), "crescent.liquidity.v1beta1.TWAPSnapshot")
+	proto.RegisterType((*Candle)(nil), "crescent.liquidity.v1beta1.Candle")
 	proto.RegisterType((*DepositRequest)(nil), "crescent.liquidity.v1beta1.DepositRequest")
+	proto.RegisterType((*SingleSidedDepositRequest)(nil), "crescent.liquidity.v1beta1.SingleSidedDepositRequest")
 	proto.RegisterType((*WithdrawRequest)(nil), "crescent.liquidity.v1beta1.WithdrawRequest")
+	proto.RegisterType((*SingleSidedWithdrawal)(nil), "crescent.liquidity.v1beta1.SingleSidedWithdrawal")
+	proto.RegisterType((*SwapRequest)(nil), "crescent.liquidity.v1beta1.SwapRequest")
 	proto.RegisterType((*Order)(nil), "crescent.liquidity.v1beta1.Order")
 }
 
@@ -544,133 +1201,223 @@ func init() {
 }
 
 var fileDescriptor_c9be4f53a63dce2f = []byte{
-	// 2016 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0x17, 0x29, 0x8a, 0x22, 0x1f, 0xcd, 0x0f, 0x8d, 0x65, 0x7b, 0x4d, 0xdb, 0x14, 0x2b, 0xd4,
-	0x8e, 0x2a, 0x20, 0x64, 0xa2, 0x36, 0x48, 0x0c, 0xa4, 0x09, 0x28, 0x72, 0x25, 0x13, 0xa5, 0x24,
-	0x7a, 0x49, 0x35, 0x71, 0x50, 0x60, 0x31, 0xda, 0x1d, 0x51, 0x03, 0xed, 0x97, 0x77, 0x87, 0xfa,
-	0xc8, 0xa9, 0xc7, 0x82, 0xa7, 0x9c, 0x8a, 0x5e, 0x78, 0x69, 0x2f, 0x45, 0xff, 0x82, 0x5e, 0x7b,
-	0x28, 0xe0, 0x63, 0x8e, 0x6d, 0x0f, 0x49, 0x6b, 0xdf, 0x7a, 0x2a, 0xfa, 0x17, 0x04, 0x33, 0xfb,
-	0xc1, 0x25, 0xed, 0x38, 0x16, 0x11, 0x9f, 0xa4, 0x9d, 0x79, 0xbf, 0xdf, 0x9b, 0xf9, 0xbd, 0x37,
-	0x6f, 0xde, 0x10, 0x36, 0x35, 0x97, 0x78, 0x1a, 0xb1, 0x58, 0xdd, 0xa0, 0x4f, 0x87, 0x54, 0xa7,
-	0xec, 0xb2, 0x7e, 0xf6, 0xfe, 0x11, 0x61, 0xf8, 0xfd, 0xc9, 0x48, 0xcd, 0x71, 0x6d, 0x66, 0xa3,
-	0x72, 0x68, 0x5b, 0x9b, 0xcc, 0x04, 0xb6, 0xe5, 0xd5, 0x81, 0x3d, 0xb0, 0x85, 0x59, 0x9d, 0xff,
-	0xe7, 0x23, 0xca, 0x15, 0xcd, 0xf6, 0x4c, 0xdb, 0xab, 0x1f, 0x61, 0x8f, 0x44, 0xb4, 0x9a, 0x4d,
-	0xad, 0x60, 0x7e, 0x6d, 0x60, 0xdb, 0x03, 0x83, 0xd4, 0xc5, 0xd7, 0xd1, 0xf0, 0xb8, 0xce, 0xa8,
-	0x49, 0x3c, 0x86, 0x4d, 0x27, 0x24, 0x98, 0x35, 0xd0, 0x87, 0x2e, 0x66, 0xd4, 0x0e, 0x08, 0xd6,
-	0xff, 0x99, 0x83, 0x74, 0x17, 0xbb, 0xd8, 0xf4, 0xd0, 0x3d, 0x80, 0x23, 0xcc, 0xb4, 0x13, 0xd5,
-	0xa3, 0x5f, 0x12, 0x29, 0x51, 0x4d, 0x6c, 0xe4, 0x95, 0xac, 0x18, 0xe9, 0xd1, 0x2f, 0x09, 0xba,
-	0x0f, 0x05, 0x46, 0xb5, 0x53, 0xd5, 0x71, 0x89, 0x46, 0x3d, 0x6a, 0x5b, 0x52, 0x52, 0x98, 0xe4,
-	0xf9, 0x68, 0x37, 0x1c, 0x44, 0x5b, 0x70, 0xe3, 0x98, 0x10, 0x55, 0xb3, 0x0d, 0x83, 0x68, 0xcc,
-	0x76, 0x55, 0xac, 0xeb, 0x2e, 0xf1, 0x3c, 0x69, 0xb1, 0x9a, 0xd8, 0xc8, 0x2a, 0xd7, 0x8f, 0x09,
-	0x69, 0x86, 0x73, 0x0d, 0x7f, 0x0a, 0xfd, 0x02, 0x6e, 0xea, 0x43, 0x8f, 0xbd, 0x02, 0x94, 0x12,
-	0xa0, 0x55, 0x3e, 0xfb, 0x12, 0xca, 0x82, 0xbb, 0x26, 0xb5, 0x54, 0x6a, 0x51, 0x46, 0xb1, 0xa1,
-	0x3a, 0xb6, 0x6d, 0xa8, 0x5c, 0x1a, 0xd5, 0x1b, 0x3a, 0x8e, 0x71, 0x29, 0x2d, 0x71, 0xec, 0x76,
-	0xed, 0xd9, 0x37, 0x6b, 0x0b, 0xff, 0xfa, 0x66, 0xed, 0xc1, 0x80, 0xb2, 0x93, 0xe1, 0x51, 0x4d,
-	0xb3, 0xcd, 0x7a, 0x20, 0xaa, 0xff, 0xe7, 0x5d, 0x4f, 0x3f, 0xad, 0xb3, 0x4b, 0x87, 0x78, 0xb5,
-	0xb6, 0xc5, 0x14, 0xc9, 0xa4, 0x56, 0xdb, 0xa7, 0xec, 0xda, 0xb6, 0xd1, 0xb4, 0xa9, 0xd5, 0x13,
-	0x7c, 0xe8, 0x1c, 0x56, 0x1c, 0x4c, 0x5d, 0x55, 0x73, 0x89, 0x50, 0x50, 0x3d, 0x26, 0x44, 0x4a,
-	0x57, 0x17, 0x37, 0x72, 0x5b, 0xb7, 0x6b, 0x3e, 0x57, 0x8d, 0xc7, 0x29, 0x0c, 0x69, 0x8d, 0x63,
-	0xb7, 0xdf, 0xe3, 0xfe, 0xff, 0xf2, 0xed, 0xda, 0xc6, 0x1b, 0xf8, 0xe7, 0x00, 0x4f, 0x29, 0x72,
-	0x2f, 0xcd, 0xc0, 0xc9, 0x0e, 0x21, 0xc2, 0xb1, 0xd8, 0x5c, 0xdc, 0xf1, 0xf2, 0xdb, 0x70, 0xcc,
-	0x37, 0x1c, 0x73, 0x7c, 0x0a, 0xe5, 0xb8, 0xc2, 0x3a, 0x71, 0x6c, 0x8f, 0x32, 0x15, 0x9b, 0xf6,
-	0xd0, 0x62, 0x52, 0x66, 0x2e, 0x7d, 0x6f, 0x4d, 0xf4, 0x6d, 0xf9, 0x7c, 0x0d, 0x41, 0x87, 0x30,
-	0xdc, 0x30, 0xf1, 0x85, 0xea, 0xb8, 0x54, 0x23, 0xaa, 0x41, 0x4d, 0xca, 0x54, 0x91, 0xa9, 0x52,
-	0xf6, 0xca, 0x7e, 0x5a, 0x44, 0x53, 0x90, 0x89, 0x2f, 0xba, 0x9c, 0xab, 0xc3, 0xa9, 0x14, 0xce,
-	0x84, 0x76, 0xe1, 0x27, 0xdc, 0x85, 0x35, 0x34, 0x55, 0x13, 0xbb, 0xa7, 0x84, 0xa9, 0x26, 0x3e,
-	0xa5, 0xd6, 0x40, 0xb5, 0x5d, 0x9d, 0xb8, 0x2a, 0x4f, 0x64, 0x4f, 0x02, 0x91, 0xd5, 0x77, 0x4d,
-	0x7c, 0xb1, 0x3f, 0x34, 0xf7, 0x84, 0xd9, 0x9e, 0xb0, 0x3a, 0xe0, 0x46, 0x7d, 0x6e, 0x83, 0xf6,
-	0xe1, 0xfe, 0x6b, 0x88, 0x3c, 0xd5, 0x21, 0xae, 0xca, 0xa3, 0x28, 0xe5, 0x04, 0xd9, 0xda, 0xf7,
-	0x90, 0x79, 0x5d, 0xe2, 0x76, 0x31, 0x75, 0xd1, 0x63, 0xe0, 0xcb, 0x0d, 0x96, 0x61, 0xd0, 0x63,
-	0xe2, 0x39, 0xd8, 0x92, 0xae, 0x55, 0x13, 0x22, 0xc4, 0xfe, 0x11, 0xae, 0x85, 0x47, 0xb8, 0xd6,
-	0x0a, 0x8e, 0xf0, 0x76, 0x86, 0x6b, 0xf2, 0x87, 0x6f, 0xd7, 0x12, 0x4a, 0xc9, 0xc4, 0x17, 0x82,
-	0xb2, 0x13, 0x80, 0x91, 0x02, 0x79, 0xef, 0x1c, 0x3b, 0x3c, 0x57, 0xb8, 0x8e, 0x44, 0xca, 0xcf,
-	0x25, 0x63, 0x8e, 0x93, 0xec, 0x10, 0xa2, 0x60, 0x46, 0xd0, 0x17, 0xb0, 0x72, 0x4e, 0xd9, 0x89,
-	0xee, 0xe2, 0xf3, 0x09, 0x6f, 0x61, 0x2e, 0xde, 0x62, 0x48, 0x14, 0xe3, 0x0e, 0xf3, 0x8b, 0x5c,
-	0x30, 0x17, 0xab, 0x03, 0xec, 0x49, 0xc5, 0x6a, 0x62, 0x23, 0x75, 0x25, 0xee, 0x5d, 0xec, 0x29,
-	0xc5, 0x80, 0x48, 0xe6, 0x3c, 0xbb, 0xd8, 0x43, 0xbf, 0x01, 0x14, 0xad, 0x7b, 0x42, 0x5e, 0x9a,
-	0x8b, 0xbc, 0x14, 0x32, 0x45, 0xec, 0xbf, 0x86, 0xa2, 0x1f, 0xb8, 0x09, 0xf5, 0xca, 0x5c, 0xd4,
-	0x79, 0x41, 0x13, 0xf1, 0x7e, 0x0a, 0xf7, 0xc2, 0x24, 0xc3, 0x1a, 0xa3, 0x67, 0x44, 0x94, 0xb8,
-	0x58, 0x72, 0x21, 0x91, 0x5c, 0x92, 0x9f, 0x5c, 0x0d, 0x61, 0xc2, 0x4b, 0x56, 0x98, 0x55, 0xeb,
-	0x7f, 0x4e, 0x42, 0x4a, 0xa4, 0x57, 0x01, 0x92, 0x54, 0x17, 0x15, 0x3d, 0xa5, 0x24, 0xa9, 0x8e,
-	0x1e, 0x40, 0x91, 0xd7, 0x0b, 0xbf, 0x5a, 0xea, 0xc4, 0xb2, 0x4d, 0x51, 0xcb, 0xb3, 0x4a, 0x9e,
-	0x0f, 0xf3, 0x62, 0xd0, 0xe2, 0x83, 0x68, 0x03, 0x4a, 0x4f, 0x87, 0x36, 0x9b, 0x32, 0xf4, 0xcb,
-	0x78, 0x41, 0x8c, 0x4f, 0x2c, 0xef, 0x43, 0x81, 0x78, 0x9a, 0x6b, 0x9f, 0xcf, 0x54, 0xee, 0xbc,
-	0x3f, 0x1a, 0x96, 0xec, 0x75, 0xc8, 0x1b, 0xd8, 0x63, 0x41, 0xa2, 0x53, 0x5d, 0xd4, 0xe8, 0x94,
-	0x92, 0xe3, 0x83, 0x22, 0x7d, 0xdb, 0x3a, 0x6a, 0x03, 0x08, 0x1b, 0x51, 0x08, 0xa4, 0xb4, 0xc8,
-	0xae, 0xcd, 0x2b, 0x64, 0x56, 0x96, 0xa3, 0xc5, 0xc9, 0xe7, 0xeb, 0xd7, 0x86, 0xae, 0x4b, 0x2c,
-	0xa6, 0xfa, 0x37, 0x1b, 0xd5, 0xa5, 0x65, 0xe1, 0xb1, 0x10, 0x8c, 0x6f, 0xf3, 0xe1, 0xb6, 0xbe,
-	0xfe, 0xff, 0x45, 0x48, 0x71, 0xed, 0xd0, 0x47, 0x90, 0xe2, 0x54, 0x42, 0xac, 0xc2, 0xd6, 0x4f,
-	0x6b, 0xdf, 0x7f, 0x63, 0xd7, 0xb8, 0x7d, 0xff, 0xd2, 0x21, 0x8a, 0x40, 0x04, 0x22, 0x27, 0x23,
-	0x91, 0x6f, 0xc1, 0xb2, 0xb8, 0x2e, 0xa8, 0x2e, 0x34, 0x4b, 0x29, 0x69, 0xfe, 0xd9, 0xd6, 0x91,
-	0x04, 0xcb, 0xa2, 0x92, 0xdb, 0x6e, 0x20, 0x52, 0xf8, 0x89, 0xde, 0x81, 0xa2, 0x4b, 0x3c, 0xe2,
-	0x9e, 0x91, 0x48, 0xc6, 0x25, 0x5f, 0xee, 0x60, 0x38, 0xd4, 0xf1, 0x01, 0x14, 0x27, 0xd7, 0x9d,
-	0x1f, 0x97, 0xb4, 0xaf, 0xb7, 0x13, 0xdc, 0x59, 0x7e, 0x58, 0x76, 0x21, 0xcb, 0x0b, 0xb8, 0x2f,
-	0xe5, 0xf2, 0x95, 0xa5, 0xcc, 0x98, 0xd4, 0xf2, 0x95, 0xe4, 0x44, 0x61, 0x71, 0x0e, 0x0a, 0xff,
-	0xd5, 0x88, 0x82, 0x62, 0x8c, 0x3e, 0x80, 0x5b, 0x22, 0xba, 0xe1, 0x59, 0x77, 0xc9, 0xd3, 0x21,
-	0xf1, 0x18, 0x57, 0x29, 0x2b, 0x54, 0x5a, 0xe5, 0xd3, 0xc1, 0xcd, 0xa0, 0xf8, 0x93, 0x6d, 0x1d,
-	0x7d, 0x08, 0x92, 0x80, 0x45, 0xc7, 0x38, 0x86, 0x03, 0x81, 0xbb, 0xc1, 0xe7, 0x3f, 0x0b, 0xa6,
-	0x27, 0xc0, 0x32, 0x64, 0x74, 0xea, 0xe1, 0x23, 0x83, 0xe8, 0xa2, 0x18, 0x67, 0x94, 0xe8, 0x7b,
-	0xfd, 0xbf, 0x8b, 0x50, 0x98, 0xf6, 0xf4, 0xd2, 0x49, 0xe1, 0x41, 0xe4, 0x42, 0x47, 0x91, 0x4d,
-	0xf3, 0xcf, 0xb6, 0xce, 0x9b, 0x25, 0xd3, 0x1b, 0xa8, 0x27, 0x84, 0x0e, 0x4e, 0x98, 0x08, 0xf0,
-	0xa2, 0x92, 0x35, 0xbd, 0xc1, 0x23, 0x31, 0x80, 0xee, 0x42, 0x36, 0xd8, 0x61, 0x14, 0xe5, 0xc9,
-	0x00, 0x72, 0x20, 0x1f, 0xee, 0x9f, 0x47, 0x90, 0x47, 0xf9, 0x47, 0xbf, 0xcc, 0xaf, 0x05, 0x1e,
-	0xc4, 0x17, 0x72, 0xa1, 0x80, 0x35, 0x8d, 0x38, 0x8c, 0xe8, 0x81, 0xcb, 0xb7, 0xd0, 0xb8, 0xe4,
-	0x43, 0x17, 0xbe, 0xcf, 0x36, 0x94, 0x4c, 0x6a, 0x71, 0x8f, 0x51, 0xae, 0x8a, 0x1c, 0x7c, 0xad,
-	0xd7, 0x14, 0xf7, 0xaa, 0x14, 0x7c, 0x60, 0xd8, 0x80, 0xa1, 0x06, 0xa4, 0x3d, 0x86, 0xd9, 0xd0,
-	0x13, 0xb9, 0x57, 0xd8, 0xfa, 0xd9, 0xeb, 0xce, 0x65, 0x10, 0xcb, 0x9e, 0x00, 0x28, 0x01, 0x70,
-	0xfd, 0x7f, 0x49, 0x28, 0xce, 0xa4, 0xc7, 0x8f, 0x16, 0xed, 0x0a, 0x40, 0x98, 0x98, 0x24, 0x0c,
-	0x77, 0x6c, 0x04, 0x7d, 0x0c, 0xd9, 0x89, 0x04, 0x4b, 0x6f, 0x26, 0x41, 0x26, 0x3c, 0xc9, 0x88,
-	0x41, 0x74, 0x59, 0x5a, 0x6f, 0x2f, 0x78, 0x85, 0xc8, 0x87, 0x1f, 0xbd, 0x89, 0xe4, 0xcb, 0xf3,
-	0x4a, 0xfe, 0xf7, 0x34, 0x2c, 0x89, 0xaa, 0x8e, 0x1e, 0x4e, 0x55, 0xd5, 0xfb, 0xaf, 0xa3, 0xf2,
-	0xbb, 0xac, 0x39, 0xca, 0xea, 0x74, 0x8c, 0x52, 0xb3, 0x31, 0x92, 0x60, 0x59, 0xdc, 0x3a, 0xc4,
-	0x0d, 0x6a, 0x6a, 0xf8, 0x89, 0x1e, 0x41, 0x56, 0xa7, 0x2e, 0xd1, 0x78, 0x4b, 0x25, 0xca, 0x68,
-	0x61, 0x6b, 0xf3, 0x07, 0x57, 0xd8, 0x0a, 0x11, 0xca, 0x04, 0x8c, 0x3e, 0x01, 0xb0, 0x8f, 0x8f,
-	0x89, 0x7b, 0xa5, 0x5c, 0xcf, 0x0a, 0x88, 0x88, 0xf4, 0x63, 0x58, 0x75, 0x89, 0x89, 0xa9, 0x25,
-	0x5a, 0xc9, 0x09, 0x53, 0xe6, 0xcd, 0x98, 0x50, 0x04, 0x3e, 0x88, 0x28, 0x5b, 0x90, 0x77, 0x89,
-	0x46, 0xe8, 0x59, 0x70, 0xf0, 0x45, 0x95, 0x7d, 0x03, 0xae, 0x6b, 0x21, 0x2a, 0x60, 0x59, 0xf2,
-	0x4b, 0x3f, 0xcc, 0xd5, 0xec, 0xf9, 0x60, 0xb4, 0x03, 0xe9, 0xe0, 0xe9, 0x90, 0x9b, 0xeb, 0xe9,
-	0x10, 0xa0, 0xd1, 0x01, 0xe4, 0x6c, 0x87, 0x58, 0xe1, 0x3b, 0xe4, 0xda, 0x5c, 0x64, 0xc0, 0x29,
-	0x82, 0xa7, 0xc7, 0x6d, 0xc8, 0x44, 0xfd, 0x41, 0x5e, 0x24, 0xd5, 0xf2, 0x91, 0xdf, 0x18, 0xa0,
-	0x06, 0x64, 0xc9, 0x85, 0x43, 0x5d, 0xa2, 0x62, 0x26, 0x5a, 0xdd, 0xdc, 0x56, 0xf9, 0xa5, 0x86,
-	0xbc, 0x1f, 0x3e, 0xba, 0xfd, 0x8e, 0xfc, 0x2b, 0xde, 0x91, 0x67, 0x7c, 0x58, 0x83, 0xa1, 0x4f,
-	0xa3, 0x93, 0x54, 0x14, 0xc9, 0xf5, 0xce, 0x0f, 0x26, 0xd7, 0xf4, 0x39, 0xda, 0xfc, 0x7d, 0x02,
-	0x32, 0x61, 0xb3, 0xc1, 0xdf, 0xd7, 0xdd, 0x83, 0x83, 0x8e, 0xda, 0x7f, 0xd2, 0x95, 0xd5, 0xc3,
-	0xfd, 0x5e, 0x57, 0x6e, 0xb6, 0x77, 0xda, 0x72, 0xab, 0xb4, 0x50, 0xbe, 0x35, 0x1a, 0x57, 0xaf,
-	0x87, 0x86, 0x87, 0x96, 0xe7, 0x10, 0x8d, 0x1e, 0x53, 0x22, 0xfa, 0xbd, 0x09, 0x66, 0xbb, 0xd1,
-	0x6b, 0x37, 0x4b, 0x89, 0xf2, 0xca, 0x68, 0x5c, 0xcd, 0x87, 0xd6, 0xdb, 0xd8, 0xa3, 0x1a, 0xef,
-	0x97, 0x26, 0x76, 0x4a, 0x63, 0x7f, 0x57, 0x6e, 0x95, 0x92, 0x65, 0x34, 0x1a, 0x57, 0x0b, 0x51,
-	0xb3, 0x83, 0xad, 0x01, 0xd1, 0xcb, 0xa9, 0xdf, 0xfd, 0xa9, 0xb2, 0xb0, 0xf9, 0xb7, 0x04, 0x64,
-	0xa3, 0xf3, 0xca, 0x5f, 0xf1, 0x07, 0x4a, 0x4b, 0x56, 0x5e, 0xb5, 0x34, 0x69, 0x34, 0xae, 0xae,
-	0x46, 0xa6, 0xf1, 0xb5, 0x6d, 0x40, 0x29, 0x86, 0xea, 0xb4, 0xf7, 0xda, 0xfd, 0x52, 0xc2, 0xf7,
-	0x19, 0xd9, 0x8b, 0x27, 0x1c, 0xda, 0x84, 0x95, 0x98, 0xe5, 0x5e, 0x43, 0xf9, 0x95, 0xdc, 0x2f,
-	0x25, 0xcb, 0xd7, 0x47, 0xe3, 0x6a, 0x31, 0x32, 0xf5, 0xdf, 0x58, 0xbc, 0xd1, 0x8c, 0xdb, 0xee,
-	0x95, 0x16, 0xcb, 0xc5, 0xd1, 0xb8, 0x9a, 0x9b, 0xd8, 0xed, 0x05, 0x7b, 0xf8, 0x6b, 0x02, 0x0a,
-	0xd3, 0x27, 0x1a, 0x7d, 0x02, 0x77, 0x7c, 0x70, 0xab, 0xad, 0xc8, 0xcd, 0x7e, 0xfb, 0x60, 0x7f,
-	0x66, 0x37, 0xf7, 0x46, 0xe3, 0xea, 0xed, 0x69, 0x50, 0x7c, 0x4b, 0x35, 0xb8, 0x3e, 0x8b, 0xdf,
-	0x3e, 0x7c, 0x52, 0x4a, 0x94, 0x6f, 0x8c, 0xc6, 0xd5, 0x95, 0x69, 0xdc, 0xf6, 0xf0, 0x12, 0xbd,
-	0x07, 0xab, 0xb3, 0xf6, 0x3d, 0xb9, 0xd3, 0x29, 0x25, 0xcb, 0x37, 0x47, 0xe3, 0x2a, 0x9a, 0x06,
-	0xf4, 0x88, 0x61, 0x04, 0x4b, 0xff, 0x6d, 0x12, 0xf2, 0x53, 0x95, 0x17, 0x7d, 0x0c, 0x65, 0x45,
-	0x7e, 0x7c, 0x28, 0xf7, 0xfa, 0x6a, 0xaf, 0xdf, 0xe8, 0x1f, 0xf6, 0x66, 0x16, 0x7e, 0x77, 0x34,
-	0xae, 0x4a, 0x53, 0x90, 0xf8, 0xba, 0x7f, 0x09, 0x77, 0x66, 0xd0, 0xfb, 0x07, 0x7d, 0x55, 0xfe,
-	0x5c, 0x6e, 0x1e, 0xf6, 0xe5, 0x56, 0x29, 0xf1, 0x0a, 0xf8, 0xbe, 0xcd, 0xe4, 0x0b, 0xa2, 0x0d,
-	0x19, 0xd1, 0xd1, 0x47, 0x20, 0xcd, 0xc0, 0x7b, 0x87, 0xcd, 0xa6, 0x2c, 0xb7, 0x44, 0x16, 0x95,
-	0x47, 0xe3, 0xea, 0xcd, 0x29, 0x6c, 0x6f, 0xa8, 0x69, 0x84, 0xe8, 0x44, 0xe7, 0x39, 0x3d, 0x83,
-	0xdc, 0x69, 0xb4, 0x3b, 0x72, 0xab, 0xb4, 0xe8, 0xe7, 0xf4, 0x14, 0x6c, 0x07, 0x53, 0x23, 0xca,
-	0xc0, 0x3f, 0x2e, 0x42, 0x2e, 0x76, 0x64, 0xf8, 0x1a, 0x7c, 0x29, 0x5f, 0xb9, 0x7d, 0xb1, 0x86,
-	0x98, 0x79, 0x7c, 0xf3, 0x0f, 0xe1, 0xf6, 0x14, 0x72, 0x66, 0xeb, 0xb3, 0xd0, 0xf8, 0xc6, 0x3f,
-	0x9c, 0x71, 0xca, 0xa1, 0x7b, 0x8d, 0x7e, 0xf3, 0x91, 0xd8, 0xf8, 0xed, 0xd1, 0xb8, 0x7a, 0x63,
-	0x1a, 0xb9, 0xc7, 0x8b, 0x0b, 0xd1, 0x51, 0x13, 0x2a, 0x53, 0xc0, 0x6e, 0x43, 0xe9, 0xb7, 0x1b,
-	0x9d, 0xce, 0x93, 0x08, 0xbe, 0x58, 0x5e, 0x1b, 0x8d, 0xab, 0x77, 0x62, 0xf0, 0x2e, 0x76, 0x19,
-	0xc5, 0x86, 0x71, 0x19, 0x92, 0x44, 0xc7, 0x2e, 0x20, 0x69, 0x1e, 0xec, 0x75, 0x3b, 0x32, 0x5f,
-	0x75, 0x2a, 0x76, 0xec, 0x7c, 0x70, 0xd3, 0x36, 0x1d, 0x83, 0x30, 0x5f, 0xf2, 0x69, 0x54, 0x63,
-	0xbf, 0x29, 0x73, 0xc9, 0x97, 0x7c, 0xc9, 0xe3, 0x20, 0x6c, 0x69, 0xc4, 0x20, 0xfa, 0x24, 0x4f,
-	0x03, 0x8c, 0xfc, 0x79, 0xb7, 0xad, 0xc8, 0xad, 0x52, 0x3a, 0x96, 0xa7, 0x3e, 0x44, 0x16, 0xb5,
-	0x2f, 0x08, 0xd2, 0xf6, 0x67, 0xcf, 0xfe, 0x53, 0x59, 0x78, 0xf6, 0xbc, 0x92, 0xf8, 0xfa, 0x79,
-	0x25, 0xf1, 0xef, 0xe7, 0x95, 0xc4, 0x57, 0x2f, 0x2a, 0x0b, 0x5f, 0xbf, 0xa8, 0x2c, 0xfc, 0xe3,
-	0x45, 0x65, 0xe1, 0x8b, 0x87, 0xf1, 0x82, 0x1d, 0x14, 0xc6, 0x77, 0x2d, 0xc2, 0xce, 0x6d, 0xf7,
-	0x34, 0x1a, 0xa8, 0x9f, 0x7d, 0x50, 0xbf, 0x88, 0xfd, 0xc2, 0x2a, 0xea, 0xf8, 0x51, 0x5a, 0x54,
-	0xe0, 0x9f, 0x7f, 0x17, 0x00, 0x00, 0xff, 0xff, 0x95, 0xe9, 0x2b, 0x59, 0x84, 0x15, 0x00, 0x00,
+	// 3456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcd, 0x6f, 0x23, 0xc9,
+	0x75, 0x1f, 0x8a, 0x14, 0x45, 0x3e, 0x8a, 0x64, 0xab, 0x24, 0xcd, 0xf4, 0x70, 0x66, 0x34, 0xb4,
+	0xe2, 0x5d, 0x4f, 0x06, 0x5e, 0xc9, 0x3b, 0xb6, 0x61, 0x4f, 0xe2, 0x78, 0xcd, 0x8f, 0x96, 0xb6,
+	0xb1, 0xa4, 0xc8, 0x6d, 0x52, 0x3b, 0x2b, 0x23, 0x40, 0xa3, 0xd5, 0x5d, 0xa2, 0x0a, 0xd3, 0x5f,
+	0xd3, 0xdd, 0x1c, 0x49, 0x3e, 0xe5, 0x18, 0xf0, 0x64, 0x20, 0x87, 0x04, 0x06, 0x88, 0x04, 0x49,
+	0x4e, 0xbe, 0x25, 0xa7, 0xfc, 0x01, 0x41, 0xb0, 0x27, 0xc3, 0xc8, 0x29, 0xc9, 0x61, 0x9d, 0xec,
+	0x1e, 0x16, 0xc8, 0x25, 0x39, 0xe4, 0x0f, 0x08, 0xaa, 0xaa, 0xbb, 0xd9, 0xa4, 0x3e, 0x56, 0xe4,
+	0xce, 0xc0, 0x27, 0x89, 0xd5, 0xef, 0xf7, 0xab, 0x7a, 0xaf, 0xde, 0x57, 0x55, 0x37, 0x3c, 0xd5,
+	0x3d, 0xec, 0xeb, 0xd8, 0x0e, 0x76, 0x4d, 0xf2, 0x6a, 0x48, 0x0c, 0x12, 0x5c, 0xec, 0xbe, 0x7e,
+	0xff, 0x18, 0x07, 0xda, 0xfb, 0x93, 0x91, 0x1d, 0xd7, 0x73, 0x02, 0x07, 0x55, 0x22, 0xd9, 0x9d,
+	0xc9, 0x93, 0x50, 0xb6, 0xb2, 0x31, 0x70, 0x06, 0x0e, 0x13, 0xdb, 0xa5, 0xff, 0x71, 0x44, 0x65,
+	0x4b, 0x77, 0x7c, 0xcb, 0xf1, 0x77, 0x8f, 0x35, 0x1f, 0xc7, 0xb4, 0xba, 0x43, 0xec, 0xf0, 0xf9,
+	0xe3, 0x81, 0xe3, 0x0c, 0x4c, 0xbc, 0xcb, 0x7e, 0x1d, 0x0f, 0x4f, 0x76, 0x03, 0x62, 0x61, 0x3f,
+	0xd0, 0x2c, 0x37, 0x22, 0x98, 0x15, 0x30, 0x86, 0x9e, 0x16, 0x10, 0xc7, 0xbe, 0xee, 0xf9, 0x99,
+	0xa7, 0xb9, 0x2e, 0xf6, 0x7c, 0xfe, 0x7c, 0xfb, 0xaf, 0x8b, 0x90, 0xed, 0x6a, 0x9e, 0x66, 0xf9,
+	0xe8, 0x11, 0xc0, 0xb1, 0x16, 0xe8, 0xa7, 0xaa, 0x4f, 0x7e, 0x81, 0xc5, 0x54, 0x35, 0xf5, 0xa4,
+	0xa8, 0xe4, 0xd9, 0x48, 0x8f, 0xfc, 0x02, 0xa3, 0x77, 0xa0, 0x14, 0x10, 0xfd, 0xa5, 0xea, 0x7a,
+	0x58, 0x27, 0x3e, 0x71, 0x6c, 0x71, 0x89, 0x89, 0x14, 0xe9, 0x68, 0x37, 0x1a, 0x44, 0xcf, 0x60,
+	0xf3, 0x04, 0x63, 0x55, 0x77, 0x4c, 0x13, 0xeb, 0x81, 0xe3, 0xa9, 0x9a, 0x61, 0x78, 0xd8, 0xf7,
+	0xc5, 0x74, 0x35, 0xf5, 0x24, 0xaf, 0xac, 0x9f, 0x60, 0xdc, 0x88, 0x9e, 0xd5, 0xf8, 0x23, 0xf4,
+	0x03, 0xb8, 0x6b, 0x0c, 0xfd, 0xe0, 0x0a, 0x50, 0x86, 0x81, 0x36, 0xe8, 0xd3, 0x4b, 0x28, 0x1b,
+	0x1e, 0x5a, 0xc4, 0x56, 0x89, 0x4d, 0x02, 0xa2, 0x99, 0xaa, 0xeb, 0x38, 0xa6, 0x4a, 0x4d, 0xa7,
+	0xfa, 0x43, 0xd7, 0x35, 0x2f, 0xc4, 0x65, 0x8a, 0xad, 0xef, 0x7c, 0xf6, 0xf9, 0xe3, 0x3b, 0xff,
+	0xf1, 0xf9, 0xe3, 0x77, 0x07, 0x24, 0x38, 0x1d, 0x1e, 0xef, 0xe8, 0x8e, 0xb5, 0x1b, 0x1a, 0x9d,
+	0xff, 0x79, 0xcf, 0x37, 0x5e, 0xee, 0x06, 0x17, 0x2e, 0xf6, 0x77, 0x64, 0x3b, 0x50, 0x44, 0x8b,
+	0xd8, 0x32, 0xa7, 0xec, 0x3a, 0x8e, 0xd9, 0x70, 0x88, 0xdd, 0x63, 0x7c, 0xe8, 0x0c, 0xd6, 0x5c,
+	0x8d, 0x78, 0xaa, 0xee, 0x61, 0x66, 0x61, 0xf5, 0x04, 0x63, 0x31, 0x5b, 0x4d, 0x3f, 0x29, 0x3c,
+	0xbb, 0xbf, 0xc3, 0xb9, 0x76, 0xe8, 0x3e, 0x46, 0x5b, 0xbe, 0x43, 0xb1, 0xf5, 0xef, 0xd1, 0xf9,
+	0x7f, 0xfd, 0xbb, 0xc7, 0x4f, 0x6e, 0x31, 0x3f, 0x05, 0xf8, 0x4a, 0x99, 0xce, 0xd2, 0x08, 0x27,
+	0xd9, 0xc3, 0x98, 0x4d, 0xcc, 0x94, 0x4b, 0x4e, 0xbc, 0xf2, 0x36, 0x26, 0xa6, 0x0a, 0x27, 0x26,
+	0x7e, 0x09, 0x95, 0xa4, 0x85, 0x0d, 0xec, 0x3a, 0x3e, 0x09, 0x54, 0xcd, 0x72, 0x86, 0x76, 0x20,
+	0xe6, 0x16, 0xb2, 0xef, 0xbd, 0x89, 0x7d, 0x9b, 0x9c, 0xaf, 0xc6, 0xe8, 0x90, 0x06, 0x9b, 0x96,
+	0x76, 0xae, 0xba, 0x1e, 0xd1, 0xb1, 0x6a, 0x12, 0x8b, 0x04, 0x2a, 0xf3, 0x64, 0x31, 0x3f, 0xf7,
+	0x3c, 0x4d, 0xac, 0x2b, 0xc8, 0xd2, 0xce, 0xbb, 0x94, 0xab, 0x45, 0xa9, 0x14, 0xca, 0x84, 0xf6,
+	0xe1, 0x5b, 0x74, 0x0a, 0x7b, 0x68, 0xa9, 0x96, 0xe6, 0xbd, 0xc4, 0x81, 0x6a, 0x69, 0x2f, 0x89,
+	0x3d, 0x50, 0x1d, 0xcf, 0xc0, 0x9e, 0x4a, 0x1d, 0xd9, 0x17, 0x81, 0x79, 0xf5, 0x43, 0x4b, 0x3b,
+	0x3f, 0x18, 0x5a, 0x6d, 0x26, 0xd6, 0x66, 0x52, 0x1d, 0x2a, 0xd4, 0xa7, 0x32, 0xe8, 0x00, 0xde,
+	0xb9, 0x81, 0xc8, 0x57, 0x5d, 0xec, 0xa9, 0x74, 0x17, 0xc5, 0x02, 0x23, 0x7b, 0x7c, 0x0d, 0x99,
+	0xdf, 0xc5, 0x5e, 0x57, 0x23, 0x1e, 0xfa, 0x18, 0xe8, 0x72, 0xc3, 0x65, 0x98, 0xe4, 0x04, 0xfb,
+	0xae, 0x66, 0x8b, 0xab, 0xd5, 0x14, 0xdb, 0x62, 0x1e, 0xc2, 0x3b, 0x51, 0x08, 0xef, 0x34, 0xc3,
+	0x10, 0xaf, 0xe7, 0xa8, 0x4d, 0xfe, 0xea, 0x77, 0x8f, 0x53, 0x8a, 0x60, 0x69, 0xe7, 0x8c, 0xb2,
+	0x15, 0x82, 0x91, 0x02, 0x45, 0xff, 0x4c, 0x73, 0xa9, 0xaf, 0x50, 0x3b, 0x62, 0xb1, 0xb8, 0x90,
+	0x19, 0x0b, 0x94, 0x64, 0x0f, 0x63, 0x45, 0x0b, 0x30, 0xfa, 0x39, 0xac, 0x9d, 0x91, 0xe0, 0xd4,
+	0xf0, 0xb4, 0xb3, 0x09, 0x6f, 0x69, 0x21, 0xde, 0x72, 0x44, 0x94, 0xe0, 0x8e, 0xfc, 0x0b, 0x9f,
+	0x07, 0x9e, 0xa6, 0x0e, 0x34, 0x5f, 0x2c, 0x57, 0x53, 0x4f, 0x32, 0x73, 0x71, 0xef, 0x6b, 0xbe,
+	0x52, 0x0e, 0x89, 0x24, 0xca, 0xb3, 0xaf, 0xf9, 0xe8, 0x4f, 0x01, 0xc5, 0xeb, 0x9e, 0x90, 0x0b,
+	0x0b, 0x91, 0x0b, 0x11, 0x53, 0xcc, 0xfe, 0x09, 0x94, 0xf9, 0xc6, 0x4d, 0xa8, 0xd7, 0x16, 0xa2,
+	0x2e, 0x32, 0x9a, 0x98, 0xf7, 0x03, 0x78, 0x14, 0x39, 0x99, 0xa6, 0x07, 0xe4, 0x35, 0x66, 0x29,
+	0x2e, 0xe1, 0x5c, 0x88, 0x39, 0x97, 0xc8, 0x9d, 0xab, 0xc6, 0x44, 0x68, 0xca, 0x8a, 0xbd, 0xaa,
+	0x05, 0x77, 0x23, 0x82, 0x80, 0xba, 0x82, 0x6f, 0x6b, 0xae, 0x7f, 0xea, 0x04, 0xbe, 0xb8, 0x4e,
+	0x91, 0xf5, 0x7b, 0x5f, 0x7c, 0xfe, 0x78, 0xbd, 0xcd, 0xd0, 0xfd, 0x17, 0xb5, 0x6e, 0x2f, 0x7a,
+	0xac, 0xac, 0x73, 0xca, 0xfe, 0x99, 0xe6, 0xc6, 0x83, 0xe8, 0x00, 0x04, 0x5d, 0xb3, 0x0d, 0x13,
+	0xab, 0xc4, 0x0e, 0xb0, 0xf7, 0x5a, 0x33, 0x7d, 0x71, 0xa3, 0x9a, 0xbe, 0xad, 0x87, 0x96, 0x39,
+	0x58, 0x8e, 0xb0, 0xe8, 0x5d, 0x28, 0x47, 0xab, 0xe3, 0x8f, 0x7c, 0x71, 0x93, 0x17, 0x14, 0x3e,
+	0x7b, 0x83, 0x0f, 0x6e, 0xff, 0x2a, 0x0b, 0x19, 0xa6, 0x4e, 0x09, 0x96, 0x88, 0xc1, 0xea, 0x52,
+	0x46, 0x59, 0x22, 0x06, 0x25, 0xa0, 0x59, 0x8f, 0xe7, 0x7c, 0x03, 0xdb, 0x8e, 0xc5, 0x2a, 0x52,
+	0x5e, 0x29, 0xd2, 0x61, 0x9a, 0xd2, 0x9a, 0x74, 0x10, 0x3d, 0x01, 0xe1, 0xd5, 0xd0, 0x09, 0xa6,
+	0x04, 0x79, 0x31, 0x2a, 0xb1, 0xf1, 0x89, 0xe4, 0x3b, 0x50, 0xc2, 0xbe, 0xee, 0x39, 0x67, 0x33,
+	0xf5, 0xa7, 0xc8, 0x47, 0xa3, 0xc2, 0xb3, 0x0d, 0x45, 0x53, 0xf3, 0x83, 0x30, 0x5c, 0x89, 0xc1,
+	0x2a, 0x4d, 0x46, 0x29, 0xd0, 0x41, 0x16, 0x84, 0xb2, 0x81, 0x64, 0x00, 0x26, 0xc3, 0xd2, 0x99,
+	0x98, 0x65, 0x31, 0xf2, 0x74, 0x8e, 0xf8, 0xc8, 0x53, 0x34, 0xcb, 0x5f, 0x74, 0xfd, 0xfa, 0xd0,
+	0xf3, 0xb0, 0x1d, 0xa8, 0xbc, 0x3e, 0x13, 0x43, 0x5c, 0x61, 0x33, 0x96, 0xc2, 0xf1, 0x3a, 0x1d,
+	0x96, 0x0d, 0x74, 0x04, 0x6b, 0x9a, 0x69, 0x3a, 0x3a, 0xaf, 0x12, 0xae, 0x63, 0x12, 0xfd, 0x82,
+	0xa5, 0xe9, 0xd2, 0xb3, 0xef, 0xee, 0x5c, 0xdf, 0x9b, 0xec, 0xd4, 0x62, 0x50, 0x97, 0x61, 0x14,
+	0x41, 0x9b, 0x19, 0x41, 0x5d, 0x28, 0x59, 0xda, 0x4b, 0xec, 0x4d, 0xe2, 0x3e, 0x3f, 0xb7, 0x4e,
+	0xab, 0x8c, 0x21, 0x0a, 0xf8, 0x2e, 0x94, 0x82, 0x69, 0x46, 0x98, 0x9f, 0x31, 0x48, 0x32, 0x7e,
+	0x07, 0xa2, 0xc8, 0xf7, 0x55, 0x57, 0x1b, 0xfa, 0xd8, 0x60, 0xf9, 0x37, 0xa7, 0x94, 0xa2, 0xe1,
+	0x2e, 0x1b, 0x45, 0x7f, 0x00, 0xc5, 0x28, 0x51, 0x73, 0xb1, 0x55, 0x26, 0xb6, 0xca, 0x07, 0x43,
+	0x21, 0xf9, 0x52, 0xbf, 0x53, 0x64, 0xf9, 0xf8, 0xe1, 0x25, 0x6f, 0x3f, 0x94, 0xed, 0xe0, 0xfb,
+	0xcf, 0x3e, 0xd1, 0xcc, 0x21, 0xae, 0x67, 0xfe, 0x86, 0x3a, 0xfb, 0x4c, 0x4f, 0xf4, 0x29, 0x20,
+	0x5a, 0x47, 0xb9, 0xbf, 0xd8, 0x0e, 0x35, 0xab, 0x66, 0x8a, 0xa5, 0xb9, 0xd4, 0xa5, 0xb5, 0x53,
+	0xb0, 0x88, 0xcd, 0x1c, 0xec, 0x20, 0xe4, 0xd8, 0xfe, 0x87, 0x15, 0xc8, 0xd0, 0x98, 0x47, 0x3f,
+	0x86, 0x0c, 0x95, 0x63, 0xe1, 0x51, 0x7a, 0xf6, 0xed, 0x9b, 0x76, 0x9b, 0xca, 0xf7, 0x2f, 0x5c,
+	0xac, 0x30, 0x44, 0x18, 0x56, 0x4b, 0x71, 0x58, 0xdd, 0x83, 0x15, 0xd6, 0xe6, 0x10, 0x83, 0x45,
+	0x49, 0x46, 0xc9, 0xd2, 0x9f, 0xb2, 0x81, 0x44, 0x58, 0x61, 0x1d, 0x88, 0xe3, 0x85, 0x61, 0x11,
+	0xfd, 0xa4, 0x86, 0xf7, 0xb0, 0x8f, 0xbd, 0xd7, 0x38, 0x0e, 0x9c, 0x65, 0x1e, 0x60, 0xe1, 0x70,
+	0x14, 0x39, 0xef, 0x42, 0x79, 0xd2, 0xa6, 0xf1, 0x48, 0xcc, 0xf2, 0x08, 0x73, 0xc3, 0x5e, 0x8b,
+	0x07, 0xe2, 0x3e, 0xe4, 0xa9, 0xc1, 0x78, 0xf0, 0xac, 0xcc, 0xed, 0x16, 0x39, 0x8b, 0xd8, 0x3c,
+	0x76, 0x28, 0x51, 0xd4, 0x54, 0x88, 0xb9, 0x05, 0x88, 0xc2, 0x26, 0x02, 0xfd, 0x10, 0xee, 0xb1,
+	0x78, 0x8e, 0x6a, 0x94, 0x87, 0x5f, 0x0d, 0xb1, 0x1f, 0x50, 0x2b, 0xe5, 0x99, 0x95, 0x36, 0xe8,
+	0xe3, 0xb0, 0xa3, 0x51, 0xf8, 0x43, 0xd9, 0x40, 0x3f, 0x02, 0x91, 0xc1, 0xe2, 0xf2, 0x93, 0xc0,
+	0x01, 0xc3, 0x6d, 0xd2, 0xe7, 0x2f, 0xc2, 0xc7, 0x13, 0x60, 0x05, 0x72, 0x06, 0xf1, 0xb5, 0x63,
+	0x33, 0x76, 0xe2, 0xf8, 0x37, 0xfa, 0x36, 0x14, 0x35, 0xcb, 0x35, 0xc9, 0x09, 0xe1, 0x21, 0xca,
+	0xdc, 0xb7, 0xa8, 0x4c, 0x0f, 0xd2, 0xb4, 0x31, 0x49, 0x8f, 0x67, 0x98, 0x0c, 0x4e, 0x03, 0xe6,
+	0xc1, 0x45, 0xa5, 0x14, 0xe5, 0xc7, 0x17, 0x6c, 0x14, 0x3d, 0x85, 0xb5, 0x44, 0x82, 0x0c, 0x45,
+	0x4b, 0x4c, 0xb4, 0x1c, 0x67, 0xc8, 0x50, 0xf6, 0x67, 0xb0, 0xcc, 0x8d, 0x59, 0x9e, 0xdb, 0x98,
+	0x1c, 0x88, 0x3e, 0x85, 0x72, 0xec, 0x93, 0x61, 0xcb, 0x25, 0xb0, 0x32, 0xf2, 0x87, 0x37, 0x39,
+	0x6d, 0x2b, 0x1a, 0xa1, 0x0d, 0x58, 0x3d, 0x43, 0xcb, 0x8a, 0x52, 0x32, 0x93, 0x83, 0x3e, 0xd5,
+	0x98, 0xe7, 0x5c, 0xba, 0x07, 0x34, 0x03, 0x12, 0x83, 0x57, 0x62, 0xa5, 0xc4, 0xb2, 0x69, 0x38,
+	0x2c, 0x1b, 0x57, 0x65, 0x0a, 0x74, 0xbb, 0x4c, 0xb1, 0x7e, 0x45, 0xa6, 0x78, 0x0f, 0xd6, 0x2d,
+	0x32, 0xa0, 0x29, 0xcc, 0x50, 0x03, 0x87, 0x1f, 0x44, 0x88, 0x21, 0x6e, 0xb0, 0xa9, 0x85, 0xe8,
+	0x51, 0xdf, 0xa1, 0x81, 0x27, 0x1b, 0xdb, 0xbf, 0x4e, 0x41, 0x71, 0x4a, 0x1d, 0xd4, 0x8c, 0x8c,
+	0x9a, 0x5a, 0xa8, 0x97, 0x0a, 0x0d, 0xdb, 0x83, 0xe2, 0xc4, 0xb0, 0x36, 0x0e, 0xc4, 0xa5, 0x85,
+	0xd8, 0x56, 0x63, 0x92, 0x03, 0x1c, 0x6c, 0xff, 0x2a, 0x0d, 0xb9, 0xc8, 0x70, 0x97, 0x2a, 0x30,
+	0x4d, 0x15, 0xa1, 0xb2, 0x4b, 0x61, 0xaa, 0x60, 0x2a, 0xa2, 0x0d, 0x58, 0x76, 0xce, 0x6c, 0xec,
+	0x85, 0x75, 0x96, 0xff, 0x40, 0x1d, 0x28, 0x98, 0xce, 0x19, 0xed, 0x5e, 0x98, 0xb2, 0x99, 0x85,
+	0x96, 0x07, 0x8c, 0x82, 0x07, 0x65, 0x07, 0x0a, 0x43, 0xd7, 0x8d, 0x09, 0x97, 0x17, 0x23, 0x64,
+	0x14, 0x9c, 0xb0, 0x05, 0xf9, 0x58, 0x7b, 0x31, 0xbb, 0x10, 0xdd, 0x84, 0x00, 0xa9, 0x90, 0x39,
+	0xc1, 0xd8, 0x7f, 0x1b, 0x47, 0x35, 0x46, 0xbc, 0xfd, 0x8f, 0x29, 0x28, 0x50, 0xa7, 0xaa, 0xe9,
+	0xba, 0x37, 0xd4, 0xcc, 0xe4, 0x7e, 0xa4, 0xa6, 0xf6, 0x63, 0x0f, 0xb2, 0xaf, 0x1d, 0x73, 0x68,
+	0xe1, 0x05, 0x7c, 0x82, 0x16, 0x9e, 0x10, 0x8d, 0xea, 0xa1, 0x46, 0xe9, 0x85, 0x58, 0xf8, 0xa2,
+	0xff, 0x2f, 0x05, 0xeb, 0x89, 0x45, 0x47, 0x0d, 0xe6, 0xf5, 0x8b, 0xa7, 0xa5, 0x8d, 0x84, 0x4b,
+	0x2f, 0x3c, 0xab, 0x5c, 0x2a, 0xbf, 0xfd, 0xe8, 0x4a, 0x84, 0x77, 0x9b, 0xbf, 0xa4, 0x05, 0x98,
+	0x21, 0x12, 0x6a, 0xa7, 0xdf, 0x88, 0xda, 0x99, 0x6f, 0xa0, 0xf6, 0x6f, 0x96, 0xa0, 0x4c, 0xbb,
+	0xec, 0x9a, 0xae, 0x0f, 0xad, 0xa1, 0xc9, 0xea, 0x66, 0xa2, 0xd4, 0xa6, 0xa6, 0x4a, 0xed, 0x11,
+	0x08, 0xa1, 0x14, 0x6b, 0xfb, 0x99, 0x77, 0x2f, 0x16, 0xcd, 0xe5, 0x09, 0x0f, 0x77, 0xf1, 0xf6,
+	0x54, 0x63, 0x9a, 0x5e, 0xd0, 0xc7, 0xe3, 0xe6, 0xb4, 0x05, 0x65, 0x46, 0x37, 0x74, 0x0d, 0x96,
+	0xff, 0xb4, 0x40, 0xcc, 0xcc, 0xb1, 0x4f, 0xac, 0x91, 0x3e, 0xe4, 0xd8, 0x1a, 0xab, 0x44, 0x8c,
+	0x2d, 0x3a, 0xa9, 0xa8, 0x3e, 0x7e, 0x15, 0x76, 0xd7, 0x6c, 0x9a, 0xc8, 0x59, 0x7a, 0xf8, 0xd5,
+	0xf6, 0x6f, 0x52, 0xb0, 0x9a, 0x3c, 0xb6, 0x5c, 0x6f, 0x4d, 0x01, 0xd2, 0x94, 0x87, 0xa7, 0x28,
+	0xfa, 0x6f, 0xec, 0x52, 0xe9, 0xb9, 0x5d, 0xea, 0xaa, 0x9d, 0xc9, 0xbc, 0x91, 0x9d, 0xd9, 0xfe,
+	0xf7, 0x0c, 0x64, 0xf9, 0xa1, 0xe7, 0x7a, 0x55, 0x3e, 0x80, 0x5c, 0x74, 0xfa, 0x0a, 0xe3, 0xe1,
+	0x56, 0x87, 0xaf, 0x18, 0x84, 0x6a, 0x90, 0x77, 0x5c, 0x6c, 0xab, 0x73, 0xab, 0x9f, 0xa3, 0xb0,
+	0x3e, 0xe1, 0xd1, 0x40, 0xff, 0x5f, 0x50, 0x6d, 0x86, 0xa5, 0x1c, 0xa7, 0x64, 0x70, 0xba, 0x60,
+	0xca, 0x66, 0x58, 0xf4, 0x33, 0x48, 0x9b, 0xce, 0xd9, 0x82, 0x69, 0x9a, 0x42, 0x69, 0xdd, 0xd5,
+	0x4d, 0xc7, 0x8f, 0x5a, 0xcc, 0xb9, 0xeb, 0x2e, 0x03, 0xd3, 0x2a, 0xc4, 0x1a, 0xad, 0x30, 0xd5,
+	0x2c, 0x76, 0x2d, 0x06, 0x94, 0xe2, 0x13, 0x9e, 0x6e, 0x3e, 0x86, 0x55, 0xde, 0x8f, 0x85, 0x8c,
+	0xf9, 0x85, 0x18, 0x0b, 0x8c, 0x83, 0x53, 0x6e, 0xff, 0x77, 0x1a, 0x4a, 0xd3, 0xcd, 0xe9, 0xed,
+	0x8b, 0xf9, 0x23, 0x00, 0xcb, 0x1f, 0xa8, 0xa7, 0xbc, 0x2f, 0xa4, 0x3e, 0x93, 0x56, 0xf2, 0x96,
+	0x3f, 0xf8, 0x90, 0x0d, 0xa0, 0x87, 0x90, 0x0f, 0x9b, 0xa6, 0xf8, 0x60, 0x30, 0x19, 0x40, 0x2e,
+	0x14, 0xc3, 0x1f, 0xac, 0xbb, 0xa4, 0x07, 0x83, 0x37, 0x5e, 0x0c, 0x57, 0xc3, 0x19, 0xd8, 0x2f,
+	0xe4, 0x41, 0x49, 0xd3, 0x75, 0xec, 0xd2, 0x6c, 0xc4, 0xa7, 0x7c, 0x0b, 0x77, 0xb4, 0xc5, 0x68,
+	0x0a, 0x3e, 0xa7, 0x0c, 0xf4, 0x68, 0x46, 0x67, 0x8c, 0x8f, 0x37, 0xcc, 0xa7, 0x6e, 0x9c, 0x35,
+	0x6c, 0x62, 0x39, 0x30, 0xba, 0x6b, 0x46, 0x35, 0xc8, 0xfa, 0x81, 0x16, 0x0c, 0xfd, 0xf0, 0xe0,
+	0x7e, 0x63, 0x57, 0x1c, 0xee, 0x65, 0x8f, 0x01, 0x94, 0x10, 0xb8, 0xfd, 0x97, 0x59, 0xb8, 0xdf,
+	0x23, 0xf6, 0xc0, 0xc4, 0x3d, 0x62, 0x60, 0xe3, 0xf7, 0xb2, 0xef, 0x75, 0x58, 0x4d, 0xee, 0xbb,
+	0xb8, 0x7c, 0x3b, 0x6b, 0x14, 0x12, 0x5b, 0x89, 0xba, 0xb0, 0x41, 0x4f, 0x81, 0x97, 0x2c, 0x9b,
+	0xbd, 0x1d, 0xd7, 0x9a, 0x45, 0xec, 0xf6, 0xb4, 0x71, 0xeb, 0xb0, 0x4a, 0xef, 0x33, 0x5d, 0x6c,
+	0xcc, 0xb5, 0x47, 0x85, 0x10, 0xc4, 0x38, 0x9a, 0x50, 0xf4, 0xb0, 0x8e, 0xc9, 0xeb, 0x88, 0x24,
+	0x77, 0x3b, 0x92, 0xd5, 0x08, 0xc5, 0x58, 0x2e, 0x7b, 0x69, 0xfe, 0xad, 0x7b, 0xa9, 0x07, 0x25,
+	0x0f, 0x9f, 0x0c, 0x6d, 0x23, 0x9e, 0x13, 0xde, 0xc2, 0x9c, 0xd1, 0x14, 0xd7, 0x47, 0x46, 0xe1,
+	0x9b, 0x46, 0xc6, 0xea, 0xa2, 0x91, 0xf1, 0x2f, 0x69, 0x28, 0xcf, 0x9c, 0xb5, 0xdf, 0x58, 0x3c,
+	0x6c, 0x01, 0x44, 0xa7, 0x7c, 0x1c, 0x05, 0x44, 0x62, 0x04, 0xfd, 0x04, 0xf2, 0x13, 0x13, 0xdc,
+	0x32, 0x1c, 0x72, 0xd1, 0xb5, 0x08, 0x0a, 0x20, 0xbe, 0x31, 0xb7, 0xdf, 0x5e, 0x5a, 0x2b, 0xc5,
+	0x73, 0xf0, 0xdd, 0x9b, 0x98, 0x7c, 0x65, 0x41, 0x93, 0xa3, 0x3e, 0xac, 0xfa, 0x2c, 0x17, 0xa9,
+	0x3e, 0x4d, 0x46, 0x61, 0xb4, 0xbc, 0x7f, 0x13, 0x51, 0x22, 0x77, 0x45, 0x9b, 0xa5, 0x99, 0x4a,
+	0xc1, 0x9f, 0x0c, 0x6f, 0xff, 0x6f, 0x0a, 0x36, 0xaf, 0x14, 0x43, 0x6d, 0x7e, 0xd7, 0x36, 0x6d,
+	0x2c, 0xb6, 0xbd, 0xb7, 0xb0, 0x37, 0xf5, 0xd5, 0x17, 0x49, 0x13, 0x5c, 0xca, 0x18, 0x4b, 0x6f,
+	0x22, 0x63, 0xa4, 0x17, 0xc8, 0x18, 0xdb, 0x5f, 0xa5, 0xa1, 0xd0, 0x3b, 0xd3, 0xdc, 0xeb, 0xfc,
+	0x76, 0xda, 0x3d, 0x97, 0x66, 0xdd, 0x53, 0x84, 0x15, 0x76, 0x69, 0x11, 0x1f, 0xca, 0xa3, 0x9f,
+	0xe8, 0x3e, 0xe4, 0xc2, 0x66, 0x93, 0x9e, 0x70, 0xd2, 0x4f, 0x32, 0xca, 0x0a, 0xef, 0x36, 0x7d,
+	0xf4, 0x53, 0x00, 0xe7, 0xe4, 0x04, 0x7b, 0x73, 0x39, 0x6d, 0x9e, 0x41, 0xc2, 0x90, 0x5d, 0xa5,
+	0x9b, 0xe1, 0x0c, 0x83, 0xb9, 0x32, 0x37, 0xd0, 0x7b, 0xce, 0x21, 0x2f, 0x02, 0x7f, 0x04, 0xb9,
+	0x18, 0x7e, 0xcb, 0x74, 0xbd, 0xe2, 0x84, 0xd8, 0xcb, 0x09, 0x2f, 0xf7, 0xd6, 0x13, 0xde, 0x24,
+	0x64, 0xf2, 0x8b, 0x66, 0xa9, 0xaf, 0xf2, 0xb0, 0xcc, 0xae, 0x79, 0xd1, 0xf3, 0xa9, 0x5b, 0xdd,
+	0x77, 0x6e, 0xa2, 0x62, 0x80, 0x45, 0xae, 0x75, 0xa7, 0xfd, 0x26, 0x73, 0x83, 0xdf, 0x2c, 0x4f,
+	0xfb, 0xcd, 0x87, 0x90, 0x37, 0x88, 0x87, 0x75, 0x76, 0x05, 0x99, 0x65, 0x2b, 0x7c, 0xfa, 0xb5,
+	0x2b, 0x6c, 0x46, 0x08, 0x65, 0x02, 0x9e, 0x71, 0xb3, 0x95, 0xb9, 0xdd, 0xec, 0x63, 0xd8, 0xf0,
+	0xb0, 0xa5, 0x11, 0x9b, 0xbd, 0x82, 0x9d, 0x30, 0xdd, 0xb2, 0x32, 0xa3, 0x18, 0xdc, 0x89, 0x29,
+	0x2f, 0xc5, 0x6c, 0x7e, 0x91, 0x2a, 0x1f, 0x5f, 0xec, 0xc1, 0x37, 0xb9, 0xd8, 0xdb, 0x83, 0x6c,
+	0xf8, 0xca, 0xbd, 0xb0, 0xd8, 0x35, 0x06, 0x47, 0xd3, 0x83, 0x0a, 0x3b, 0xfb, 0x85, 0x64, 0xab,
+	0x8b, 0x1d, 0x54, 0x28, 0x45, 0xf8, 0xca, 0xfe, 0x3e, 0xe4, 0xe2, 0x37, 0x52, 0x45, 0xe6, 0x54,
+	0x2b, 0xc7, 0xe1, 0xab, 0xa8, 0x1a, 0xe4, 0xf1, 0xb9, 0x4b, 0x3c, 0xac, 0x6a, 0xfc, 0x2e, 0xf9,
+	0xd6, 0xe7, 0x4c, 0x0e, 0xab, 0x05, 0xe8, 0x83, 0x38, 0x92, 0xca, 0xcc, 0xb9, 0xbe, 0xf3, 0xb5,
+	0xce, 0x35, 0x53, 0x7a, 0x3a, 0x50, 0x0c, 0x3c, 0x32, 0x18, 0xc4, 0x17, 0x84, 0xc2, 0x02, 0x2f,
+	0x98, 0x38, 0x01, 0xbf, 0xec, 0x38, 0x82, 0xb5, 0x88, 0x50, 0x77, 0x6c, 0x83, 0x5d, 0x8a, 0x8a,
+	0x6b, 0x5f, 0xff, 0x7e, 0xad, 0xcf, 0x41, 0x8d, 0x08, 0xa3, 0x08, 0xc1, 0xcc, 0x08, 0x7a, 0x01,
+	0x1b, 0xe1, 0x18, 0x36, 0xa2, 0xcf, 0x11, 0x68, 0xe4, 0xa3, 0x79, 0x22, 0x1f, 0xc5, 0x14, 0xf1,
+	0x18, 0xfa, 0x08, 0x8a, 0xf4, 0xac, 0xaf, 0x12, 0x5b, 0x3d, 0x71, 0x3c, 0x1d, 0x8b, 0xeb, 0x5f,
+	0x6f, 0x4c, 0xba, 0x2f, 0xb2, 0xbd, 0x47, 0xc5, 0x95, 0x42, 0x30, 0xf9, 0x81, 0x1e, 0xd0, 0x1e,
+	0x86, 0xbe, 0xf9, 0xb4, 0xcd, 0x0b, 0x76, 0xbf, 0x9d, 0xa3, 0x2d, 0x8a, 0x1f, 0x74, 0x6c, 0xf3,
+	0x02, 0xbd, 0x0f, 0x69, 0xfa, 0x61, 0xca, 0xe6, 0xed, 0x02, 0x85, 0xca, 0x3e, 0xfd, 0xfb, 0x25,
+	0xc8, 0x45, 0xaf, 0xa3, 0xe8, 0x97, 0x43, 0xdd, 0x4e, 0xa7, 0xa5, 0xf6, 0x8f, 0xba, 0x92, 0x7a,
+	0x78, 0xd0, 0xeb, 0x4a, 0x0d, 0x79, 0x4f, 0x96, 0x9a, 0xc2, 0x9d, 0xca, 0xbd, 0xd1, 0xb8, 0xba,
+	0x1e, 0x09, 0x1e, 0xda, 0xbe, 0x8b, 0x75, 0x72, 0x42, 0x30, 0x7b, 0x07, 0x3c, 0xc1, 0xd4, 0x6b,
+	0x3d, 0xb9, 0x21, 0xa4, 0x2a, 0x6b, 0xa3, 0x71, 0xb5, 0x18, 0x49, 0xd7, 0x35, 0x9f, 0xe8, 0xf4,
+	0xd5, 0xc0, 0x44, 0x4e, 0xa9, 0x1d, 0xec, 0x4b, 0x4d, 0x61, 0xa9, 0x82, 0x46, 0xe3, 0x6a, 0x29,
+	0x12, 0x54, 0x34, 0x7b, 0x80, 0x8d, 0x69, 0xc9, 0x5e, 0xbf, 0x56, 0x6f, 0x49, 0x42, 0x7a, 0x5a,
+	0xb2, 0x17, 0xd0, 0xf7, 0x30, 0xe8, 0xbb, 0x80, 0x26, 0x92, 0x2f, 0x24, 0x79, 0xff, 0xc3, 0xbe,
+	0xd4, 0x14, 0x32, 0x95, 0x8d, 0xd1, 0xb8, 0x2a, 0x44, 0xb2, 0xfc, 0xb5, 0x09, 0x36, 0xe8, 0x37,
+	0x4e, 0x13, 0xe9, 0x46, 0xe7, 0xa0, 0x21, 0x1d, 0xf4, 0x95, 0x1a, 0x45, 0x2c, 0x57, 0xc4, 0xd1,
+	0xb8, 0xba, 0x11, 0x21, 0x1a, 0x8e, 0x4d, 0xb7, 0x85, 0xbd, 0x33, 0xa8, 0x64, 0xfe, 0xfc, 0xef,
+	0xb6, 0xee, 0x3c, 0xfd, 0x9f, 0x14, 0xe4, 0x27, 0x3b, 0xfa, 0x03, 0xb8, 0xdb, 0x51, 0x9a, 0x92,
+	0x72, 0x95, 0xa1, 0x18, 0x53, 0x2c, 0x9a, 0xb4, 0xd4, 0x13, 0x10, 0x12, 0xa8, 0x96, 0xdc, 0x96,
+	0xfb, 0x42, 0x8a, 0xeb, 0x15, 0xcb, 0xb3, 0x4f, 0x65, 0xe8, 0x25, 0x5c, 0x42, 0xb2, 0x5d, 0x53,
+	0x3e, 0x92, 0xfa, 0xc2, 0x52, 0x65, 0x7d, 0x34, 0xae, 0x96, 0x63, 0x51, 0xfe, 0x2d, 0x0b, 0x7d,
+	0x15, 0x9e, 0x94, 0x6d, 0x0b, 0xe9, 0x4a, 0x79, 0x34, 0xae, 0x16, 0x26, 0x72, 0x6d, 0x6a, 0xa7,
+	0x84, 0x4c, 0x5f, 0x91, 0xf7, 0xf7, 0x25, 0x25, 0xb2, 0x53, 0x2c, 0x18, 0xc6, 0x48, 0xa8, 0xf1,
+	0xbf, 0xa6, 0xa0, 0x90, 0xf0, 0x42, 0xf4, 0x1c, 0xee, 0xf7, 0xe5, 0xb6, 0xa4, 0xca, 0x07, 0xea,
+	0x5e, 0x47, 0x69, 0xcc, 0xaa, 0x5d, 0x19, 0x8d, 0xab, 0x77, 0x13, 0xf2, 0x49, 0xc5, 0xf7, 0xe1,
+	0x5b, 0xd3, 0x50, 0xb9, 0xdd, 0x96, 0x9a, 0x72, 0xad, 0x2f, 0xa9, 0x1d, 0x45, 0x6d, 0xd4, 0x0e,
+	0x1a, 0x52, 0x4b, 0x48, 0x55, 0xaa, 0xa3, 0x71, 0xf5, 0x61, 0x82, 0x42, 0xb6, 0x2c, 0x6c, 0x10,
+	0x2d, 0xc0, 0x1d, 0xaf, 0xa1, 0xd9, 0x3a, 0x36, 0xd1, 0x73, 0xa8, 0x4c, 0x13, 0xed, 0xc9, 0xad,
+	0x16, 0xe5, 0xf8, 0x48, 0x6e, 0xb5, 0x84, 0xa5, 0xca, 0xfd, 0xd1, 0xb8, 0xba, 0x99, 0x60, 0xd8,
+	0x23, 0xa6, 0xd9, 0xf1, 0x3e, 0x22, 0xa6, 0x19, 0x2a, 0xf5, 0xcf, 0x29, 0x10, 0x66, 0x53, 0x01,
+	0xaa, 0xc3, 0xa3, 0xd0, 0x24, 0xd4, 0x2b, 0x9a, 0x72, 0x5f, 0xee, 0x1c, 0xcc, 0x68, 0xf7, 0x78,
+	0x34, 0xae, 0x3e, 0x98, 0x05, 0x26, 0x55, 0x7c, 0x06, 0x9b, 0x97, 0x39, 0xf6, 0xfb, 0x92, 0x90,
+	0xe2, 0x91, 0x33, 0x8b, 0xdd, 0xef, 0x4b, 0x57, 0x63, 0x5a, 0x7d, 0x49, 0x58, 0xba, 0x1a, 0xd3,
+	0xea, 0x4b, 0xa1, 0x1a, 0xff, 0x94, 0x82, 0xd2, 0x74, 0x2d, 0x47, 0x3f, 0x85, 0x07, 0x7c, 0x8b,
+	0x9b, 0xb2, 0x22, 0x35, 0xae, 0x50, 0xe1, 0xd1, 0x68, 0x5c, 0xbd, 0x3f, 0x0d, 0x4a, 0x2a, 0xb0,
+	0x03, 0xeb, 0xb3, 0xf8, 0xfa, 0xe1, 0x91, 0x90, 0xaa, 0x6c, 0x8e, 0xc6, 0xd5, 0xb5, 0x69, 0x5c,
+	0x7d, 0x78, 0x81, 0xbe, 0x07, 0x1b, 0xb3, 0xf2, 0x3d, 0x89, 0x6d, 0xc2, 0xdd, 0xd1, 0xb8, 0x8a,
+	0xa6, 0x01, 0x3d, 0x1c, 0xef, 0xc0, 0x9f, 0x2d, 0x41, 0x71, 0xaa, 0xe7, 0x42, 0x3f, 0x81, 0x8a,
+	0x22, 0x7d, 0x7c, 0x28, 0xf5, 0xfa, 0x34, 0xd8, 0xfb, 0x87, 0xbd, 0x99, 0x85, 0x3f, 0x1c, 0x8d,
+	0xab, 0xe2, 0x14, 0x24, 0xb9, 0xee, 0x3f, 0x81, 0x07, 0x33, 0xe8, 0x83, 0x4e, 0x5f, 0x95, 0x3e,
+	0x95, 0x1a, 0x87, 0x34, 0xb2, 0x53, 0x57, 0xc0, 0x0f, 0x9c, 0x40, 0x3a, 0xc7, 0xfa, 0x90, 0xe6,
+	0x84, 0x1f, 0x83, 0x38, 0x03, 0xef, 0x1d, 0x36, 0x1a, 0x92, 0xd4, 0x64, 0xd9, 0x89, 0x39, 0xf5,
+	0x14, 0xb6, 0x37, 0xd4, 0x75, 0x8c, 0x0d, 0xbe, 0xe3, 0x33, 0xc8, 0xbd, 0x9a, 0xdc, 0x92, 0x9a,
+	0x42, 0x9a, 0xef, 0xde, 0x14, 0x6c, 0x4f, 0x23, 0x66, 0x9c, 0x4b, 0xfe, 0x36, 0x0d, 0x85, 0x44,
+	0xb1, 0xa4, 0x6b, 0xe0, 0xa6, 0xbc, 0x52, 0x7d, 0xb6, 0x86, 0x84, 0x78, 0x52, 0xf9, 0xe7, 0x70,
+	0x7f, 0x0a, 0x39, 0xa3, 0xfa, 0x2c, 0x34, 0xa9, 0xf8, 0x8f, 0x40, 0xbc, 0x04, 0x6d, 0xd7, 0xfa,
+	0x8d, 0x0f, 0xa5, 0x66, 0x14, 0x48, 0xd3, 0xc8, 0x36, 0x6d, 0x2b, 0xb0, 0x81, 0x1a, 0xb0, 0x35,
+	0x05, 0xec, 0xd6, 0x94, 0xbe, 0x5c, 0x6b, 0xb5, 0x8e, 0x62, 0x78, 0x9a, 0x87, 0x4b, 0x02, 0xde,
+	0xd5, 0x3c, 0xfa, 0xb5, 0xa1, 0x79, 0x11, 0x91, 0xc4, 0x09, 0x34, 0x24, 0x69, 0x74, 0xda, 0xdd,
+	0x96, 0xc4, 0x93, 0xf7, 0x24, 0x81, 0x72, 0x70, 0xc3, 0xb1, 0x5c, 0x13, 0x07, 0xdc, 0xe4, 0xd3,
+	0x28, 0x96, 0x39, 0x58, 0xfe, 0x66, 0x26, 0x4f, 0x82, 0x58, 0xc2, 0xc0, 0xc6, 0xc4, 0x4f, 0x43,
+	0x8c, 0xf4, 0x69, 0x57, 0x56, 0xa4, 0xa6, 0x90, 0x4d, 0xf8, 0x29, 0x87, 0x48, 0xac, 0xeb, 0x89,
+	0x36, 0xe9, 0x2f, 0x52, 0x20, 0xcc, 0x7e, 0x94, 0x43, 0x5d, 0xb5, 0xd6, 0x6a, 0x75, 0x1a, 0x35,
+	0xe6, 0xef, 0xdd, 0x4e, 0x4b, 0x6e, 0x1c, 0xa9, 0x5d, 0x45, 0xee, 0x28, 0x72, 0xff, 0x28, 0x72,
+	0xd5, 0x59, 0x54, 0xd7, 0x23, 0x8e, 0x47, 0x5f, 0x46, 0xfe, 0xf1, 0xd5, 0xe8, 0x8e, 0xaa, 0xd4,
+	0xfa, 0x35, 0x21, 0x55, 0x79, 0x30, 0x1a, 0x57, 0xef, 0x5d, 0x46, 0x3b, 0x8a, 0x16, 0x68, 0x7c,
+	0x55, 0xf5, 0x17, 0x9f, 0xfd, 0xd7, 0xd6, 0x9d, 0xcf, 0xbe, 0xd8, 0x4a, 0xfd, 0xf6, 0x8b, 0xad,
+	0xd4, 0x7f, 0x7e, 0xb1, 0x95, 0xfa, 0xe5, 0x97, 0x5b, 0x77, 0x7e, 0xfb, 0xe5, 0xd6, 0x9d, 0x7f,
+	0xfb, 0x72, 0xeb, 0xce, 0xcf, 0x9f, 0x27, 0x5b, 0xaa, 0xb0, 0xb7, 0x78, 0xcf, 0xc6, 0xc1, 0x99,
+	0xe3, 0xbd, 0x8c, 0x07, 0x76, 0x5f, 0xff, 0x70, 0xf7, 0x3c, 0xf1, 0x25, 0x35, 0xeb, 0xb4, 0x8e,
+	0xb3, 0xac, 0x23, 0xfc, 0xfe, 0xff, 0x0f, 0x00, 0xf7, 0x7c, 0x25, 0x8b, 0x6c, 0x2d, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -693,6 +1440,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxNumCandles != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxNumCandles))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.CandleIntervals) > 0 {
+		for iNdEx := len(m.CandleIntervals) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CandleIntervals[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CandleIntervals[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintLiquidity(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.MaxNumTWAPSnapshots != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxNumTWAPSnapshots))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
 	if m.MaxNumActivePoolsPerPair != 0 {
 		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxNumActivePoolsPerPair))
 		i--
@@ -862,6 +1637,77 @@ func (m *Pair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinOrderNotional != nil {
+		{
+			size := m.MinOrderNotional.Size()
+			i -= size
+			if _, err := m.MinOrderNotional.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.TickPrecision != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdUInt32MarshalTo(*m.TickPrecision, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdUInt32(*m.TickPrecision):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintLiquidity(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.OrdersPaused {
+		i--
+		if m.OrdersPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.DepositsPaused {
+		i--
+		if m.DepositsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TakerFeeRate != nil {
+		{
+			size := m.TakerFeeRate.Size()
+			i -= size
+			if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MakerFeeRate != nil {
+		{
+			size := m.MakerFeeRate.Size()
+			i -= size
+			if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.AllocationPolicy != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.AllocationPolicy))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CurrentBatchId != 0 {
 		i = encodeVarintLiquidity(dAtA, i, uint64(m.CurrentBatchId))
 		i--
@@ -933,6 +1779,87 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MigratedToPoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MigratedToPoolId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.OrdersPaused {
+		i--
+		if m.OrdersPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.DepositsPaused {
+		i--
+		if m.DepositsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.LastPositionId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.LastPositionId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.LiquidityTicks) > 0 {
+		for iNdEx := len(m.LiquidityTicks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityTicks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.Price != nil {
+		{
+			size := m.Price.Size()
+			i -= size
+			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.QuoteCoinWeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.QuoteCoinWeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BaseCoinWeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BaseCoinWeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Amplification != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.Disabled {
 		i--
 		if m.Disabled {
@@ -1016,7 +1943,7 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DepositRequest) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityTick) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1026,35 +1953,63 @@ func (m *DepositRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DepositRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityTick) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityTick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x40
-	}
 	{
-		size, err := m.MintedPoolCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
 		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
-	if len(m.AcceptedCoins) > 0 {
-		for iNdEx := len(m.AcceptedCoins) - 1; iNdEx >= 0; iNdEx-- {
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AcceptedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1062,34 +2017,45 @@ func (m *DepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintLiquidity(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.DepositCoins) > 0 {
-		for iNdEx := len(m.DepositCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DepositCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLiquidity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x22
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.UpperPrice.Size()
+		i -= size
+		if _, err := m.UpperPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.MsgHeight != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LowerPrice.Size()
+		i -= size
+		if _, err := m.LowerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
 	if m.PoolId != 0 {
 		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
@@ -1104,7 +2070,7 @@ func (m *DepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WithdrawRequest) Marshal() (dAtA []byte, err error) {
+func (m *PoolAccrual) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1114,71 +2080,101 @@ func (m *WithdrawRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolAccrual) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolAccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.WithdrawnCoins) > 0 {
-		for iNdEx := len(m.WithdrawnCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WithdrawnCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLiquidity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	{
-		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.Withdrawer) > 0 {
-		i -= len(m.Withdrawer)
-		copy(dAtA[i:], m.Withdrawer)
-		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Withdrawer)))
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x8
 	}
-	if m.MsgHeight != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
-		i--
-		dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolAccrualSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAccrualSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAccrualSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
 	}
+	i -= n3
+	i = encodeVarintLiquidity(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
 	if m.PoolId != 0 {
 		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
-		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Order) Marshal() (dAtA []byte, err error) {
+func (m *TWAPAccumulator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1188,413 +2184,4103 @@ func (m *Order) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+func (m *TWAPAccumulator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TWAPAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
+	if m.LastSnapshotSeq != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.LastSnapshotSeq))
 		i--
-		dAtA[i] = 0x78
+		dAtA[i] = 0x28
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpireAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt):])
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdatedAt):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintLiquidity(dAtA, i, uint64(n4))
 	i--
-	dAtA[i] = 0x72
-	if m.BatchId != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.BatchId))
-		i--
-		dAtA[i] = 0x68
-	}
+	dAtA[i] = 0x22
 	{
-		size := m.OpenAmount.Size()
+		size := m.LastPrice.Size()
 		i -= size
-		if _, err := m.OpenAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x62
+	dAtA[i] = 0x1a
 	{
-		size := m.Amount.Size()
+		size := m.CumulativePrice.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x5a
+	dAtA[i] = 0x12
+	if m.PairId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TWAPSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TWAPSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TWAPSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.Price.Size()
+		size := m.CumulativePrice.Size()
 		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x52
+	dAtA[i] = 0x22
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintLiquidity(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.Seq != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PairId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size, err := m.ReceivedCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.QuoteVolume.Size()
+		i -= size
+		if _, err := m.QuoteVolume.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x4a
 	{
-		size, err := m.RemainingOfferCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.BaseVolume.Size()
+		i -= size
+		if _, err := m.BaseVolume.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x42
 	{
-		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x3a
-	if m.Direction != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.Direction))
-		i--
-		dAtA[i] = 0x30
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if len(m.Orderer) > 0 {
-		i -= len(m.Orderer)
-		copy(dAtA[i:], m.Orderer)
-		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Orderer)))
-		i--
-		dAtA[i] = 0x2a
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.MsgHeight != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
-		i--
-		dAtA[i] = 0x20
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.PairId != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
-		i--
-		dAtA[i] = 0x18
+	i--
+	dAtA[i] = 0x22
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.OpenTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.OpenTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	if m.Id != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+	i -= n6
+	i = encodeVarintLiquidity(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err7 != nil {
+		return 0, err7
 	}
-	if m.Type != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.Type))
+	i -= n7
+	i = encodeVarintLiquidity(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.PairId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLiquidity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BatchSize != 0 {
-		n += 1 + sovLiquidity(uint64(m.BatchSize))
-	}
-	if m.TickPrecision != 0 {
-		n += 1 + sovLiquidity(uint64(m.TickPrecision))
-	}
-	l = len(m.FeeCollectorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	l = len(m.DustCollectorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = m.MinInitialPoolCoinSupply.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if len(m.PairCreationFee) > 0 {
-		for _, e := range m.PairCreationFee {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	{
+		size, err := m.MintedPoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if len(m.PoolCreationFee) > 0 {
-		for _, e := range m.PoolCreationFee {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.AcceptedCoins) > 0 {
+		for iNdEx := len(m.AcceptedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	l = m.MinInitialDepositAmount.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.MaxPriceLimitRatio.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if m.MaxNumMarketMakingOrderTicks != 0 {
-		n += 1 + sovLiquidity(uint64(m.MaxNumMarketMakingOrderTicks))
-	}
-	if m.MaxNumMarketMakingOrdersPerPair != 0 {
-		n += 1 + sovLiquidity(uint64(m.MaxNumMarketMakingOrdersPerPair))
+	if len(m.DepositCoins) > 0 {
+		for iNdEx := len(m.DepositCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxOrderLifespan)
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.SwapFeeRate.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.WithdrawFeeRate.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if m.DepositExtraGas != 0 {
-		n += 1 + sovLiquidity(uint64(m.DepositExtraGas))
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.WithdrawExtraGas != 0 {
-		n += 2 + sovLiquidity(uint64(m.WithdrawExtraGas))
+	if m.MsgHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.OrderExtraGas != 0 {
-		n += 2 + sovLiquidity(uint64(m.OrderExtraGas))
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.MaxNumActivePoolsPerPair != 0 {
-		n += 2 + sovLiquidity(uint64(m.MaxNumActivePoolsPerPair))
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Pair) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SingleSidedDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SingleSidedDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SingleSidedDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
-	}
-	l = len(m.BaseCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x60
 	}
-	l = len(m.QuoteCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	{
+		size, err := m.MintedPoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = len(m.EscrowAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.RefundedCoins) > 0 {
+		for iNdEx := len(m.RefundedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.LastOrderId != 0 {
-		n += 1 + sovLiquidity(uint64(m.LastOrderId))
+	if len(m.AcceptedCoins) > 0 {
+		for iNdEx := len(m.AcceptedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	if m.LastPrice != nil {
-		l = m.LastPrice.Size()
-		n += 1 + l + sovLiquidity(uint64(l))
+	{
+		size, err := m.ReceivedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.CurrentBatchId != 0 {
-		n += 1 + sovLiquidity(uint64(m.CurrentBatchId))
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.SwappedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.MinMintedPoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovLiquidity(uint64(m.Type))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.DepositCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.PairId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PairId))
+	if m.MsgHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ReserveAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.PoolCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	if m.MinPrice != nil {
-		l = m.MinPrice.Size()
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	if m.MaxPrice != nil {
-		l = m.MaxPrice.Size()
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	if m.LastDepositRequestId != 0 {
-		n += 1 + sovLiquidity(uint64(m.LastDepositRequestId))
-	}
-	if m.LastWithdrawRequestId != 0 {
-		n += 1 + sovLiquidity(uint64(m.LastWithdrawRequestId))
-	}
-	if m.Disabled {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DepositRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PoolId))
-	}
-	if m.MsgHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	if m.SingleSided != nil {
+		{
+			size, err := m.SingleSided.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.DepositCoins) > 0 {
-		for _, e := range m.DepositCoins {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.WithdrawnCoins) > 0 {
+		for iNdEx := len(m.WithdrawnCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawnCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.AcceptedCoins) > 0 {
-		for _, e := range m.AcceptedCoins {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	{
+		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = m.MintedPoolCoin.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovLiquidity(uint64(m.Status))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Withdrawer) > 0 {
+		i -= len(m.Withdrawer)
+		copy(dAtA[i:], m.Withdrawer)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Withdrawer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if m.MsgHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *WithdrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SingleSidedWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SingleSidedWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SingleSidedWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PoolId))
-	}
-	if m.MsgHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgHeight))
-	}
-	l = len(m.Withdrawer)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	{
+		size, err := m.ReceivedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = m.PoolCoin.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if len(m.WithdrawnCoins) > 0 {
-		for _, e := range m.WithdrawnCoins {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.SwappedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.Status != 0 {
-		n += 1 + sovLiquidity(uint64(m.Status))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MinWithdrawnCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Order) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovLiquidity(uint64(m.Type))
+	if m.Status != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
+	if len(m.RefundedCoins) > 0 {
+		for iNdEx := len(m.RefundedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	if m.PairId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PairId))
+	{
+		size, err := m.OutCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.MsgHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.MinOutCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = len(m.Orderer)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.Direction != 0 {
-		n += 1 + sovLiquidity(uint64(m.Direction))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PairIds) > 0 {
+		dAtA23 := make([]byte, len(m.PairIds)*10)
+		var j22 int
+		for _, num := range m.PairIds {
+			for num >= 1<<7 {
+				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j22++
+			}
+			dAtA23[j22] = uint8(num)
+			j22++
+		}
+		i -= j22
+		copy(dAtA[i:], dAtA23[:j22])
+		i = encodeVarintLiquidity(dAtA, i, uint64(j22))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.OfferCoin.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.RemainingOfferCoin.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.ReceivedCoin.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.Price.Size()
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MsgHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.PostOnly {
+		i--
+		if m.PostOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.TriggeredOrderType != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.TriggeredOrderType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.TriggerCondition != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.TriggerCondition))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.TriggerPrice != nil {
+		{
+			size := m.TriggerPrice.Size()
+			i -= size
+			if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Status != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x78
+	}
+	n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpireAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt):])
+	if err25 != nil {
+		return 0, err25
+	}
+	i -= n25
+	i = encodeVarintLiquidity(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x72
+	if m.BatchId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.OpenAmount.Size()
+		i -= size
+		if _, err := m.OpenAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.ReceivedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.RemainingOfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Direction != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MsgHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PairId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchSize != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchSize))
+	}
+	if m.TickPrecision != 0 {
+		n += 1 + sovLiquidity(uint64(m.TickPrecision))
+	}
+	l = len(m.FeeCollectorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.DustCollectorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.MinInitialPoolCoinSupply.Size()
 	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.Amount.Size()
+	if len(m.PairCreationFee) > 0 {
+		for _, e := range m.PairCreationFee {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.MinInitialDepositAmount.Size()
 	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.OpenAmount.Size()
+	l = m.MaxPriceLimitRatio.Size()
 	n += 1 + l + sovLiquidity(uint64(l))
-	if m.BatchId != 0 {
-		n += 1 + sovLiquidity(uint64(m.BatchId))
+	if m.MaxNumMarketMakingOrderTicks != 0 {
+		n += 1 + sovLiquidity(uint64(m.MaxNumMarketMakingOrderTicks))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt)
+	if m.MaxNumMarketMakingOrdersPerPair != 0 {
+		n += 1 + sovLiquidity(uint64(m.MaxNumMarketMakingOrdersPerPair))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxOrderLifespan)
 	n += 1 + l + sovLiquidity(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovLiquidity(uint64(m.Status))
+	l = m.SwapFeeRate.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.WithdrawFeeRate.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.DepositExtraGas != 0 {
+		n += 1 + sovLiquidity(uint64(m.DepositExtraGas))
+	}
+	if m.WithdrawExtraGas != 0 {
+		n += 2 + sovLiquidity(uint64(m.WithdrawExtraGas))
+	}
+	if m.OrderExtraGas != 0 {
+		n += 2 + sovLiquidity(uint64(m.OrderExtraGas))
+	}
+	if m.MaxNumActivePoolsPerPair != 0 {
+		n += 2 + sovLiquidity(uint64(m.MaxNumActivePoolsPerPair))
+	}
+	if m.MaxNumTWAPSnapshots != 0 {
+		n += 2 + sovLiquidity(uint64(m.MaxNumTWAPSnapshots))
+	}
+	if len(m.CandleIntervals) > 0 {
+		for _, e := range m.CandleIntervals {
+			l = github_com_gogo_protobuf_types.SizeOfStdDuration(e)
+			n += 2 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if m.MaxNumCandles != 0 {
+		n += 2 + sovLiquidity(uint64(m.MaxNumCandles))
+	}
+	return n
+}
+
+func (m *Pair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	l = len(m.BaseCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.QuoteCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.LastOrderId != 0 {
+		n += 1 + sovLiquidity(uint64(m.LastOrderId))
+	}
+	if m.LastPrice != nil {
+		l = m.LastPrice.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.CurrentBatchId != 0 {
+		n += 1 + sovLiquidity(uint64(m.CurrentBatchId))
+	}
+	if m.AllocationPolicy != 0 {
+		n += 1 + sovLiquidity(uint64(m.AllocationPolicy))
+	}
+	if m.MakerFeeRate != nil {
+		l = m.MakerFeeRate.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.TakerFeeRate != nil {
+		l = m.TakerFeeRate.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.DepositsPaused {
+		n += 2
+	}
+	if m.OrdersPaused {
+		n += 2
+	}
+	if m.TickPrecision != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdUInt32(*m.TickPrecision)
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.MinOrderNotional != nil {
+		l = m.MinOrderNotional.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovLiquidity(uint64(m.Type))
+	}
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.ReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.PoolCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.MinPrice != nil {
+		l = m.MinPrice.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.MaxPrice != nil {
+		l = m.MaxPrice.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.LastDepositRequestId != 0 {
+		n += 1 + sovLiquidity(uint64(m.LastDepositRequestId))
+	}
+	if m.LastWithdrawRequestId != 0 {
+		n += 1 + sovLiquidity(uint64(m.LastWithdrawRequestId))
+	}
+	if m.Disabled {
+		n += 2
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovLiquidity(uint64(m.Amplification))
+	}
+	if m.BaseCoinWeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.BaseCoinWeight))
+	}
+	if m.QuoteCoinWeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.QuoteCoinWeight))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if len(m.LiquidityTicks) > 0 {
+		for _, e := range m.LiquidityTicks {
+			l = e.Size()
+			n += 2 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if m.LastPositionId != 0 {
+		n += 2 + sovLiquidity(uint64(m.LastPositionId))
+	}
+	if m.DepositsPaused {
+		n += 3
+	}
+	if m.OrdersPaused {
+		n += 3
+	}
+	if m.MigratedToPoolId != 0 {
+		n += 2 + sovLiquidity(uint64(m.MigratedToPoolId))
+	}
+	return n
+}
+
+func (m *LiquidityTick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.LowerPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.UpperPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolAccrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *PoolAccrualSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *TWAPAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.LastPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdatedAt)
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.LastSnapshotSeq != 0 {
+		n += 1 + sovLiquidity(uint64(m.LastSnapshotSeq))
+	}
+	return n
+}
+
+func (m *TWAPSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovLiquidity(uint64(m.Seq))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.OpenTime)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.BaseVolume.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.QuoteVolume.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *DepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if len(m.DepositCoins) > 0 {
+		for _, e := range m.DepositCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.AcceptedCoins) > 0 {
+		for _, e := range m.AcceptedCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.MintedPoolCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLiquidity(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *SingleSidedDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.DepositCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.MinMintedPoolCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.SwappedCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.ReceivedCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.AcceptedCoins) > 0 {
+		for _, e := range m.AcceptedCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.RefundedCoins) > 0 {
+		for _, e := range m.RefundedCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.MintedPoolCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLiquidity(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *WithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	l = len(m.Withdrawer)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.PoolCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.WithdrawnCoins) > 0 {
+		for _, e := range m.WithdrawnCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovLiquidity(uint64(m.Status))
+	}
+	if m.SingleSided != nil {
+		l = m.SingleSided.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	return n
+}
+
+func (m *SingleSidedWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinWithdrawnCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.SwappedCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.ReceivedCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *SwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if len(m.PairIds) > 0 {
+		l = 0
+		for _, e := range m.PairIds {
+			l += sovLiquidity(uint64(e))
+		}
+		n += 1 + sovLiquidity(uint64(l)) + l
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.MinOutCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.OutCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.RefundedCoins) > 0 {
+		for _, e := range m.RefundedCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovLiquidity(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovLiquidity(uint64(m.Type))
+	}
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovLiquidity(uint64(m.Direction))
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.RemainingOfferCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.ReceivedCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.OpenAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.BatchId != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt)
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLiquidity(uint64(m.Status))
+	}
+	if m.TriggerPrice != nil {
+		l = m.TriggerPrice.Size()
+		n += 2 + l + sovLiquidity(uint64(l))
+	}
+	if m.TriggerCondition != 0 {
+		n += 2 + sovLiquidity(uint64(m.TriggerCondition))
+	}
+	if m.TriggeredOrderType != 0 {
+		n += 2 + sovLiquidity(uint64(m.TriggeredOrderType))
+	}
+	if m.TimeInForce != 0 {
+		n += 2 + sovLiquidity(uint64(m.TimeInForce))
+	}
+	if m.PostOnly {
+		n += 3
+	}
+	l = m.Fee.Size()
+	n += 2 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func sovLiquidity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidity(x uint64) (n int) {
+	return sovLiquidity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickPrecision", wireType)
+			}
+			m.TickPrecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickPrecision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollectorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustCollectorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustCollectorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialPoolCoinSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinInitialPoolCoinSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairCreationFee = append(m.PairCreationFee, types.Coin{})
+			if err := m.PairCreationFee[len(m.PairCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinInitialDepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumMarketMakingOrderTicks", wireType)
+			}
+			m.MaxNumMarketMakingOrderTicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumMarketMakingOrderTicks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumMarketMakingOrdersPerPair", wireType)
+			}
+			m.MaxNumMarketMakingOrdersPerPair = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumMarketMakingOrdersPerPair |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderLifespan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxOrderLifespan, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositExtraGas", wireType)
+			}
+			m.DepositExtraGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawExtraGas", wireType)
+			}
+			m.WithdrawExtraGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderExtraGas", wireType)
+			}
+			m.OrderExtraGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumActivePoolsPerPair", wireType)
+			}
+			m.MaxNumActivePoolsPerPair = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumActivePoolsPerPair |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumTWAPSnapshots", wireType)
+			}
+			m.MaxNumTWAPSnapshots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumTWAPSnapshots |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleIntervals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandleIntervals = append(m.CandleIntervals, time.Duration(0))
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&(m.CandleIntervals[len(m.CandleIntervals)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumCandles", wireType)
+			}
+			m.MaxNumCandles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumCandles |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOrderId", wireType)
+			}
+			m.LastOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LastPrice = &v
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBatchId", wireType)
+			}
+			m.CurrentBatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentBatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationPolicy", wireType)
+			}
+			m.AllocationPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllocationPolicy |= AllocationPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MakerFeeRate = &v
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TakerFeeRate = &v
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositsPaused = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OrdersPaused = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickPrecision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TickPrecision == nil {
+				m.TickPrecision = new(uint32)
+			}
+			if err := github_com_gogo_protobuf_types.StdUInt32Unmarshal(m.TickPrecision, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinOrderNotional = &v
+			if err := m.MinOrderNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinPrice = &v
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxPrice = &v
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDepositRequestId", wireType)
+			}
+			m.LastDepositRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDepositRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWithdrawRequestId", wireType)
+			}
+			m.LastWithdrawRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastWithdrawRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseCoinWeight", wireType)
+			}
+			m.BaseCoinWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseCoinWeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteCoinWeight", wireType)
+			}
+			m.QuoteCoinWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuoteCoinWeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Price = &v
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTicks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityTicks = append(m.LiquidityTicks, LiquidityTick{})
+			if err := m.LiquidityTicks[len(m.LiquidityTicks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPositionId", wireType)
+			}
+			m.LastPositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositsPaused = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OrdersPaused = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedToPoolId", wireType)
+			}
+			m.MigratedToPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigratedToPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityTick) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityTick: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityTick: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolAccrual) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolAccrual: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolAccrual: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolAccrualSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolAccrualSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolAccrualSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TWAPAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TWAPAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TWAPAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSnapshotSeq", wireType)
+			}
+			m.LastSnapshotSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSnapshotSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TWAPSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TWAPSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TWAPSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovLiquidity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLiquidity(x uint64) (n int) {
-	return sovLiquidity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Candle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1617,17 +6303,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			m.BatchSize = 0
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1637,16 +6323,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchSize |= uint32(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickPrecision", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.TickPrecision = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1656,16 +6342,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TickPrecision |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1675,27 +6375,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeCollectorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.OpenTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DustCollectorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1723,11 +6424,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DustCollectorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialPoolCoinSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1755,15 +6458,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinInitialPoolCoinSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1773,31 +6476,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairCreationFee = append(m.PairCreationFee, types.Coin{})
-			if err := m.PairCreationFee[len(m.PairCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1807,29 +6510,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
-			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1857,13 +6560,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinInitialDepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceLimitRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1891,15 +6594,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPriceLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuoteVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumMarketMakingOrderTicks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.MaxNumMarketMakingOrderTicks = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1909,16 +6662,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNumMarketMakingOrderTicks |= uint32(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumMarketMakingOrdersPerPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.MaxNumMarketMakingOrdersPerPair = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1928,16 +6681,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNumMarketMakingOrdersPerPair |= uint32(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderLifespan", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
 			}
-			var msglen int
+			m.MsgHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1947,28 +6700,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MsgHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxOrderLifespan, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1996,15 +6735,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2014,31 +6751,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WithdrawFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DepositCoins = append(m.DepositCoins, types.Coin{})
+			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositExtraGas", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedCoins", wireType)
 			}
-			m.DepositExtraGas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2048,35 +6785,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawExtraGas", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			m.WithdrawExtraGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WithdrawExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderExtraGas", wireType)
+			m.AcceptedCoins = append(m.AcceptedCoins, types.Coin{})
+			if err := m.AcceptedCoins[len(m.AcceptedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.OrderExtraGas = 0
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedPoolCoin", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2086,16 +6819,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumActivePoolsPerPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.MaxNumActivePoolsPerPair = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2105,7 +6852,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNumActivePoolsPerPair |= uint32(b&0x7F) << shift
+				m.Status |= RequestStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2131,7 +6878,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pair) Unmarshal(dAtA []byte) error {
+func (m *SingleSidedDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2154,10 +6901,10 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pair: wiretype end group for non-group")
+			return fmt.Errorf("proto: SingleSidedDepositRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SingleSidedDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2180,8 +6927,46 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			}
+			m.MsgHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2209,13 +6994,13 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2225,29 +7010,30 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteCoinDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.DepositCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMintedPoolCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2257,29 +7043,30 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.MinMintedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOrderId", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwappedCoin", wireType)
 			}
-			m.LastOrderId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2289,16 +7076,30 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastOrderId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwappedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2308,33 +7109,131 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.LastPrice = &v
-			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReceivedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedCoins = append(m.AcceptedCoins, types.Coin{})
+			if err := m.AcceptedCoins[len(m.AcceptedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedCoins = append(m.RefundedCoins, types.Coin{})
+			if err := m.RefundedCoins[len(m.RefundedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedPoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBatchId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.CurrentBatchId = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2344,7 +7243,7 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentBatchId |= uint64(b&0x7F) << shift
+				m.Status |= RequestStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2370,7 +7269,7 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2393,17 +7292,17 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: WithdrawRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Type = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2413,16 +7312,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= PoolType(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2432,16 +7331,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
 			}
-			m.PairId = 0
+			m.MsgHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2451,14 +7350,14 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PairId |= uint64(b&0x7F) << shift
+				m.MsgHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2486,13 +7385,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Withdrawer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2502,29 +7401,30 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReserveAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2534,29 +7434,31 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.WithdrawnCoins = append(m.WithdrawnCoins, types.Coin{})
+			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2566,33 +7468,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= RequestStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.MinPrice = &v
-			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleSided", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2602,86 +7487,28 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.MaxPrice = &v
-			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SingleSided == nil {
+				m.SingleSided = &SingleSidedWithdrawal{}
+			}
+			if err := m.SingleSided.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDepositRequestId", wireType)
-			}
-			m.LastDepositRequestId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastDepositRequestId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastWithdrawRequestId", wireType)
-			}
-			m.LastWithdrawRequestId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastWithdrawRequestId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Disabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -2703,7 +7530,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositRequest) Unmarshal(dAtA []byte) error {
+func (m *SingleSidedWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2726,104 +7553,15 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
-			}
-			m.MsgHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MsgHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			return fmt.Errorf("proto: SingleSidedWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SingleSidedWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWithdrawnCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2850,14 +7588,13 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositCoins = append(m.DepositCoins, types.Coin{})
-			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinWithdrawnCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwappedCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2884,14 +7621,13 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AcceptedCoins = append(m.AcceptedCoins, types.Coin{})
-			if err := m.AcceptedCoins[len(m.AcceptedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwappedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintedPoolCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2918,29 +7654,10 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReceivedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= RequestStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -2962,7 +7679,7 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
+func (m *SwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2985,10 +7702,10 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3012,9 +7729,9 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
 			}
-			m.PoolId = 0
+			m.MsgHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3024,16 +7741,16 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.MsgHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
-			m.MsgHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3043,16 +7760,105 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PairIds = append(m.PairIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PairIds) == 0 {
+					m.PairIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLiquidity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PairIds = append(m.PairIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIds", wireType)
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3062,27 +7868,28 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Withdrawer = string(dAtA[iNdEx:postIndex])
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOutCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3109,13 +7916,13 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinOutCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3142,12 +7949,45 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawnCoins = append(m.WithdrawnCoins, types.Coin{})
-			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundedCoins = append(m.RefundedCoins, types.Coin{})
+			if err := m.RefundedCoins[len(m.RefundedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
@@ -3615,6 +8455,152 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TriggerPrice = &v
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerCondition", wireType)
+			}
+			m.TriggerCondition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerCondition |= TriggerCondition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggeredOrderType", wireType)
+			}
+			m.TriggeredOrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggeredOrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PostOnly = bool(v != 0)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
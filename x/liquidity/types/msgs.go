@@ -13,27 +13,51 @@ var (
 	_ sdk.Msg = (*MsgCreatePair)(nil)
 	_ sdk.Msg = (*MsgCreatePool)(nil)
 	_ sdk.Msg = (*MsgCreateRangedPool)(nil)
+	_ sdk.Msg = (*MsgCreateStablePool)(nil)
+	_ sdk.Msg = (*MsgCreateWeightedPool)(nil)
+	_ sdk.Msg = (*MsgCreateConcentratedPool)(nil)
+	_ sdk.Msg = (*MsgOpenPosition)(nil)
+	_ sdk.Msg = (*MsgClosePosition)(nil)
+	_ sdk.Msg = (*MsgCollectFees)(nil)
 	_ sdk.Msg = (*MsgDeposit)(nil)
+	_ sdk.Msg = (*MsgDepositSingleSided)(nil)
 	_ sdk.Msg = (*MsgWithdraw)(nil)
+	_ sdk.Msg = (*MsgWithdrawSingleSided)(nil)
 	_ sdk.Msg = (*MsgLimitOrder)(nil)
 	_ sdk.Msg = (*MsgMarketOrder)(nil)
 	_ sdk.Msg = (*MsgMMOrder)(nil)
+	_ sdk.Msg = (*MsgBatchOrders)(nil)
+	_ sdk.Msg = (*MsgTriggerOrder)(nil)
+	_ sdk.Msg = (*MsgSwapExactIn)(nil)
 	_ sdk.Msg = (*MsgCancelOrder)(nil)
+	_ sdk.Msg = (*MsgReplaceOrder)(nil)
 	_ sdk.Msg = (*MsgCancelAllOrders)(nil)
 )
 
 // Message types for the liquidity module
 const (
-	TypeMsgCreatePair       = "create_pair"
-	TypeMsgCreatePool       = "create_pool"
-	TypeMsgCreateRangedPool = "create_ranged_pool"
-	TypeMsgDeposit          = "deposit"
-	TypeMsgWithdraw         = "withdraw"
-	TypeMsgLimitOrder       = "limit_order"
-	TypeMsgMarketOrder      = "market_order"
-	TypeMsgMMOrder          = "mm_order"
-	TypeMsgCancelOrder      = "cancel_order"
-	TypeMsgCancelAllOrders  = "cancel_all_orders"
+	TypeMsgCreatePair             = "create_pair"
+	TypeMsgCreatePool             = "create_pool"
+	TypeMsgCreateRangedPool       = "create_ranged_pool"
+	TypeMsgCreateStablePool       = "create_stable_pool"
+	TypeMsgCreateWeightedPool     = "create_weighted_pool"
+	TypeMsgCreateConcentratedPool = "create_concentrated_pool"
+	TypeMsgOpenPosition           = "open_position"
+	TypeMsgClosePosition          = "close_position"
+	TypeMsgCollectFees            = "collect_fees"
+	TypeMsgDeposit                = "deposit"
+	TypeMsgDepositSingleSided     = "deposit_single_sided"
+	TypeMsgWithdraw               = "withdraw"
+	TypeMsgWithdrawSingleSided    = "withdraw_single_sided"
+	TypeMsgLimitOrder             = "limit_order"
+	TypeMsgMarketOrder            = "market_order"
+	TypeMsgMMOrder                = "mm_order"
+	TypeMsgBatchOrders            = "batch_orders"
+	TypeMsgTriggerOrder           = "trigger_order"
+	TypeMsgSwapExactIn            = "swap_exact_in"
+	TypeMsgCancelOrder            = "cancel_order"
+	TypeMsgReplaceOrder           = "replace_order"
+	TypeMsgCancelAllOrders        = "cancel_all_orders"
 )
 
 // NewMsgCreatePair returns a new MsgCreatePair.
@@ -62,6 +86,9 @@ func (msg MsgCreatePair) ValidateBasic() error {
 	if msg.BaseCoinDenom == msg.QuoteCoinDenom {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot use same denom for both base coin and quote coin")
 	}
+	if !msg.AllocationPolicy.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid allocation policy: %s", msg.AllocationPolicy)
+	}
 	return nil
 }
 
@@ -210,6 +237,350 @@ func (msg MsgCreateRangedPool) GetCreator() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgCreateStablePool creates a new MsgCreateStablePool.
+func NewMsgCreateStablePool(
+	creator sdk.AccAddress,
+	pairId uint64,
+	depositCoins sdk.Coins,
+	amplification uint32,
+) *MsgCreateStablePool {
+	return &MsgCreateStablePool{
+		Creator:       creator.String(),
+		PairId:        pairId,
+		DepositCoins:  depositCoins,
+		Amplification: amplification,
+	}
+}
+
+func (msg MsgCreateStablePool) Route() string { return RouterKey }
+
+func (msg MsgCreateStablePool) Type() string { return TypeMsgCreateStablePool }
+
+func (msg MsgCreateStablePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if err := msg.DepositCoins.Validate(); err != nil {
+		return err
+	}
+	if len(msg.DepositCoins) != 2 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "wrong number of deposit coins: %d", len(msg.DepositCoins))
+	}
+	for _, coin := range msg.DepositCoins {
+		if coin.Amount.GT(amm.MaxCoinAmount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "deposit coin %s is bigger than the max amount %s", coin, amm.MaxCoinAmount)
+		}
+	}
+	if err := amm.ValidateStablePoolAmplification(msg.Amplification); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgCreateStablePool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateStablePool) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreateStablePool) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCreateWeightedPool creates a new MsgCreateWeightedPool.
+func NewMsgCreateWeightedPool(
+	creator sdk.AccAddress,
+	pairId uint64,
+	depositCoins sdk.Coins,
+	baseCoinWeight, quoteCoinWeight uint32,
+) *MsgCreateWeightedPool {
+	return &MsgCreateWeightedPool{
+		Creator:         creator.String(),
+		PairId:          pairId,
+		DepositCoins:    depositCoins,
+		BaseCoinWeight:  baseCoinWeight,
+		QuoteCoinWeight: quoteCoinWeight,
+	}
+}
+
+func (msg MsgCreateWeightedPool) Route() string { return RouterKey }
+
+func (msg MsgCreateWeightedPool) Type() string { return TypeMsgCreateWeightedPool }
+
+func (msg MsgCreateWeightedPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if err := msg.DepositCoins.Validate(); err != nil {
+		return err
+	}
+	if len(msg.DepositCoins) != 2 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "wrong number of deposit coins: %d", len(msg.DepositCoins))
+	}
+	for _, coin := range msg.DepositCoins {
+		if coin.Amount.GT(amm.MaxCoinAmount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "deposit coin %s is bigger than the max amount %s", coin, amm.MaxCoinAmount)
+		}
+	}
+	if err := amm.ValidateWeightedPoolWeights(msg.QuoteCoinWeight, msg.BaseCoinWeight); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgCreateWeightedPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateWeightedPool) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreateWeightedPool) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCreateConcentratedPool creates a new MsgCreateConcentratedPool.
+func NewMsgCreateConcentratedPool(
+	creator sdk.AccAddress,
+	pairId uint64,
+	initialPrice sdk.Dec,
+) *MsgCreateConcentratedPool {
+	return &MsgCreateConcentratedPool{
+		Creator:      creator.String(),
+		PairId:       pairId,
+		InitialPrice: initialPrice,
+	}
+}
+
+func (msg MsgCreateConcentratedPool) Route() string { return RouterKey }
+
+func (msg MsgCreateConcentratedPool) Type() string { return TypeMsgCreateConcentratedPool }
+
+func (msg MsgCreateConcentratedPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if msg.InitialPrice.LT(amm.MinPoolPrice) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "initial price must not be lower than the min price %s", amm.MinPoolPrice)
+	}
+	if msg.InitialPrice.GT(amm.MaxPoolPrice) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "initial price must not be greater than the max price %s", amm.MaxPoolPrice)
+	}
+	return nil
+}
+
+func (msg MsgCreateConcentratedPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateConcentratedPool) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreateConcentratedPool) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgOpenPosition creates a new MsgOpenPosition.
+func NewMsgOpenPosition(
+	owner sdk.AccAddress,
+	poolId uint64,
+	lowerPrice, upperPrice sdk.Dec,
+	depositCoins sdk.Coins,
+) *MsgOpenPosition {
+	return &MsgOpenPosition{
+		Owner:        owner.String(),
+		PoolId:       poolId,
+		LowerPrice:   lowerPrice,
+		UpperPrice:   upperPrice,
+		DepositCoins: depositCoins,
+	}
+}
+
+func (msg MsgOpenPosition) Route() string { return RouterKey }
+
+func (msg MsgOpenPosition) Type() string { return TypeMsgOpenPosition }
+
+func (msg MsgOpenPosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if err := amm.ValidateConcentratedLiquidityRange(msg.LowerPrice, msg.UpperPrice); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := msg.DepositCoins.Validate(); err != nil {
+		return err
+	}
+	if len(msg.DepositCoins) == 0 || len(msg.DepositCoins) > 2 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "wrong number of deposit coins: %d", len(msg.DepositCoins))
+	}
+	for _, coin := range msg.DepositCoins {
+		if coin.Amount.GT(amm.MaxCoinAmount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "deposit coin %s is bigger than the max amount %s", coin, amm.MaxCoinAmount)
+		}
+	}
+	return nil
+}
+
+func (msg MsgOpenPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgOpenPosition) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgOpenPosition) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgClosePosition creates a new MsgClosePosition.
+func NewMsgClosePosition(
+	owner sdk.AccAddress,
+	poolId uint64,
+	positionId uint64,
+) *MsgClosePosition {
+	return &MsgClosePosition{
+		Owner:      owner.String(),
+		PoolId:     poolId,
+		PositionId: positionId,
+	}
+}
+
+func (msg MsgClosePosition) Route() string { return RouterKey }
+
+func (msg MsgClosePosition) Type() string { return TypeMsgClosePosition }
+
+func (msg MsgClosePosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if msg.PositionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "position id must not be 0")
+	}
+	return nil
+}
+
+func (msg MsgClosePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClosePosition) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgClosePosition) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCollectFees creates a new MsgCollectFees.
+func NewMsgCollectFees(
+	owner sdk.AccAddress,
+	poolId uint64,
+	positionId uint64,
+) *MsgCollectFees {
+	return &MsgCollectFees{
+		Owner:      owner.String(),
+		PoolId:     poolId,
+		PositionId: positionId,
+	}
+}
+
+func (msg MsgCollectFees) Route() string { return RouterKey }
+
+func (msg MsgCollectFees) Type() string { return TypeMsgCollectFees }
+
+func (msg MsgCollectFees) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if msg.PositionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "position id must not be 0")
+	}
+	return nil
+}
+
+func (msg MsgCollectFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCollectFees) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCollectFees) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgDeposit creates a new MsgDeposit.
 func NewMsgDeposit(
 	depositor sdk.AccAddress,
@@ -263,24 +634,140 @@ func (msg MsgDeposit) GetDepositor() sdk.AccAddress {
 	return addr
 }
 
-// NewMsgWithdraw creates a new MsgWithdraw.
-func NewMsgWithdraw(
+// NewMsgDepositSingleSided creates a new MsgDepositSingleSided.
+func NewMsgDepositSingleSided(
+	depositor sdk.AccAddress,
+	poolId uint64,
+	depositCoin sdk.Coin,
+	minMintedPoolCoin sdk.Coin,
+) *MsgDepositSingleSided {
+	return &MsgDepositSingleSided{
+		Depositor:         depositor.String(),
+		PoolId:            poolId,
+		DepositCoin:       depositCoin,
+		MinMintedPoolCoin: minMintedPoolCoin,
+	}
+}
+
+func (msg MsgDepositSingleSided) Route() string { return RouterKey }
+
+func (msg MsgDepositSingleSided) Type() string { return TypeMsgDepositSingleSided }
+
+func (msg MsgDepositSingleSided) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if err := msg.DepositCoin.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid deposit coin")
+	}
+	if !msg.DepositCoin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "deposit coin must be positive")
+	}
+	if msg.DepositCoin.Amount.GT(amm.MaxCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "deposit coin %s is bigger than the max amount %s", msg.DepositCoin, amm.MaxCoinAmount)
+	}
+	if err := msg.MinMintedPoolCoin.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid min minted pool coin")
+	}
+	return nil
+}
+
+func (msg MsgDepositSingleSided) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDepositSingleSided) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgDepositSingleSided) GetDepositor() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgWithdraw creates a new MsgWithdraw.
+func NewMsgWithdraw(
+	withdrawer sdk.AccAddress,
+	poolId uint64,
+	poolCoin sdk.Coin,
+) *MsgWithdraw {
+	return &MsgWithdraw{
+		Withdrawer: withdrawer.String(),
+		PoolId:     poolId,
+		PoolCoin:   poolCoin,
+	}
+}
+
+func (msg MsgWithdraw) Route() string { return RouterKey }
+
+func (msg MsgWithdraw) Type() string { return TypeMsgWithdraw }
+
+func (msg MsgWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Withdrawer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdrawer address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if err := msg.PoolCoin.Validate(); err != nil {
+		return err
+	}
+	if !msg.PoolCoin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool coin must be positive")
+	}
+	return nil
+}
+
+func (msg MsgWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Withdrawer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgWithdraw) GetWithdrawer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Withdrawer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgWithdrawSingleSided creates a new MsgWithdrawSingleSided.
+func NewMsgWithdrawSingleSided(
 	withdrawer sdk.AccAddress,
 	poolId uint64,
 	poolCoin sdk.Coin,
-) *MsgWithdraw {
-	return &MsgWithdraw{
-		Withdrawer: withdrawer.String(),
-		PoolId:     poolId,
-		PoolCoin:   poolCoin,
+	minWithdrawnCoin sdk.Coin,
+) *MsgWithdrawSingleSided {
+	return &MsgWithdrawSingleSided{
+		Withdrawer:       withdrawer.String(),
+		PoolId:           poolId,
+		PoolCoin:         poolCoin,
+		MinWithdrawnCoin: minWithdrawnCoin,
 	}
 }
 
-func (msg MsgWithdraw) Route() string { return RouterKey }
+func (msg MsgWithdrawSingleSided) Route() string { return RouterKey }
 
-func (msg MsgWithdraw) Type() string { return TypeMsgWithdraw }
+func (msg MsgWithdrawSingleSided) Type() string { return TypeMsgWithdrawSingleSided }
 
-func (msg MsgWithdraw) ValidateBasic() error {
+func (msg MsgWithdrawSingleSided) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Withdrawer); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdrawer address: %v", err)
 	}
@@ -288,19 +775,25 @@ func (msg MsgWithdraw) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
 	}
 	if err := msg.PoolCoin.Validate(); err != nil {
-		return err
+		return sdkerrors.Wrap(err, "invalid pool coin")
 	}
 	if !msg.PoolCoin.IsPositive() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool coin must be positive")
 	}
+	if err := msg.MinWithdrawnCoin.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid min withdrawn coin")
+	}
+	if msg.MinWithdrawnCoin.Denom == msg.PoolCoin.Denom {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min withdrawn coin denom must not be the pool coin denom")
+	}
 	return nil
 }
 
-func (msg MsgWithdraw) GetSignBytes() []byte {
+func (msg MsgWithdrawSingleSided) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
 
-func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
+func (msg MsgWithdrawSingleSided) GetSigners() []sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(msg.Withdrawer)
 	if err != nil {
 		panic(err)
@@ -308,7 +801,7 @@ func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
-func (msg MsgWithdraw) GetWithdrawer() sdk.AccAddress {
+func (msg MsgWithdrawSingleSided) GetWithdrawer() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(msg.Withdrawer)
 	if err != nil {
 		panic(err)
@@ -390,6 +883,12 @@ func (msg MsgLimitOrder) ValidateBasic() error {
 	if msg.OrderLifespan < 0 {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order lifespan must not be negative: %s", msg.OrderLifespan)
 	}
+	if !msg.TimeInForce.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid time in force: %s", msg.TimeInForce)
+	}
+	if msg.PostOnly && msg.TimeInForce != TimeInForceUnspecified {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "post-only order cannot have time in force: %s", msg.TimeInForce)
+	}
 	return nil
 }
 
@@ -592,6 +1091,289 @@ func (msg MsgMMOrder) GetOrderer() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgBatchOrders creates a new MsgBatchOrders.
+func NewMsgBatchOrders(
+	orderer sdk.AccAddress,
+	pairId uint64,
+	typ OrderType,
+	orders []BatchOrderEntry,
+	orderLifespan time.Duration,
+) *MsgBatchOrders {
+	return &MsgBatchOrders{
+		Orderer:       orderer.String(),
+		PairId:        pairId,
+		OrderType:     typ,
+		Orders:        orders,
+		OrderLifespan: orderLifespan,
+	}
+}
+
+func (msg MsgBatchOrders) Route() string { return RouterKey }
+
+func (msg MsgBatchOrders) Type() string { return TypeMsgBatchOrders }
+
+func (msg MsgBatchOrders) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orderer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orderer address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if msg.OrderType != OrderTypeLimit && msg.OrderType != OrderTypeMM {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order type must be either limit or mm: %s", msg.OrderType)
+	}
+	if len(msg.Orders) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "orders must not be empty")
+	}
+	if len(msg.Orders) > MaxNumBatchOrders {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "too many orders: %d > %d", len(msg.Orders), MaxNumBatchOrders)
+	}
+	for i, order := range msg.Orders {
+		if err := order.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid order at index %d", i)
+		}
+	}
+	if msg.OrderLifespan < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order lifespan must not be negative: %s", msg.OrderLifespan)
+	}
+	return nil
+}
+
+func (msg MsgBatchOrders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgBatchOrders) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgBatchOrders) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewBatchOrderEntry returns a new BatchOrderEntry.
+func NewBatchOrderEntry(dir OrderDirection, price sdk.Dec, amt sdk.Int) BatchOrderEntry {
+	return BatchOrderEntry{
+		Direction: dir,
+		Price:     price,
+		Amount:    amt,
+	}
+}
+
+// Validate validates BatchOrderEntry.
+func (entry BatchOrderEntry) Validate() error {
+	if entry.Direction != OrderDirectionBuy && entry.Direction != OrderDirectionSell {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order direction: %s", entry.Direction)
+	}
+	if !entry.Price.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "price must be positive")
+	}
+	if entry.Amount.LT(amm.MinCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order amount %s is smaller than the min amount %s", entry.Amount, amm.MinCoinAmount)
+	}
+	if entry.Amount.GT(amm.MaxCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order amount %s is bigger than the max amount %s", entry.Amount, amm.MaxCoinAmount)
+	}
+	return nil
+}
+
+// NewMsgTriggerOrder creates a new MsgTriggerOrder.
+func NewMsgTriggerOrder(
+	orderer sdk.AccAddress,
+	pairId uint64,
+	dir OrderDirection,
+	offerCoin sdk.Coin,
+	demandCoinDenom string,
+	price *sdk.Dec,
+	amt sdk.Int,
+	triggerPrice sdk.Dec,
+	triggerCond TriggerCondition,
+	orderLifespan time.Duration,
+) *MsgTriggerOrder {
+	return &MsgTriggerOrder{
+		Orderer:          orderer.String(),
+		PairId:           pairId,
+		Direction:        dir,
+		OfferCoin:        offerCoin,
+		DemandCoinDenom:  demandCoinDenom,
+		Price:            price,
+		Amount:           amt,
+		TriggerPrice:     triggerPrice,
+		TriggerCondition: triggerCond,
+		OrderLifespan:    orderLifespan,
+	}
+}
+
+func (msg MsgTriggerOrder) Route() string { return RouterKey }
+
+func (msg MsgTriggerOrder) Type() string { return TypeMsgTriggerOrder }
+
+func (msg MsgTriggerOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orderer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orderer address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if msg.Direction != OrderDirectionBuy && msg.Direction != OrderDirectionSell {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order direction: %s", msg.Direction)
+	}
+	if err := sdk.ValidateDenom(msg.DemandCoinDenom); err != nil {
+		return sdkerrors.Wrap(err, "invalid demand coin denom")
+	}
+	if msg.Price != nil && !msg.Price.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "price must be positive")
+	}
+	if !msg.TriggerPrice.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "trigger price must be positive")
+	}
+	if msg.TriggerCondition != TriggerConditionGTE && msg.TriggerCondition != TriggerConditionLTE {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid trigger condition: %s", msg.TriggerCondition)
+	}
+	if err := msg.OfferCoin.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid offer coin")
+	}
+	if msg.OfferCoin.Amount.LT(amm.MinCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "offer coin %s is smaller than the min amount %s", msg.OfferCoin, amm.MinCoinAmount)
+	}
+	if msg.OfferCoin.Amount.GT(amm.MaxCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "offer coin %s is bigger than the max amount %s", msg.OfferCoin, amm.MaxCoinAmount)
+	}
+	if msg.Amount.LT(amm.MinCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order amount %s is smaller than the min amount %s", msg.Amount, amm.MinCoinAmount)
+	}
+	if msg.Amount.GT(amm.MaxCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order amount %s is bigger than the max amount %s", msg.Amount, amm.MaxCoinAmount)
+	}
+	var minOfferCoin sdk.Coin
+	switch msg.Direction {
+	case OrderDirectionBuy:
+		if msg.Price != nil {
+			minOfferCoin = sdk.NewCoin(msg.OfferCoin.Denom, amm.OfferCoinAmount(amm.Buy, *msg.Price, msg.Amount))
+		} else { // The price of a market order is determined when the order is triggered.
+			minOfferCoin = sdk.NewCoin(msg.OfferCoin.Denom, sdk.ZeroInt())
+		}
+	case OrderDirectionSell:
+		minOfferCoin = sdk.NewCoin(msg.OfferCoin.Denom, msg.Amount)
+	}
+	if msg.OfferCoin.IsLT(minOfferCoin) {
+		return sdkerrors.Wrapf(ErrInsufficientOfferCoin, "%s is less than %s", msg.OfferCoin, minOfferCoin)
+	}
+	if msg.OfferCoin.Denom == msg.DemandCoinDenom {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "offer coin denom and demand coin denom must not be same")
+	}
+	if msg.OrderLifespan < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order lifespan must not be negative: %s", msg.OrderLifespan)
+	}
+	return nil
+}
+
+func (msg MsgTriggerOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgTriggerOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgTriggerOrder) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgSwapExactIn creates a new MsgSwapExactIn.
+func NewMsgSwapExactIn(
+	orderer sdk.AccAddress,
+	pairIds []uint64,
+	offerCoin sdk.Coin,
+	minOutCoin sdk.Coin,
+) *MsgSwapExactIn {
+	return &MsgSwapExactIn{
+		Orderer:    orderer.String(),
+		PairIds:    pairIds,
+		OfferCoin:  offerCoin,
+		MinOutCoin: minOutCoin,
+	}
+}
+
+func (msg MsgSwapExactIn) Route() string { return RouterKey }
+
+func (msg MsgSwapExactIn) Type() string { return TypeMsgSwapExactIn }
+
+func (msg MsgSwapExactIn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orderer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orderer address: %v", err)
+	}
+	if len(msg.PairIds) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "route must not be empty")
+	}
+	if len(msg.PairIds) > MaxSwapRouteLength {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "route length %d is longer than %d", len(msg.PairIds), MaxSwapRouteLength)
+	}
+	pairIdSet := map[uint64]struct{}{}
+	for _, pairId := range msg.PairIds {
+		if pairId == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+		}
+		if _, ok := pairIdSet[pairId]; ok {
+			return ErrDuplicatePairId
+		}
+		pairIdSet[pairId] = struct{}{}
+	}
+	if err := msg.OfferCoin.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid offer coin")
+	}
+	if msg.OfferCoin.Amount.LT(amm.MinCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "offer coin %s is smaller than the min amount %s", msg.OfferCoin, amm.MinCoinAmount)
+	}
+	if msg.OfferCoin.Amount.GT(amm.MaxCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "offer coin %s is bigger than the max amount %s", msg.OfferCoin, amm.MaxCoinAmount)
+	}
+	if err := msg.MinOutCoin.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid min out coin")
+	}
+	if msg.OfferCoin.Denom == msg.MinOutCoin.Denom {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "offer coin denom and min out coin denom must not be same")
+	}
+	return nil
+}
+
+func (msg MsgSwapExactIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSwapExactIn) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSwapExactIn) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgCancelOrder creates a new MsgCancelOrder.
 func NewMsgCancelOrder(
 	orderer sdk.AccAddress,
@@ -642,6 +1424,74 @@ func (msg MsgCancelOrder) GetOrderer() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgReplaceOrder creates a new MsgReplaceOrder.
+func NewMsgReplaceOrder(
+	orderer sdk.AccAddress,
+	pairId uint64,
+	orderId uint64,
+	price sdk.Dec,
+	amt sdk.Int,
+	orderLifespan time.Duration,
+) *MsgReplaceOrder {
+	return &MsgReplaceOrder{
+		Orderer:       orderer.String(),
+		PairId:        pairId,
+		OrderId:       orderId,
+		Price:         price,
+		Amount:        amt,
+		OrderLifespan: orderLifespan,
+	}
+}
+
+func (msg MsgReplaceOrder) Route() string { return RouterKey }
+
+func (msg MsgReplaceOrder) Type() string { return TypeMsgReplaceOrder }
+
+func (msg MsgReplaceOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orderer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orderer address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if msg.OrderId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "order id must not be 0")
+	}
+	if !msg.Price.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "price must be positive")
+	}
+	if msg.Amount.LT(amm.MinCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order amount %s is smaller than the min amount %s", msg.Amount, amm.MinCoinAmount)
+	}
+	if msg.Amount.GT(amm.MaxCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order amount %s is bigger than the max amount %s", msg.Amount, amm.MaxCoinAmount)
+	}
+	if msg.OrderLifespan < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order lifespan must not be negative: %s", msg.OrderLifespan)
+	}
+	return nil
+}
+
+func (msg MsgReplaceOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgReplaceOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgReplaceOrder) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgCancelAllOrders creates a new MsgCancelAllOrders.
 func NewMsgCancelAllOrders(
 	orderer sdk.AccAddress,
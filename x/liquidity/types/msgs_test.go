@@ -52,6 +52,20 @@ func TestMsgCreatePair(t *testing.T) {
 			},
 			"cannot use same denom for both base coin and quote coin: invalid request",
 		},
+		{
+			"pro-rata allocation policy",
+			func(msg *types.MsgCreatePair) {
+				msg.AllocationPolicy = types.AllocationPolicyProRata
+			},
+			"",
+		},
+		{
+			"invalid allocation policy",
+			func(msg *types.MsgCreatePair) {
+				msg.AllocationPolicy = 10
+			},
+			"invalid allocation policy: 10: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			msg := types.NewMsgCreatePair(testAddr, "denom1", "denom2")
@@ -252,66 +266,72 @@ func TestMsgCreateRangedPool(t *testing.T) {
 	}
 }
 
-func TestMsgDeposit(t *testing.T) {
-	testCases := []struct {
+func TestMsgCreateStablePool(t *testing.T) {
+	for _, tc := range []struct {
 		name        string
-		malleate    func(msg *types.MsgDeposit)
+		malleate    func(msg *types.MsgCreateStablePool)
 		expectedErr string
 	}{
 		{
 			"happy case",
-			func(msg *types.MsgDeposit) {},
+			func(msg *types.MsgCreateStablePool) {},
 			"", // empty means no error expected
 		},
 		{
-			"invalid depositor",
-			func(msg *types.MsgDeposit) {
-				msg.Depositor = "invalidaddr"
+			"invalid pair id",
+			func(msg *types.MsgCreateStablePool) {
+				msg.PairId = 0
 			},
-			"invalid depositor address: decoding bech32 failed: invalid separator index -1: invalid address",
+			"pair id must not be 0: invalid request",
 		},
 		{
-			"invalid pool id",
-			func(msg *types.MsgDeposit) {
-				msg.PoolId = 0
+			"invalid creator",
+			func(msg *types.MsgCreateStablePool) {
+				msg.Creator = "invalidaddr"
 			},
-			"pool id must not be 0: invalid request",
+			"invalid creator address: decoding bech32 failed: invalid separator index -1: invalid address",
 		},
 		{
-			"invalid deposit coins",
-			func(msg *types.MsgDeposit) {
-				msg.DepositCoins = sdk.Coins{utils.ParseCoin("0denom1"), utils.ParseCoin("1000000denom2")}
+			"single deposit coin",
+			func(msg *types.MsgCreateStablePool) {
+				msg.DepositCoins = utils.ParseCoins("1000000denom1")
 			},
-			"coin 0denom1 amount is not positive",
+			"wrong number of deposit coins: 1: invalid request",
 		},
 		{
-			"invalid deposit coins",
-			func(msg *types.MsgDeposit) {
-				msg.DepositCoins = sdk.Coins{utils.ParseCoin("1000000denom1"), utils.ParseCoin("0denom2")}
+			"too large deposit coins",
+			func(msg *types.MsgCreateStablePool) {
+				msg.DepositCoins = utils.ParseCoins("100000000000000000000000000000000000000000denom1,100000000000000000000000000000000000000000denom2")
 			},
-			"coin denom2 amount is not positive",
+			"deposit coin 100000000000000000000000000000000000000000denom1 is bigger than the max amount 10000000000000000000000000000000000000000: invalid request",
 		},
 		{
-			"invalid deposit coins",
-			func(msg *types.MsgDeposit) {
-				msg.DepositCoins = utils.ParseCoins("1000000denom1,1000000denom2,1000000denom3")
+			"zero amplification",
+			func(msg *types.MsgCreateStablePool) {
+				msg.Amplification = 0
 			},
-			"wrong number of deposit coins: 3: invalid request",
+			"amplification must not be lower than 1: invalid request",
 		},
-	}
-
-	for _, tc := range testCases {
+		{
+			"too high amplification",
+			func(msg *types.MsgCreateStablePool) {
+				msg.Amplification = 10001
+			},
+			"amplification must not be greater than 10000: invalid request",
+		},
+	} {
 		t.Run(tc.name, func(t *testing.T) {
-			msg := types.NewMsgDeposit(testAddr, 1, utils.ParseCoins("1000000denom1,1000000denom2"))
+			msg := types.NewMsgCreateStablePool(
+				testAddr, 1, utils.ParseCoins("1000000denom1,1000000denom2"), 100)
 			tc.malleate(msg)
-			require.Equal(t, types.TypeMsgDeposit, msg.Type())
+			require.Equal(t, types.TypeMsgCreateStablePool, msg.Type())
 			require.Equal(t, types.RouterKey, msg.Route())
 			err := msg.ValidateBasic()
 			if tc.expectedErr == "" {
 				require.NoError(t, err)
 				signers := msg.GetSigners()
 				require.Len(t, signers, 1)
-				require.Equal(t, msg.GetDepositor(), signers[0])
+				require.Equal(t, msg.GetCreator(), signers[0])
 			} else {
 				require.EqualError(t, err, tc.expectedErr)
 			}
@@ -319,50 +339,73 @@ func TestMsgDeposit(t *testing.T) {
 	}
 }
 
-func TestMsgWithdraw(t *testing.T) {
+func TestMsgCreateWeightedPool(t *testing.T) {
 	for _, tc := range []struct {
 		name        string
-		malleate    func(msg *types.MsgWithdraw)
+		malleate    func(msg *types.MsgCreateWeightedPool)
 		expectedErr string
 	}{
 		{
 			"happy case",
-			func(msg *types.MsgWithdraw) {},
+			func(msg *types.MsgCreateWeightedPool) {},
 			"", // empty means no error expected
 		},
 		{
-			"invalid withdrawer",
-			func(msg *types.MsgWithdraw) {
-				msg.Withdrawer = "invalidaddr"
+			"invalid pair id",
+			func(msg *types.MsgCreateWeightedPool) {
+				msg.PairId = 0
 			},
-			"invalid withdrawer address: decoding bech32 failed: invalid separator index -1: invalid address",
+			"pair id must not be 0: invalid request",
 		},
 		{
-			"invalid pool id",
-			func(msg *types.MsgWithdraw) {
-				msg.PoolId = 0
+			"invalid creator",
+			func(msg *types.MsgCreateWeightedPool) {
+				msg.Creator = "invalidaddr"
 			},
-			"pool id must not be 0: invalid request",
+			"invalid creator address: decoding bech32 failed: invalid separator index -1: invalid address",
 		},
 		{
-			"invalid pool coin",
-			func(msg *types.MsgWithdraw) {
-				msg.PoolCoin = utils.ParseCoin("0pool1")
+			"single deposit coin",
+			func(msg *types.MsgCreateWeightedPool) {
+				msg.DepositCoins = utils.ParseCoins("1000000denom1")
 			},
-			"pool coin must be positive: invalid request",
+			"wrong number of deposit coins: 1: invalid request",
+		},
+		{
+			"too large deposit coins",
+			func(msg *types.MsgCreateWeightedPool) {
+				msg.DepositCoins = utils.ParseCoins("100000000000000000000000000000000000000000denom1,100000000000000000000000000000000000000000denom2")
+			},
+			"deposit coin 100000000000000000000000000000000000000000denom1 is bigger than the max amount 10000000000000000000000000000000000000000: invalid request",
+		},
+		{
+			"zero weight",
+			func(msg *types.MsgCreateWeightedPool) {
+				msg.BaseCoinWeight = 0
+				msg.QuoteCoinWeight = 100
+			},
+			"weight must not be lower than 1: invalid request",
+		},
+		{
+			"wrong sum of weights",
+			func(msg *types.MsgCreateWeightedPool) {
+				msg.QuoteCoinWeight = 90
+			},
+			"sum of weights must be 100: invalid request",
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
-			msg := types.NewMsgWithdraw(testAddr, 1, utils.ParseCoin("1000000pool1"))
+			msg := types.NewMsgCreateWeightedPool(
+				testAddr, 1, utils.ParseCoins("1000000denom1,4000000denom2"), 20, 80)
 			tc.malleate(msg)
-			require.Equal(t, types.TypeMsgWithdraw, msg.Type())
+			require.Equal(t, types.TypeMsgCreateWeightedPool, msg.Type())
 			require.Equal(t, types.RouterKey, msg.Route())
 			err := msg.ValidateBasic()
 			if tc.expectedErr == "" {
 				require.NoError(t, err)
 				signers := msg.GetSigners()
 				require.Len(t, signers, 1)
-				require.Equal(t, msg.GetWithdrawer(), signers[0])
+				require.Equal(t, msg.GetCreator(), signers[0])
 			} else {
 				require.EqualError(t, err, tc.expectedErr)
 			}
@@ -370,119 +413,57 @@ func TestMsgWithdraw(t *testing.T) {
 	}
 }
 
-func TestMsgLimitOrder(t *testing.T) {
-	orderLifespan := 20 * time.Second
+func TestMsgCreateConcentratedPool(t *testing.T) {
 	for _, tc := range []struct {
 		name        string
-		malleate    func(msg *types.MsgLimitOrder)
+		malleate    func(msg *types.MsgCreateConcentratedPool)
 		expectedErr string
 	}{
 		{
 			"happy case",
-			func(msg *types.MsgLimitOrder) {},
+			func(msg *types.MsgCreateConcentratedPool) {},
 			"", // empty means no error expected
 		},
-		{
-			"invalid orderer",
-			func(msg *types.MsgLimitOrder) {
-				msg.Orderer = "invalidaddr"
-			},
-			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
-		},
 		{
 			"invalid pair id",
-			func(msg *types.MsgLimitOrder) {
+			func(msg *types.MsgCreateConcentratedPool) {
 				msg.PairId = 0
 			},
 			"pair id must not be 0: invalid request",
 		},
 		{
-			"invalid direction",
-			func(msg *types.MsgLimitOrder) {
-				msg.Direction = 0
-			},
-			"invalid order direction: ORDER_DIRECTION_UNSPECIFIED: invalid request",
-		},
-		{
-			"invalid offer coin",
-			func(msg *types.MsgLimitOrder) {
-				msg.OfferCoin = utils.ParseCoin("0denom1")
-			},
-			"offer coin 0denom1 is smaller than the min amount 100: invalid request",
-		},
-		{
-			"small offer coin amount",
-			func(msg *types.MsgLimitOrder) {
-				msg.OfferCoin = utils.ParseCoin("10denom1")
-			},
-			"offer coin 10denom1 is smaller than the min amount 100: invalid request",
-		},
-		{
-			"insufficient offer coin amount",
-			func(msg *types.MsgLimitOrder) {
-				msg.OfferCoin = utils.ParseCoin("1000000denom2")
-				msg.Price = utils.ParseDec("10")
-				msg.Amount = newInt(1000000)
-			},
-			"1000000denom2 is less than 10000000denom2: insufficient offer coin",
-		},
-		{
-			"invalid demand coin denom",
-			func(msg *types.MsgLimitOrder) {
-				msg.DemandCoinDenom = "invaliddenom!"
-			},
-			"invalid demand coin denom: invalid denom: invaliddenom!",
-		},
-		{
-			"same offer coin denom and demand coin denom",
-			func(msg *types.MsgLimitOrder) {
-				msg.OfferCoin = utils.ParseCoin("1000000denom1")
-				msg.DemandCoinDenom = "denom1"
-			},
-			"offer coin denom and demand coin denom must not be same: invalid request",
-		},
-		{
-			"invalid price",
-			func(msg *types.MsgLimitOrder) {
-				msg.Price = utils.ParseDec("0")
-			},
-			"price must be positive: invalid request",
-		},
-		{
-			"zero order amount",
-			func(msg *types.MsgLimitOrder) {
-				msg.Amount = sdk.ZeroInt()
+			"invalid creator",
+			func(msg *types.MsgCreateConcentratedPool) {
+				msg.Creator = "invalidaddr"
 			},
-			"order amount 0 is smaller than the min amount 100: invalid request",
+			"invalid creator address: decoding bech32 failed: invalid separator index -1: invalid address",
 		},
 		{
-			"small order amount",
-			func(msg *types.MsgLimitOrder) {
-				msg.Amount = newInt(10)
+			"too low initial price",
+			func(msg *types.MsgCreateConcentratedPool) {
+				msg.InitialPrice = sdk.ZeroDec()
 			},
-			"order amount 10 is smaller than the min amount 100: invalid request",
+			"initial price must not be lower than the min price 0.000000000000001000: invalid request",
 		},
 		{
-			"invalid order lifespan",
-			func(msg *types.MsgLimitOrder) {
-				msg.OrderLifespan = -1
+			"too high initial price",
+			func(msg *types.MsgCreateConcentratedPool) {
+				msg.InitialPrice = utils.ParseDec("1000000000000000000000")
 			},
-			"order lifespan must not be negative: -1ns: invalid request",
+			"initial price must not be greater than the max price 100000000000000000000.000000000000000000: invalid request",
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
-			msg := types.NewMsgLimitOrder(
-				testAddr, 1, types.OrderDirectionBuy, utils.ParseCoin("1000000denom2"),
-				"denom1", utils.ParseDec("1.0"), newInt(1000000), orderLifespan)
+			msg := types.NewMsgCreateConcentratedPool(testAddr, 1, utils.ParseDec("1.0"))
 			tc.malleate(msg)
-			require.Equal(t, types.TypeMsgLimitOrder, msg.Type())
+			require.Equal(t, types.TypeMsgCreateConcentratedPool, msg.Type())
 			require.Equal(t, types.RouterKey, msg.Route())
 			err := msg.ValidateBasic()
 			if tc.expectedErr == "" {
 				require.NoError(t, err)
 				signers := msg.GetSigners()
 				require.Len(t, signers, 1)
-				require.Equal(t, msg.GetOrderer(), signers[0])
+				require.Equal(t, msg.GetCreator(), signers[0])
 			} else {
 				require.EqualError(t, err, tc.expectedErr)
 			}
@@ -490,103 +471,73 @@ func TestMsgLimitOrder(t *testing.T) {
 	}
 }
 
-func TestMsgMarketOrder(t *testing.T) {
-	orderLifespan := 20 * time.Second
+func TestMsgOpenPosition(t *testing.T) {
 	for _, tc := range []struct {
 		name        string
-		malleate    func(msg *types.MsgMarketOrder)
+		malleate    func(msg *types.MsgOpenPosition)
 		expectedErr string
 	}{
 		{
 			"happy case",
-			func(msg *types.MsgMarketOrder) {},
+			func(msg *types.MsgOpenPosition) {},
 			"", // empty means no error expected
 		},
 		{
-			"invalid orderer",
-			func(msg *types.MsgMarketOrder) {
-				msg.Orderer = "invalidaddr"
-			},
-			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
-		},
-		{
-			"invalid pair id",
-			func(msg *types.MsgMarketOrder) {
-				msg.PairId = 0
-			},
-			"pair id must not be 0: invalid request",
-		},
-		{
-			"invalid direction",
-			func(msg *types.MsgMarketOrder) {
-				msg.Direction = 0
-			},
-			"invalid order direction: ORDER_DIRECTION_UNSPECIFIED: invalid request",
-		},
-		{
-			"zero offer coin",
-			func(msg *types.MsgMarketOrder) {
-				msg.OfferCoin = utils.ParseCoin("0denom1")
-			},
-			"offer coin 0denom1 is smaller than the min amount 100: invalid request",
-		},
-		{
-			"small offer coin amount",
-			func(msg *types.MsgMarketOrder) {
-				msg.OfferCoin = utils.ParseCoin("10denom1")
+			"single deposit coin",
+			func(msg *types.MsgOpenPosition) {
+				msg.DepositCoins = utils.ParseCoins("1000000denom1")
 			},
-			"offer coin 10denom1 is smaller than the min amount 100: invalid request",
+			"",
 		},
 		{
-			"invalid demand coin denom",
-			func(msg *types.MsgMarketOrder) {
-				msg.DemandCoinDenom = "invaliddenom!"
+			"invalid owner",
+			func(msg *types.MsgOpenPosition) {
+				msg.Owner = "invalidaddr"
 			},
-			"invalid demand coin denom: invalid denom: invaliddenom!",
+			"invalid owner address: decoding bech32 failed: invalid separator index -1: invalid address",
 		},
 		{
-			"same offer coin denom and demand coin denom",
-			func(msg *types.MsgMarketOrder) {
-				msg.OfferCoin = utils.ParseCoin("1000000denom1")
-				msg.DemandCoinDenom = "denom1"
+			"invalid pool id",
+			func(msg *types.MsgOpenPosition) {
+				msg.PoolId = 0
 			},
-			"offer coin denom and demand coin denom must not be same: invalid request",
+			"pool id must not be 0: invalid request",
 		},
 		{
-			"zero order amount",
-			func(msg *types.MsgMarketOrder) {
-				msg.Amount = sdk.ZeroInt()
+			"wrong price range",
+			func(msg *types.MsgOpenPosition) {
+				msg.LowerPrice = utils.ParseDec("2.0")
+				msg.UpperPrice = utils.ParseDec("0.5")
 			},
-			"order amount 0 is smaller than the min amount 100: invalid request",
+			"lower price must be lower than upper price: invalid request",
 		},
 		{
-			"small order amount",
-			func(msg *types.MsgMarketOrder) {
-				msg.Amount = newInt(10)
+			"no deposit coins",
+			func(msg *types.MsgOpenPosition) {
+				msg.DepositCoins = sdk.Coins{}
 			},
-			"order amount 10 is smaller than the min amount 100: invalid request",
+			"wrong number of deposit coins: 0: invalid request",
 		},
 		{
-			"invalid order lifespan",
-			func(msg *types.MsgMarketOrder) {
-				msg.OrderLifespan = -1
+			"too large deposit coins",
+			func(msg *types.MsgOpenPosition) {
+				msg.DepositCoins = utils.ParseCoins("100000000000000000000000000000000000000000denom1,100000000000000000000000000000000000000000denom2")
 			},
-			"order lifespan must not be negative: -1ns: invalid request",
+			"deposit coin 100000000000000000000000000000000000000000denom1 is bigger than the max amount 10000000000000000000000000000000000000000: invalid request",
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
-			msg := types.NewMsgMarketOrder(
-				testAddr, 1, types.OrderDirectionBuy, utils.ParseCoin("1000000denom1"),
-				"denom2", newInt(1000000), orderLifespan)
+			msg := types.NewMsgOpenPosition(
+				testAddr, 1, utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseCoins("1000000denom1,1000000denom2"))
 			tc.malleate(msg)
-			require.Equal(t, types.TypeMsgMarketOrder, msg.Type())
+			require.Equal(t, types.TypeMsgOpenPosition, msg.Type())
 			require.Equal(t, types.RouterKey, msg.Route())
 			err := msg.ValidateBasic()
 			if tc.expectedErr == "" {
 				require.NoError(t, err)
 				signers := msg.GetSigners()
 				require.Len(t, signers, 1)
-				require.Equal(t, msg.GetOrderer(), signers[0])
+				require.Equal(t, msg.GetOwner(), signers[0])
 			} else {
 				require.EqualError(t, err, tc.expectedErr)
 			}
@@ -594,112 +545,939 @@ func TestMsgMarketOrder(t *testing.T) {
 	}
 }
 
-func TestMsgMMOrder(t *testing.T) {
-	orderLifespan := 20 * time.Second
+func TestMsgClosePosition(t *testing.T) {
 	for _, tc := range []struct {
 		name        string
-		malleate    func(msg *types.MsgMMOrder)
+		malleate    func(msg *types.MsgClosePosition)
 		expectedErr string
 	}{
 		{
 			"happy case",
-			func(msg *types.MsgMMOrder) {},
+			func(msg *types.MsgClosePosition) {},
 			"", // empty means no error expected
 		},
 		{
-			"invalid orderer",
-			func(msg *types.MsgMMOrder) {
-				msg.Orderer = "invalidaddr"
+			"invalid owner",
+			func(msg *types.MsgClosePosition) {
+				msg.Owner = "invalidaddr"
 			},
-			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+			"invalid owner address: decoding bech32 failed: invalid separator index -1: invalid address",
 		},
 		{
-			"invalid pair id",
-			func(msg *types.MsgMMOrder) {
-				msg.PairId = 0
+			"invalid pool id",
+			func(msg *types.MsgClosePosition) {
+				msg.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"invalid position id",
+			func(msg *types.MsgClosePosition) {
+				msg.PositionId = 0
+			},
+			"position id must not be 0: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgClosePosition(testAddr, 1, 1)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgClosePosition, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOwner(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgCollectFees(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgCollectFees)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgCollectFees) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid owner",
+			func(msg *types.MsgCollectFees) {
+				msg.Owner = "invalidaddr"
+			},
+			"invalid owner address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pool id",
+			func(msg *types.MsgCollectFees) {
+				msg.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"invalid position id",
+			func(msg *types.MsgCollectFees) {
+				msg.PositionId = 0
+			},
+			"position id must not be 0: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgCollectFees(testAddr, 1, 1)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgCollectFees, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOwner(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgDeposit(t *testing.T) {
+	testCases := []struct {
+		name        string
+		malleate    func(msg *types.MsgDeposit)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgDeposit) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid depositor",
+			func(msg *types.MsgDeposit) {
+				msg.Depositor = "invalidaddr"
+			},
+			"invalid depositor address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pool id",
+			func(msg *types.MsgDeposit) {
+				msg.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"invalid deposit coins",
+			func(msg *types.MsgDeposit) {
+				msg.DepositCoins = sdk.Coins{utils.ParseCoin("0denom1"), utils.ParseCoin("1000000denom2")}
+			},
+			"coin 0denom1 amount is not positive",
+		},
+		{
+			"invalid deposit coins",
+			func(msg *types.MsgDeposit) {
+				msg.DepositCoins = sdk.Coins{utils.ParseCoin("1000000denom1"), utils.ParseCoin("0denom2")}
+			},
+			"coin denom2 amount is not positive",
+		},
+		{
+			"invalid deposit coins",
+			func(msg *types.MsgDeposit) {
+				msg.DepositCoins = utils.ParseCoins("1000000denom1,1000000denom2,1000000denom3")
+			},
+			"wrong number of deposit coins: 3: invalid request",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgDeposit(testAddr, 1, utils.ParseCoins("1000000denom1,1000000denom2"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgDeposit, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetDepositor(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgDepositSingleSided(t *testing.T) {
+	testCases := []struct {
+		name        string
+		malleate    func(msg *types.MsgDepositSingleSided)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgDepositSingleSided) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid depositor",
+			func(msg *types.MsgDepositSingleSided) {
+				msg.Depositor = "invalidaddr"
+			},
+			"invalid depositor address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pool id",
+			func(msg *types.MsgDepositSingleSided) {
+				msg.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"zero deposit coin",
+			func(msg *types.MsgDepositSingleSided) {
+				msg.DepositCoin = utils.ParseCoin("0denom1")
+			},
+			"deposit coin must be positive: invalid request",
+		},
+		{
+			"too large deposit coin",
+			func(msg *types.MsgDepositSingleSided) {
+				msg.DepositCoin = utils.ParseCoin("100000000000000000000000000000000000000000denom1")
+			},
+			"deposit coin 100000000000000000000000000000000000000000denom1 is bigger than the max amount 10000000000000000000000000000000000000000: invalid request",
+		},
+		{
+			"invalid min minted pool coin",
+			func(msg *types.MsgDepositSingleSided) {
+				msg.MinMintedPoolCoin = sdk.Coin{Denom: "pool1", Amount: sdk.NewInt(-1)}
+			},
+			"invalid min minted pool coin: negative coin amount: -1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgDepositSingleSided(testAddr, 1, utils.ParseCoin("1000000denom1"), utils.ParseCoin("1pool1"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgDepositSingleSided, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetDepositor(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgWithdraw(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgWithdraw)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgWithdraw) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid withdrawer",
+			func(msg *types.MsgWithdraw) {
+				msg.Withdrawer = "invalidaddr"
+			},
+			"invalid withdrawer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pool id",
+			func(msg *types.MsgWithdraw) {
+				msg.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"invalid pool coin",
+			func(msg *types.MsgWithdraw) {
+				msg.PoolCoin = utils.ParseCoin("0pool1")
+			},
+			"pool coin must be positive: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgWithdraw(testAddr, 1, utils.ParseCoin("1000000pool1"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgWithdraw, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetWithdrawer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawSingleSided(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgWithdrawSingleSided)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgWithdrawSingleSided) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid withdrawer",
+			func(msg *types.MsgWithdrawSingleSided) {
+				msg.Withdrawer = "invalidaddr"
+			},
+			"invalid withdrawer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pool id",
+			func(msg *types.MsgWithdrawSingleSided) {
+				msg.PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"invalid pool coin",
+			func(msg *types.MsgWithdrawSingleSided) {
+				msg.PoolCoin = utils.ParseCoin("0pool1")
+			},
+			"pool coin must be positive: invalid request",
+		},
+		{
+			"invalid min withdrawn coin",
+			func(msg *types.MsgWithdrawSingleSided) {
+				msg.MinWithdrawnCoin = sdk.Coin{Denom: "denom1", Amount: sdk.NewInt(-1)}
+			},
+			"invalid min withdrawn coin: negative coin amount: -1",
+		},
+		{
+			"pool coin denom as min withdrawn coin denom",
+			func(msg *types.MsgWithdrawSingleSided) {
+				msg.MinWithdrawnCoin = utils.ParseCoin("1pool1")
+			},
+			"min withdrawn coin denom must not be the pool coin denom: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgWithdrawSingleSided(testAddr, 1, utils.ParseCoin("1000000pool1"), utils.ParseCoin("1denom1"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgWithdrawSingleSided, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetWithdrawer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgLimitOrder(t *testing.T) {
+	orderLifespan := 20 * time.Second
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgLimitOrder)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgLimitOrder) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgLimitOrder) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgLimitOrder) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid direction",
+			func(msg *types.MsgLimitOrder) {
+				msg.Direction = 0
+			},
+			"invalid order direction: ORDER_DIRECTION_UNSPECIFIED: invalid request",
+		},
+		{
+			"invalid offer coin",
+			func(msg *types.MsgLimitOrder) {
+				msg.OfferCoin = utils.ParseCoin("0denom1")
+			},
+			"offer coin 0denom1 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"small offer coin amount",
+			func(msg *types.MsgLimitOrder) {
+				msg.OfferCoin = utils.ParseCoin("10denom1")
+			},
+			"offer coin 10denom1 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"insufficient offer coin amount",
+			func(msg *types.MsgLimitOrder) {
+				msg.OfferCoin = utils.ParseCoin("1000000denom2")
+				msg.Price = utils.ParseDec("10")
+				msg.Amount = newInt(1000000)
+			},
+			"1000000denom2 is less than 10000000denom2: insufficient offer coin",
+		},
+		{
+			"invalid demand coin denom",
+			func(msg *types.MsgLimitOrder) {
+				msg.DemandCoinDenom = "invaliddenom!"
+			},
+			"invalid demand coin denom: invalid denom: invaliddenom!",
+		},
+		{
+			"same offer coin denom and demand coin denom",
+			func(msg *types.MsgLimitOrder) {
+				msg.OfferCoin = utils.ParseCoin("1000000denom1")
+				msg.DemandCoinDenom = "denom1"
+			},
+			"offer coin denom and demand coin denom must not be same: invalid request",
+		},
+		{
+			"invalid price",
+			func(msg *types.MsgLimitOrder) {
+				msg.Price = utils.ParseDec("0")
+			},
+			"price must be positive: invalid request",
+		},
+		{
+			"zero order amount",
+			func(msg *types.MsgLimitOrder) {
+				msg.Amount = sdk.ZeroInt()
+			},
+			"order amount 0 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"small order amount",
+			func(msg *types.MsgLimitOrder) {
+				msg.Amount = newInt(10)
+			},
+			"order amount 10 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"invalid order lifespan",
+			func(msg *types.MsgLimitOrder) {
+				msg.OrderLifespan = -1
+			},
+			"order lifespan must not be negative: -1ns: invalid request",
+		},
+		{
+			"invalid time in force",
+			func(msg *types.MsgLimitOrder) {
+				msg.TimeInForce = 10
+			},
+			"invalid time in force: 10: invalid request",
+		},
+		{
+			"post-only order with time in force",
+			func(msg *types.MsgLimitOrder) {
+				msg.PostOnly = true
+				msg.TimeInForce = types.TimeInForceImmediateOrCancel
+			},
+			"post-only order cannot have time in force: TIME_IN_FORCE_IMMEDIATE_OR_CANCEL: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgLimitOrder(
+				testAddr, 1, types.OrderDirectionBuy, utils.ParseCoin("1000000denom2"),
+				"denom1", utils.ParseDec("1.0"), newInt(1000000), orderLifespan)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgLimitOrder, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgMarketOrder(t *testing.T) {
+	orderLifespan := 20 * time.Second
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgMarketOrder)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgMarketOrder) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgMarketOrder) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgMarketOrder) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid direction",
+			func(msg *types.MsgMarketOrder) {
+				msg.Direction = 0
+			},
+			"invalid order direction: ORDER_DIRECTION_UNSPECIFIED: invalid request",
+		},
+		{
+			"zero offer coin",
+			func(msg *types.MsgMarketOrder) {
+				msg.OfferCoin = utils.ParseCoin("0denom1")
+			},
+			"offer coin 0denom1 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"small offer coin amount",
+			func(msg *types.MsgMarketOrder) {
+				msg.OfferCoin = utils.ParseCoin("10denom1")
+			},
+			"offer coin 10denom1 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"invalid demand coin denom",
+			func(msg *types.MsgMarketOrder) {
+				msg.DemandCoinDenom = "invaliddenom!"
+			},
+			"invalid demand coin denom: invalid denom: invaliddenom!",
+		},
+		{
+			"same offer coin denom and demand coin denom",
+			func(msg *types.MsgMarketOrder) {
+				msg.OfferCoin = utils.ParseCoin("1000000denom1")
+				msg.DemandCoinDenom = "denom1"
+			},
+			"offer coin denom and demand coin denom must not be same: invalid request",
+		},
+		{
+			"zero order amount",
+			func(msg *types.MsgMarketOrder) {
+				msg.Amount = sdk.ZeroInt()
+			},
+			"order amount 0 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"small order amount",
+			func(msg *types.MsgMarketOrder) {
+				msg.Amount = newInt(10)
+			},
+			"order amount 10 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"invalid order lifespan",
+			func(msg *types.MsgMarketOrder) {
+				msg.OrderLifespan = -1
+			},
+			"order lifespan must not be negative: -1ns: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgMarketOrder(
+				testAddr, 1, types.OrderDirectionBuy, utils.ParseCoin("1000000denom1"),
+				"denom2", newInt(1000000), orderLifespan)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgMarketOrder, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgMMOrder(t *testing.T) {
+	orderLifespan := 20 * time.Second
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgMMOrder)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgMMOrder) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgMMOrder) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgMMOrder) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid direction",
+			func(msg *types.MsgMMOrder) {
+				msg.Direction = 0
+			},
+			"invalid order direction: ORDER_DIRECTION_UNSPECIFIED: invalid request",
+		},
+		{
+			"invalid offer coin",
+			func(msg *types.MsgMMOrder) {
+				msg.OfferCoin = utils.ParseCoin("0denom1")
+			},
+			"offer coin 0denom1 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"small offer coin amount",
+			func(msg *types.MsgMMOrder) {
+				msg.OfferCoin = utils.ParseCoin("10denom1")
+			},
+			"offer coin 10denom1 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"insufficient offer coin amount",
+			func(msg *types.MsgMMOrder) {
+				msg.OfferCoin = utils.ParseCoin("1000000denom2")
+				msg.Price = utils.ParseDec("10")
+				msg.Amount = newInt(1000000)
+			},
+			"1000000denom2 is less than 10000000denom2: insufficient offer coin",
+		},
+		{
+			"invalid demand coin denom",
+			func(msg *types.MsgMMOrder) {
+				msg.DemandCoinDenom = "invaliddenom!"
+			},
+			"invalid demand coin denom: invalid denom: invaliddenom!",
+		},
+		{
+			"same offer coin denom and demand coin denom",
+			func(msg *types.MsgMMOrder) {
+				msg.OfferCoin = utils.ParseCoin("1000000denom1")
+				msg.DemandCoinDenom = "denom1"
+			},
+			"offer coin denom and demand coin denom must not be same: invalid request",
+		},
+		{
+			"invalid price",
+			func(msg *types.MsgMMOrder) {
+				msg.Price = utils.ParseDec("0")
+			},
+			"price must be positive: invalid request",
+		},
+		{
+			"zero order amount",
+			func(msg *types.MsgMMOrder) {
+				msg.Amount = sdk.ZeroInt()
+			},
+			"order amount 0 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"small order amount",
+			func(msg *types.MsgMMOrder) {
+				msg.Amount = newInt(10)
+			},
+			"order amount 10 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"invalid order lifespan",
+			func(msg *types.MsgMMOrder) {
+				msg.OrderLifespan = -1
+			},
+			"order lifespan must not be negative: -1ns: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgMMOrder(
+				testAddr, 1, types.OrderDirectionBuy, utils.ParseCoin("1000000denom2"),
+				"denom1", utils.ParseDec("1.0"), newInt(1000000), orderLifespan)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgMMOrder, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgBatchOrders(t *testing.T) {
+	orderLifespan := 20 * time.Second
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgBatchOrders)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgBatchOrders) {},
+			"", // empty means no error expected
+		},
+		{
+			"mm orders",
+			func(msg *types.MsgBatchOrders) {
+				msg.OrderType = types.OrderTypeMM
+			},
+			"",
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgBatchOrders) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgBatchOrders) {
+				msg.PairId = 0
 			},
 			"pair id must not be 0: invalid request",
 		},
+		{
+			"invalid order type",
+			func(msg *types.MsgBatchOrders) {
+				msg.OrderType = types.OrderTypeMarket
+			},
+			"order type must be either limit or mm: ORDER_TYPE_MARKET: invalid request",
+		},
+		{
+			"empty orders",
+			func(msg *types.MsgBatchOrders) {
+				msg.Orders = nil
+			},
+			"orders must not be empty: invalid request",
+		},
+		{
+			"too many orders",
+			func(msg *types.MsgBatchOrders) {
+				msg.Orders = make([]types.BatchOrderEntry, types.MaxNumBatchOrders+1)
+				for i := range msg.Orders {
+					msg.Orders[i] = types.NewBatchOrderEntry(types.OrderDirectionBuy, utils.ParseDec("1.0"), newInt(10000))
+				}
+			},
+			"too many orders: 51 > 50: invalid request",
+		},
 		{
 			"invalid direction",
-			func(msg *types.MsgMMOrder) {
+			func(msg *types.MsgBatchOrders) {
+				msg.Orders[1].Direction = 0
+			},
+			"invalid order at index 1: invalid order direction: ORDER_DIRECTION_UNSPECIFIED: invalid request",
+		},
+		{
+			"invalid price",
+			func(msg *types.MsgBatchOrders) {
+				msg.Orders[0].Price = sdk.ZeroDec()
+			},
+			"invalid order at index 0: price must be positive: invalid request",
+		},
+		{
+			"too small amount",
+			func(msg *types.MsgBatchOrders) {
+				msg.Orders[1].Amount = newInt(50)
+			},
+			"invalid order at index 1: order amount 50 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"invalid order lifespan",
+			func(msg *types.MsgBatchOrders) {
+				msg.OrderLifespan = -1
+			},
+			"order lifespan must not be negative: -1ns: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgBatchOrders(
+				testAddr, 1, types.OrderTypeLimit, []types.BatchOrderEntry{
+					types.NewBatchOrderEntry(types.OrderDirectionBuy, utils.ParseDec("0.99"), newInt(1000000)),
+					types.NewBatchOrderEntry(types.OrderDirectionSell, utils.ParseDec("1.01"), newInt(1000000)),
+				}, orderLifespan)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgBatchOrders, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgTriggerOrder(t *testing.T) {
+	orderLifespan := 20 * time.Second
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgTriggerOrder)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgTriggerOrder) {},
+			"", // empty means no error expected
+		},
+		{
+			"happy case: market order",
+			func(msg *types.MsgTriggerOrder) {
+				msg.Price = nil
+				msg.OfferCoin = utils.ParseCoin("100denom1")
+			},
+			"",
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgTriggerOrder) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgTriggerOrder) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid direction",
+			func(msg *types.MsgTriggerOrder) {
 				msg.Direction = 0
 			},
 			"invalid order direction: ORDER_DIRECTION_UNSPECIFIED: invalid request",
 		},
 		{
-			"invalid offer coin",
-			func(msg *types.MsgMMOrder) {
-				msg.OfferCoin = utils.ParseCoin("0denom1")
+			"zero price",
+			func(msg *types.MsgTriggerOrder) {
+				p := sdk.ZeroDec()
+				msg.Price = &p
 			},
-			"offer coin 0denom1 is smaller than the min amount 100: invalid request",
+			"price must be positive: invalid request",
+		},
+		{
+			"zero trigger price",
+			func(msg *types.MsgTriggerOrder) {
+				msg.TriggerPrice = sdk.ZeroDec()
+			},
+			"trigger price must be positive: invalid request",
+		},
+		{
+			"invalid trigger condition",
+			func(msg *types.MsgTriggerOrder) {
+				msg.TriggerCondition = types.TriggerConditionUnspecified
+			},
+			"invalid trigger condition: TRIGGER_CONDITION_UNSPECIFIED: invalid request",
 		},
 		{
 			"small offer coin amount",
-			func(msg *types.MsgMMOrder) {
+			func(msg *types.MsgTriggerOrder) {
 				msg.OfferCoin = utils.ParseCoin("10denom1")
 			},
 			"offer coin 10denom1 is smaller than the min amount 100: invalid request",
 		},
 		{
-			"insufficient offer coin amount",
-			func(msg *types.MsgMMOrder) {
-				msg.OfferCoin = utils.ParseCoin("1000000denom2")
-				msg.Price = utils.ParseDec("10")
-				msg.Amount = newInt(1000000)
+			"insufficient offer coin",
+			func(msg *types.MsgTriggerOrder) {
+				msg.OfferCoin = utils.ParseCoin("999999denom1")
 			},
-			"1000000denom2 is less than 10000000denom2: insufficient offer coin",
+			"999999denom1 is less than 1000000denom1: insufficient offer coin",
 		},
 		{
 			"invalid demand coin denom",
-			func(msg *types.MsgMMOrder) {
+			func(msg *types.MsgTriggerOrder) {
 				msg.DemandCoinDenom = "invaliddenom!"
 			},
 			"invalid demand coin denom: invalid denom: invaliddenom!",
 		},
 		{
 			"same offer coin denom and demand coin denom",
-			func(msg *types.MsgMMOrder) {
+			func(msg *types.MsgTriggerOrder) {
 				msg.OfferCoin = utils.ParseCoin("1000000denom1")
 				msg.DemandCoinDenom = "denom1"
 			},
 			"offer coin denom and demand coin denom must not be same: invalid request",
 		},
-		{
-			"invalid price",
-			func(msg *types.MsgMMOrder) {
-				msg.Price = utils.ParseDec("0")
-			},
-			"price must be positive: invalid request",
-		},
-		{
-			"zero order amount",
-			func(msg *types.MsgMMOrder) {
-				msg.Amount = sdk.ZeroInt()
-			},
-			"order amount 0 is smaller than the min amount 100: invalid request",
-		},
 		{
 			"small order amount",
-			func(msg *types.MsgMMOrder) {
+			func(msg *types.MsgTriggerOrder) {
 				msg.Amount = newInt(10)
 			},
 			"order amount 10 is smaller than the min amount 100: invalid request",
 		},
 		{
 			"invalid order lifespan",
-			func(msg *types.MsgMMOrder) {
+			func(msg *types.MsgTriggerOrder) {
 				msg.OrderLifespan = -1
 			},
 			"order lifespan must not be negative: -1ns: invalid request",
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
-			msg := types.NewMsgMMOrder(
-				testAddr, 1, types.OrderDirectionBuy, utils.ParseCoin("1000000denom2"),
-				"denom1", utils.ParseDec("1.0"), newInt(1000000), orderLifespan)
+			price := utils.ParseDec("1.0")
+			msg := types.NewMsgTriggerOrder(
+				testAddr, 1, types.OrderDirectionBuy, utils.ParseCoin("1000000denom1"),
+				"denom2", &price, newInt(1000000), utils.ParseDec("1.1"), types.TriggerConditionGTE, orderLifespan)
 			tc.malleate(msg)
-			require.Equal(t, types.TypeMsgMMOrder, msg.Type())
+			require.Equal(t, types.TypeMsgTriggerOrder, msg.Type())
 			require.Equal(t, types.RouterKey, msg.Route())
 			err := msg.ValidateBasic()
 			if tc.expectedErr == "" {
@@ -765,6 +1543,85 @@ func TestMsgCancelOrder(t *testing.T) {
 	}
 }
 
+func TestMsgReplaceOrder(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgReplaceOrder)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgReplaceOrder) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgReplaceOrder) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgReplaceOrder) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid order id",
+			func(msg *types.MsgReplaceOrder) {
+				msg.OrderId = 0
+			},
+			"order id must not be 0: invalid request",
+		},
+		{
+			"zero price",
+			func(msg *types.MsgReplaceOrder) {
+				msg.Price = sdk.ZeroDec()
+			},
+			"price must be positive: invalid request",
+		},
+		{
+			"too small amount",
+			func(msg *types.MsgReplaceOrder) {
+				msg.Amount = sdk.NewInt(50)
+			},
+			"order amount 50 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"too large amount",
+			func(msg *types.MsgReplaceOrder) {
+				msg.Amount = sdk.NewIntWithDecimal(1, 50)
+			},
+			"order amount 100000000000000000000000000000000000000000000000000 is bigger than the max amount 10000000000000000000000000000000000000000: invalid request",
+		},
+		{
+			"invalid order lifespan",
+			func(msg *types.MsgReplaceOrder) {
+				msg.OrderLifespan = -1
+			},
+			"order lifespan must not be negative: -1ns: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgReplaceOrder(testAddr, 1, 1, utils.ParseDec("1.0"), sdk.NewInt(1000000), 0)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgReplaceOrder, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestMsgCancelAllOrders(t *testing.T) {
 	for _, tc := range []struct {
 		name        string
@@ -815,3 +1672,83 @@ func TestMsgCancelAllOrders(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSwapExactIn(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgSwapExactIn)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgSwapExactIn) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgSwapExactIn) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"empty route",
+			func(msg *types.MsgSwapExactIn) {
+				msg.PairIds = nil
+			},
+			"route must not be empty: invalid request",
+		},
+		{
+			"too long route",
+			func(msg *types.MsgSwapExactIn) {
+				msg.PairIds = []uint64{1, 2, 3, 4, 5, 6}
+			},
+			"route length 6 is longer than 5: invalid request",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgSwapExactIn) {
+				msg.PairIds = []uint64{1, 0}
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"duplicate pair id",
+			func(msg *types.MsgSwapExactIn) {
+				msg.PairIds = []uint64{1, 2, 1}
+			},
+			"duplicate pair id presents in the pair id list",
+		},
+		{
+			"small offer coin amount",
+			func(msg *types.MsgSwapExactIn) {
+				msg.OfferCoin = utils.ParseCoin("10denom1")
+			},
+			"offer coin 10denom1 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"same offer coin denom and min out coin denom",
+			func(msg *types.MsgSwapExactIn) {
+				msg.MinOutCoin = utils.ParseCoin("1000denom1")
+			},
+			"offer coin denom and min out coin denom must not be same: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSwapExactIn(
+				testAddr, []uint64{1, 2}, utils.ParseCoin("1000000denom1"), utils.ParseCoin("1000denom3"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgSwapExactIn, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -59,6 +59,12 @@ func NewUserOrder(order Order) *UserOrder {
 	}
 }
 
+// IsFullyMatched returns whether the user order has been matched
+// by the whole open amount of the original order.
+func (order *UserOrder) IsFullyMatched(o Order) bool {
+	return order.OpenAmount.IsZero() && order.Amount.Equal(o.OpenAmount)
+}
+
 func (order *UserOrder) GetBatchId() uint64 {
 	return order.BatchId
 }
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	farmingtypes "github.com/crescent-network/crescent/v5/x/farming/types"
+	"github.com/crescent-network/crescent/v5/x/liquidity/amm"
 )
 
 func (pair Pair) GetEscrowAddress() sdk.AccAddress {
@@ -54,9 +55,81 @@ func (pair Pair) Validate() error {
 	if pair.CurrentBatchId == 0 {
 		return fmt.Errorf("current batch id must not be 0")
 	}
+	if !pair.AllocationPolicy.IsValid() {
+		return fmt.Errorf("invalid allocation policy: %s", pair.AllocationPolicy)
+	}
+	if pair.MakerFeeRate != nil {
+		if err := ValidatePairFeeRate(*pair.MakerFeeRate); err != nil {
+			return fmt.Errorf("invalid maker fee rate: %w", err)
+		}
+	}
+	if pair.TakerFeeRate != nil {
+		if err := ValidatePairFeeRate(*pair.TakerFeeRate); err != nil {
+			return fmt.Errorf("invalid taker fee rate: %w", err)
+		}
+	}
+	if pair.MinOrderNotional != nil {
+		if err := ValidatePairMinOrderNotional(*pair.MinOrderNotional); err != nil {
+			return fmt.Errorf("invalid min order notional: %w", err)
+		}
+	}
 	return nil
 }
 
+// ValidatePairMinOrderNotional validates a pair's minimum order notional.
+func ValidatePairMinOrderNotional(notional sdk.Int) error {
+	if !notional.IsPositive() {
+		return fmt.Errorf("min order notional must be positive: %s", notional)
+	}
+	return nil
+}
+
+// IsTooSmallOrderNotional returns whether the order's notional, which is
+// the quote coin amount of the order, is smaller than the pair's minimum
+// order notional.
+func (pair Pair) IsTooSmallOrderNotional(amt sdk.Int, price sdk.Dec) bool {
+	if pair.MinOrderNotional == nil {
+		return false
+	}
+	return price.MulInt(amt).LT(pair.MinOrderNotional.ToDec())
+}
+
+// ValidatePairFeeRate validates a pair's maker or taker fee rate.
+// The rate must be in range [0, 1).
+func ValidatePairFeeRate(feeRate sdk.Dec) error {
+	if feeRate.IsNegative() {
+		return fmt.Errorf("fee rate must not be negative: %s", feeRate)
+	}
+	if feeRate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("fee rate must be less than 1: %s", feeRate)
+	}
+	return nil
+}
+
+// IsValid returns true if the AllocationPolicy is one of:
+// AllocationPolicyPriority, AllocationPolicyProRata.
+func (policy AllocationPolicy) IsValid() bool {
+	switch policy {
+	case AllocationPolicyPriority, AllocationPolicyProRata:
+		return true
+	default:
+		return false
+	}
+}
+
+// AMMAllocationPolicy returns the amm.AllocationPolicy which corresponds to
+// the AllocationPolicy.
+func (policy AllocationPolicy) AMMAllocationPolicy() amm.AllocationPolicy {
+	switch policy {
+	case AllocationPolicyPriority:
+		return amm.PriorityAllocationPolicy{}
+	case AllocationPolicyProRata:
+		return amm.ProRataAllocationPolicy{}
+	default:
+		panic(fmt.Errorf("invalid allocation policy: %s", policy))
+	}
+}
+
 // PairEscrowAddress returns a unique address of the pair's escrow.
 func PairEscrowAddress(pairId uint64) sdk.AccAddress {
 	return farmingtypes.DeriveAddress(
@@ -63,6 +63,21 @@ func TestPair_Validate(t *testing.T) {
 			},
 			"current batch id must not be 0",
 		},
+		{
+			"invalid allocation policy",
+			func(pair *types.Pair) {
+				pair.AllocationPolicy = 10
+			},
+			"invalid allocation policy: 10",
+		},
+		{
+			"zero min order notional",
+			func(pair *types.Pair) {
+				notional := sdk.ZeroInt()
+				pair.MinOrderNotional = &notional
+			},
+			"invalid min order notional: min order notional must be positive: 0",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			pair := types.NewPair(1, "denom1", "denom2")
@@ -77,6 +92,17 @@ func TestPair_Validate(t *testing.T) {
 	}
 }
 
+func TestPair_IsTooSmallOrderNotional(t *testing.T) {
+	pair := types.NewPair(1, "denom1", "denom2")
+	require.False(t, pair.IsTooSmallOrderNotional(sdk.NewInt(100), sdk.NewDec(1)))
+
+	notional := sdk.NewInt(1000)
+	pair.MinOrderNotional = &notional
+	require.True(t, pair.IsTooSmallOrderNotional(sdk.NewInt(999), sdk.NewDec(1)))
+	require.False(t, pair.IsTooSmallOrderNotional(sdk.NewInt(1000), sdk.NewDec(1)))
+	require.False(t, pair.IsTooSmallOrderNotional(sdk.NewInt(500), sdk.NewDec(2)))
+}
+
 func TestPairEscrowAddress(t *testing.T) {
 	for _, tc := range []struct {
 		pairId   uint64
@@ -18,6 +18,8 @@ const (
 	DefaultMaxNumMarketMakingOrdersPerPair        = 15
 	DefaultMaxOrderLifespan                       = 24 * time.Hour
 	DefaultMaxNumActivePoolsPerPair               = 20
+	DefaultMaxNumTWAPSnapshots             uint32 = 1440 // a day of snapshots
+	DefaultMaxNumCandles                   uint32 = 1000
 )
 
 // Liquidity params default values
@@ -34,18 +36,23 @@ var (
 	DefaultDepositExtraGas          = sdk.Gas(60000)
 	DefaultWithdrawExtraGas         = sdk.Gas(64000)
 	DefaultOrderExtraGas            = sdk.Gas(37000)
+	DefaultCandleIntervals          = []time.Duration{time.Minute, time.Hour, 24 * time.Hour}
 )
 
 // General constants
 const (
-	PoolReserveAddressPrefix  = "PoolReserveAddress"
-	PairEscrowAddressPrefix   = "PairEscrowAddress"
-	ModuleAddressNameSplitter = "|"
-	AddressType               = farmingtypes.AddressType32Bytes
+	PoolReserveAddressPrefix       = "PoolReserveAddress"
+	PairEscrowAddressPrefix        = "PairEscrowAddress"
+	ModuleAddressNameSplitter      = "|"
+	AddressType                    = farmingtypes.AddressType32Bytes
+	MaxSwapRouteLength             = 5
+	DefaultQueryMaxSwapRouteLength = 3  // used when the max route length is not specified in the query
+	MaxNumBatchOrders              = 50 // max number of orders in a MsgBatchOrders
+	TWAPSnapshotInterval           = time.Minute
 )
 
 var (
-	// GlobalEscrowAddress is an escrow for deposit/withdraw requests.
+	// GlobalEscrowAddress is an escrow for deposit/withdraw/swap requests.
 	GlobalEscrowAddress = farmingtypes.DeriveAddress(AddressType, ModuleName, "GlobalEscrow")
 )
 
@@ -68,6 +75,9 @@ var (
 	KeyWithdrawExtraGas                = []byte("WithdrawExtraGas")
 	KeyOrderExtraGas                   = []byte("OrderExtraGas")
 	KeyMaxNumActivePoolsPerPair        = []byte("MaxNumActivePoolsPerPair")
+	KeyMaxNumTWAPSnapshots             = []byte("MaxNumTWAPSnapshots")
+	KeyCandleIntervals                 = []byte("CandleIntervals")
+	KeyMaxNumCandles                   = []byte("MaxNumCandles")
 )
 
 var _ paramstypes.ParamSet = (*Params)(nil)
@@ -97,6 +107,9 @@ func DefaultParams() Params {
 		WithdrawExtraGas:                DefaultWithdrawExtraGas,
 		OrderExtraGas:                   DefaultOrderExtraGas,
 		MaxNumActivePoolsPerPair:        DefaultMaxNumActivePoolsPerPair,
+		MaxNumTWAPSnapshots:             DefaultMaxNumTWAPSnapshots,
+		CandleIntervals:                 DefaultCandleIntervals,
+		MaxNumCandles:                   DefaultMaxNumCandles,
 	}
 }
 
@@ -121,6 +134,9 @@ func (params *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyWithdrawExtraGas, &params.WithdrawExtraGas, validateExtraGas),
 		paramstypes.NewParamSetPair(KeyOrderExtraGas, &params.OrderExtraGas, validateExtraGas),
 		paramstypes.NewParamSetPair(KeyMaxNumActivePoolsPerPair, &params.MaxNumActivePoolsPerPair, validateMaxNumActivePoolsPerPair),
+		paramstypes.NewParamSetPair(KeyMaxNumTWAPSnapshots, &params.MaxNumTWAPSnapshots, validateMaxNumTWAPSnapshots),
+		paramstypes.NewParamSetPair(KeyCandleIntervals, &params.CandleIntervals, validateCandleIntervals),
+		paramstypes.NewParamSetPair(KeyMaxNumCandles, &params.MaxNumCandles, validateMaxNumCandles),
 	}
 }
 
@@ -148,6 +164,9 @@ func (params Params) Validate() error {
 		{params.WithdrawExtraGas, validateExtraGas},
 		{params.OrderExtraGas, validateExtraGas},
 		{params.MaxNumActivePoolsPerPair, validateMaxNumActivePoolsPerPair},
+		{params.MaxNumTWAPSnapshots, validateMaxNumTWAPSnapshots},
+		{params.CandleIntervals, validateCandleIntervals},
+		{params.MaxNumCandles, validateMaxNumCandles},
 	} {
 		if err := field.validateFunc(field.val); err != nil {
 			return err
@@ -354,3 +373,49 @@ func validateMaxNumActivePoolsPerPair(i interface{}) error {
 	}
 	return nil
 }
+
+func validateMaxNumTWAPSnapshots(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max number of twap snapshots must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateCandleIntervals(i interface{}) error {
+	v, ok := i.([]time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	intervalSet := map[time.Duration]struct{}{}
+	for _, interval := range v {
+		if interval <= 0 {
+			return fmt.Errorf("candle interval must be positive: %s", interval)
+		}
+		if _, ok := intervalSet[interval]; ok {
+			return fmt.Errorf("duplicate candle interval: %s", interval)
+		}
+		intervalSet[interval] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateMaxNumCandles(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max number of candles must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -119,6 +120,34 @@ func TestParams_Validate(t *testing.T) {
 			},
 			"withdraw fee rate must not be negative: -1.000000000000000000",
 		},
+		{
+			"zero MaxNumTWAPSnapshots",
+			func(params *types.Params) {
+				params.MaxNumTWAPSnapshots = 0
+			},
+			"max number of twap snapshots must be positive: 0",
+		},
+		{
+			"zero CandleIntervals",
+			func(params *types.Params) {
+				params.CandleIntervals = []time.Duration{0}
+			},
+			"candle interval must be positive: 0s",
+		},
+		{
+			"duplicate CandleIntervals",
+			func(params *types.Params) {
+				params.CandleIntervals = []time.Duration{time.Hour, time.Minute, time.Hour}
+			},
+			"duplicate candle interval: 1h0m0s",
+		},
+		{
+			"zero MaxNumCandles",
+			func(params *types.Params) {
+				params.MaxNumCandles = 0
+			},
+			"max number of candles must be positive: 0",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultParams()
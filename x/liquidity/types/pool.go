@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -78,6 +79,56 @@ func NewRangedPool(id, pairId uint64, creator sdk.AccAddress, minPrice, maxPrice
 	}
 }
 
+// NewStablePool returns a new stable pool object.
+func NewStablePool(id, pairId uint64, creator sdk.AccAddress, amplification uint32) Pool {
+	return Pool{
+		Type:                  PoolTypeStable,
+		Id:                    id,
+		PairId:                pairId,
+		Creator:               creator.String(),
+		ReserveAddress:        PoolReserveAddress(id).String(),
+		PoolCoinDenom:         PoolCoinDenom(id),
+		LastDepositRequestId:  0,
+		LastWithdrawRequestId: 0,
+		Disabled:              false,
+		Amplification:         amplification,
+	}
+}
+
+// NewWeightedPool returns a new weighted pool object.
+func NewWeightedPool(id, pairId uint64, creator sdk.AccAddress, baseCoinWeight, quoteCoinWeight uint32) Pool {
+	return Pool{
+		Type:                  PoolTypeWeighted,
+		Id:                    id,
+		PairId:                pairId,
+		Creator:               creator.String(),
+		ReserveAddress:        PoolReserveAddress(id).String(),
+		PoolCoinDenom:         PoolCoinDenom(id),
+		LastDepositRequestId:  0,
+		LastWithdrawRequestId: 0,
+		Disabled:              false,
+		BaseCoinWeight:        baseCoinWeight,
+		QuoteCoinWeight:       quoteCoinWeight,
+	}
+}
+
+// NewConcentratedPool returns a new concentrated pool object.
+func NewConcentratedPool(id, pairId uint64, creator sdk.AccAddress, price sdk.Dec) Pool {
+	return Pool{
+		Type:                  PoolTypeConcentrated,
+		Id:                    id,
+		PairId:                pairId,
+		Creator:               creator.String(),
+		ReserveAddress:        PoolReserveAddress(id).String(),
+		PoolCoinDenom:         PoolCoinDenom(id),
+		LastDepositRequestId:  0,
+		LastWithdrawRequestId: 0,
+		Disabled:              false,
+		Price:                 &price,
+		LastPositionId:        0,
+	}
+}
+
 func (pool Pool) GetCreator() sdk.AccAddress {
 	if pool.Creator == "" {
 		return nil
@@ -111,9 +162,80 @@ func (pool Pool) Validate() error {
 	if err := sdk.ValidateDenom(pool.PoolCoinDenom); err != nil {
 		return fmt.Errorf("invalid pool coin denom: %w", err)
 	}
+	if pool.Type == PoolTypeStable {
+		if err := amm.ValidateStablePoolAmplification(pool.Amplification); err != nil {
+			return err
+		}
+	}
+	if pool.Type == PoolTypeWeighted {
+		if err := amm.ValidateWeightedPoolWeights(pool.QuoteCoinWeight, pool.BaseCoinWeight); err != nil {
+			return err
+		}
+	}
+	if pool.Type == PoolTypeConcentrated {
+		if pool.Price == nil {
+			return fmt.Errorf("concentrated pool must have price")
+		}
+		if pool.Price.LT(amm.MinPoolPrice) || pool.Price.GT(amm.MaxPoolPrice) {
+			return fmt.Errorf("pool price is out of range: %s", pool.Price)
+		}
+		for i, tick := range pool.LiquidityTicks {
+			if i > 0 && !pool.LiquidityTicks[i-1].Price.LT(tick.Price) {
+				return fmt.Errorf("liquidity ticks are not sorted by price")
+			}
+			if tick.LiquidityNet.IsZero() {
+				return fmt.Errorf("liquidity tick at %s has zero net liquidity", tick.Price)
+			}
+		}
+	}
+	if pool.MigratedToPoolId != 0 {
+		if pool.MigratedToPoolId == pool.Id {
+			return fmt.Errorf("pool cannot be migrated to itself")
+		}
+		if !pool.Disabled {
+			return fmt.Errorf("migrated pool must be disabled")
+		}
+	}
 	return nil
 }
 
+// AMMLiquidityTicks returns the pool's liquidity ticks as amm.LiquidityTick.
+func (pool Pool) AMMLiquidityTicks() []amm.LiquidityTick {
+	ticks := make([]amm.LiquidityTick, len(pool.LiquidityTicks))
+	for i, tick := range pool.LiquidityTicks {
+		ticks[i] = amm.LiquidityTick{Price: tick.Price, LiquidityNet: tick.LiquidityNet}
+	}
+	return ticks
+}
+
+// AddLiquidity adds liquidity to the pool's liquidity curve within
+// the price range [lowerPrice, upperPrice].
+// Negative liquidity removes liquidity from the curve.
+func (pool *Pool) AddLiquidity(lowerPrice, upperPrice, liquidity sdk.Dec) {
+	pool.addLiquidityNet(lowerPrice, liquidity)
+	pool.addLiquidityNet(upperPrice, liquidity.Neg())
+}
+
+// addLiquidityNet adds net liquidity to the tick at the price, keeping
+// the ticks sorted and removing ticks with zero net liquidity.
+func (pool *Pool) addLiquidityNet(price, liquidityNet sdk.Dec) {
+	i := sort.Search(len(pool.LiquidityTicks), func(i int) bool {
+		return pool.LiquidityTicks[i].Price.GTE(price)
+	})
+	if i < len(pool.LiquidityTicks) && pool.LiquidityTicks[i].Price.Equal(price) {
+		net := pool.LiquidityTicks[i].LiquidityNet.Add(liquidityNet)
+		if net.IsZero() {
+			pool.LiquidityTicks = append(pool.LiquidityTicks[:i], pool.LiquidityTicks[i+1:]...)
+		} else {
+			pool.LiquidityTicks[i].LiquidityNet = net
+		}
+		return
+	}
+	pool.LiquidityTicks = append(pool.LiquidityTicks, LiquidityTick{})
+	copy(pool.LiquidityTicks[i+1:], pool.LiquidityTicks[i:])
+	pool.LiquidityTicks[i] = LiquidityTick{Price: price, LiquidityNet: liquidityNet}
+}
+
 // AMMPool constructs amm.Pool interface from Pool.
 func (pool Pool) AMMPool(rx, ry, ps sdk.Int) amm.Pool {
 	switch pool.Type {
@@ -121,6 +243,14 @@ func (pool Pool) AMMPool(rx, ry, ps sdk.Int) amm.Pool {
 		return amm.NewBasicPool(rx, ry, ps)
 	case PoolTypeRanged:
 		return amm.NewRangedPool(rx, ry, ps, *pool.MinPrice, *pool.MaxPrice)
+	case PoolTypeStable:
+		return amm.NewStablePool(rx, ry, ps, pool.Amplification)
+	case PoolTypeWeighted:
+		return amm.NewWeightedPool(rx, ry, ps, pool.QuoteCoinWeight, pool.BaseCoinWeight)
+	case PoolTypeConcentrated:
+		// A concentrated pool's balances are derived from its liquidity curve,
+		// so rx, ry and ps are ignored.
+		return amm.NewConcentratedPool(*pool.Price, pool.AMMLiquidityTicks())
 	default:
 		panic(fmt.Errorf("invalid pool type: %s", pool.Type))
 	}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PoolAccrualSnapshotInterval is the interval between pool accrual
+	// snapshots.
+	PoolAccrualSnapshotInterval = time.Hour
+	// MaxPoolAccrualSnapshotAge is the max age of the pool accrual snapshots
+	// used by the pool stats.
+	// Snapshots older than MaxPoolAccrualSnapshotAge+PoolAccrualSnapshotInterval
+	// are pruned.
+	MaxPoolAccrualSnapshotAge = 7 * 24 * time.Hour
+)
+
+// NewPoolAccrual returns a new PoolAccrual with zero volume and fees.
+func NewPoolAccrual(poolId uint64) PoolAccrual {
+	return PoolAccrual{
+		PoolId: poolId,
+		Volume: sdk.ZeroInt(),
+		Fees:   sdk.ZeroInt(),
+	}
+}
+
+// Validate validates PoolAccrual for genesis.
+func (accrual PoolAccrual) Validate() error {
+	if accrual.PoolId == 0 {
+		return fmt.Errorf("pool id must not be 0")
+	}
+	if accrual.Volume.IsNegative() {
+		return fmt.Errorf("volume must not be negative: %s", accrual.Volume)
+	}
+	if accrual.Fees.IsNegative() {
+		return fmt.Errorf("fees must not be negative: %s", accrual.Fees)
+	}
+	return nil
+}
+
+// Snapshot returns the snapshot of the pool accrual at given time.
+func (accrual PoolAccrual) Snapshot(t time.Time) PoolAccrualSnapshot {
+	return PoolAccrualSnapshot{
+		PoolId: accrual.PoolId,
+		Time:   t,
+		Volume: accrual.Volume,
+		Fees:   accrual.Fees,
+	}
+}
+
+// Validate validates PoolAccrualSnapshot for genesis.
+func (snapshot PoolAccrualSnapshot) Validate() error {
+	if snapshot.PoolId == 0 {
+		return fmt.Errorf("pool id must not be 0")
+	}
+	if snapshot.Volume.IsNegative() {
+		return fmt.Errorf("volume must not be negative: %s", snapshot.Volume)
+	}
+	if snapshot.Fees.IsNegative() {
+		return fmt.Errorf("fees must not be negative: %s", snapshot.Fees)
+	}
+	return nil
+}
+
+// MustMarshalPoolAccrual returns the pool accrual bytes.
+// It throws panic if it fails.
+func MustMarshalPoolAccrual(cdc codec.BinaryCodec, accrual PoolAccrual) []byte {
+	return cdc.MustMarshal(&accrual)
+}
+
+// MustUnmarshalPoolAccrual return the unmarshalled pool accrual from bytes.
+// It throws panic if it fails.
+func MustUnmarshalPoolAccrual(cdc codec.BinaryCodec, value []byte) PoolAccrual {
+	accrual, err := UnmarshalPoolAccrual(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return accrual
+}
+
+// UnmarshalPoolAccrual returns the pool accrual from bytes.
+func UnmarshalPoolAccrual(cdc codec.BinaryCodec, value []byte) (accrual PoolAccrual, err error) {
+	err = cdc.Unmarshal(value, &accrual)
+	return accrual, err
+}
+
+// MustMarshalPoolAccrualSnapshot returns the pool accrual snapshot bytes.
+// It throws panic if it fails.
+func MustMarshalPoolAccrualSnapshot(cdc codec.BinaryCodec, snapshot PoolAccrualSnapshot) []byte {
+	return cdc.MustMarshal(&snapshot)
+}
+
+// MustUnmarshalPoolAccrualSnapshot return the unmarshalled pool accrual
+// snapshot from bytes.
+// It throws panic if it fails.
+func MustUnmarshalPoolAccrualSnapshot(cdc codec.BinaryCodec, value []byte) PoolAccrualSnapshot {
+	snapshot, err := UnmarshalPoolAccrualSnapshot(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return snapshot
+}
+
+// UnmarshalPoolAccrualSnapshot returns the pool accrual snapshot from bytes.
+func UnmarshalPoolAccrualSnapshot(cdc codec.BinaryCodec, value []byte) (snapshot PoolAccrualSnapshot, err error) {
+	err = cdc.Unmarshal(value, &snapshot)
+	return snapshot, err
+}
@@ -3,8 +3,10 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	utils "github.com/crescent-network/crescent/v5/types"
 	"github.com/crescent-network/crescent/v5/x/liquidity/types"
 )
 
@@ -64,3 +66,26 @@ func TestParsePoolCoinDenomFailure(t *testing.T) {
 		})
 	}
 }
+
+func TestPool_AddLiquidity(t *testing.T) {
+	pool := types.NewConcentratedPool(1, 1, testAddr, utils.ParseDec("1.0"))
+
+	pool.AddLiquidity(utils.ParseDec("0.5"), utils.ParseDec("2.0"), sdk.NewDec(100))
+	pool.AddLiquidity(utils.ParseDec("0.8"), utils.ParseDec("2.0"), sdk.NewDec(50))
+	pool.AddLiquidity(utils.ParseDec("0.4"), utils.ParseDec("0.8"), sdk.NewDec(10))
+	require.Equal(t, []types.LiquidityTick{
+		{Price: utils.ParseDec("0.4"), LiquidityNet: sdk.NewDec(10)},
+		{Price: utils.ParseDec("0.5"), LiquidityNet: sdk.NewDec(100)},
+		{Price: utils.ParseDec("0.8"), LiquidityNet: sdk.NewDec(40)},
+		{Price: utils.ParseDec("2.0"), LiquidityNet: sdk.NewDec(-150)},
+	}, pool.LiquidityTicks)
+	require.NoError(t, pool.Validate())
+
+	// Ticks with zero net liquidity are removed.
+	pool.AddLiquidity(utils.ParseDec("0.8"), utils.ParseDec("2.0"), sdk.NewDec(-50))
+	pool.AddLiquidity(utils.ParseDec("0.4"), utils.ParseDec("0.8"), sdk.NewDec(-10))
+	require.Equal(t, []types.LiquidityTick{
+		{Price: utils.ParseDec("0.5"), LiquidityNet: sdk.NewDec(100)},
+		{Price: utils.ParseDec("2.0"), LiquidityNet: sdk.NewDec(-100)},
+	}, pool.LiquidityTicks)
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/crescent-network/crescent/v5/x/liquidity/amm"
+)
+
+// NewPosition returns a new Position.
+func NewPosition(id, poolId uint64, owner sdk.AccAddress, lowerPrice, upperPrice, liquidity sdk.Dec) Position {
+	return Position{
+		Id:         id,
+		PoolId:     poolId,
+		Owner:      owner.String(),
+		LowerPrice: lowerPrice,
+		UpperPrice: upperPrice,
+		Liquidity:  liquidity,
+		Fees:       nil,
+	}
+}
+
+func (position Position) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(position.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate validates Position for genesis.
+func (position Position) Validate() error {
+	if position.Id == 0 {
+		return fmt.Errorf("id must not be 0")
+	}
+	if position.PoolId == 0 {
+		return fmt.Errorf("pool id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(position.Owner); err != nil {
+		return fmt.Errorf("invalid owner address %s: %w", position.Owner, err)
+	}
+	if err := amm.ValidateConcentratedLiquidityRange(position.LowerPrice, position.UpperPrice); err != nil {
+		return err
+	}
+	if !position.Liquidity.IsPositive() {
+		return fmt.Errorf("liquidity must be positive: %s", position.Liquidity)
+	}
+	if err := position.Fees.Validate(); err != nil {
+		return fmt.Errorf("invalid fees: %w", err)
+	}
+	return nil
+}
+
+// Amounts returns the quote coin and base coin amounts of the position
+// at given pool price.
+func (position Position) Amounts(price sdk.Dec) (x, y sdk.Dec) {
+	return amm.ConcentratedLiquidityAmounts(position.Liquidity, position.LowerPrice, position.UpperPrice, price)
+}
+
+// MustMarshalPosition returns the position bytes.
+// It throws panic if it fails.
+func MustMarshalPosition(cdc codec.BinaryCodec, position Position) []byte {
+	return cdc.MustMarshal(&position)
+}
+
+// MustUnmarshalPosition return the unmarshalled position from bytes.
+// It throws panic if it fails.
+func MustUnmarshalPosition(cdc codec.BinaryCodec, value []byte) Position {
+	position, err := UnmarshalPosition(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return position
+}
+
+// UnmarshalPosition returns the position from bytes.
+func UnmarshalPosition(cdc codec.BinaryCodec, value []byte) (position Position, err error) {
+	err = cdc.Unmarshal(value, &position)
+	return position, err
+}
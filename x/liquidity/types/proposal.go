@@ -0,0 +1,347 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/crescent-network/crescent/v5/x/liquidity/amm"
+)
+
+const (
+	ProposalTypePairFeeRates            string = "PairFeeRates"
+	ProposalTypeStablePoolAmplification string = "StablePoolAmplification"
+	ProposalTypePoolManagement          string = "PoolManagement"
+	ProposalTypePairOrderRules          string = "PairOrderRules"
+)
+
+var (
+	_ gov.Content = &PairFeeRatesProposal{}
+	_ gov.Content = &StablePoolAmplificationProposal{}
+	_ gov.Content = &PoolManagementProposal{}
+	_ gov.Content = &PairOrderRulesProposal{}
+)
+
+func init() {
+	gov.RegisterProposalType(ProposalTypePairFeeRates)
+	gov.RegisterProposalTypeCodec(&PairFeeRatesProposal{}, "crescent/PairFeeRatesProposal")
+	gov.RegisterProposalType(ProposalTypeStablePoolAmplification)
+	gov.RegisterProposalTypeCodec(&StablePoolAmplificationProposal{}, "crescent/StablePoolAmplificationProposal")
+	gov.RegisterProposalType(ProposalTypePoolManagement)
+	gov.RegisterProposalTypeCodec(&PoolManagementProposal{}, "crescent/PoolManagementProposal")
+	gov.RegisterProposalType(ProposalTypePairOrderRules)
+	gov.RegisterProposalTypeCodec(&PairOrderRulesProposal{}, "crescent/PairOrderRulesProposal")
+}
+
+// NewPairFeeRatesProposal returns a new PairFeeRatesProposal.
+func NewPairFeeRatesProposal(title, description string, changes []PairFeeRatesChange) *PairFeeRatesProposal {
+	return &PairFeeRatesProposal{
+		Title:       title,
+		Description: description,
+		Changes:     changes,
+	}
+}
+
+func (p *PairFeeRatesProposal) GetTitle() string       { return p.Title }
+func (p *PairFeeRatesProposal) GetDescription() string { return p.Description }
+func (p *PairFeeRatesProposal) ProposalRoute() string  { return RouterKey }
+func (p *PairFeeRatesProposal) ProposalType() string   { return ProposalTypePairFeeRates }
+
+func (p *PairFeeRatesProposal) ValidateBasic() error {
+	if len(p.Changes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "changes must not be empty")
+	}
+	pairIdSet := map[uint64]struct{}{}
+	for _, change := range p.Changes {
+		if err := change.Validate(); err != nil {
+			return err
+		}
+		if _, ok := pairIdSet[change.PairId]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate pair id: %d", change.PairId)
+		}
+		pairIdSet[change.PairId] = struct{}{}
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p PairFeeRatesProposal) String() string {
+	return fmt.Sprintf(`Pair Fee Rates Proposal:
+  Title:       %s
+  Description: %s
+  Changes:     %v
+`, p.Title, p.Description, p.Changes)
+}
+
+// NewPairFeeRatesChange returns a new PairFeeRatesChange.
+func NewPairFeeRatesChange(pairId uint64, makerFeeRate, takerFeeRate *sdk.Dec) PairFeeRatesChange {
+	return PairFeeRatesChange{
+		PairId:       pairId,
+		MakerFeeRate: makerFeeRate,
+		TakerFeeRate: takerFeeRate,
+	}
+}
+
+func (change PairFeeRatesChange) Validate() error {
+	if change.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if change.MakerFeeRate != nil {
+		if err := ValidatePairFeeRate(*change.MakerFeeRate); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid maker fee rate: %v", err)
+		}
+	}
+	if change.TakerFeeRate != nil {
+		if err := ValidatePairFeeRate(*change.TakerFeeRate); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid taker fee rate: %v", err)
+		}
+	}
+	return nil
+}
+
+// NewStablePoolAmplificationProposal returns a new StablePoolAmplificationProposal.
+func NewStablePoolAmplificationProposal(title, description string, changes []StablePoolAmplificationChange) *StablePoolAmplificationProposal {
+	return &StablePoolAmplificationProposal{
+		Title:       title,
+		Description: description,
+		Changes:     changes,
+	}
+}
+
+func (p *StablePoolAmplificationProposal) GetTitle() string       { return p.Title }
+func (p *StablePoolAmplificationProposal) GetDescription() string { return p.Description }
+func (p *StablePoolAmplificationProposal) ProposalRoute() string  { return RouterKey }
+func (p *StablePoolAmplificationProposal) ProposalType() string {
+	return ProposalTypeStablePoolAmplification
+}
+
+func (p *StablePoolAmplificationProposal) ValidateBasic() error {
+	if len(p.Changes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "changes must not be empty")
+	}
+	poolIdSet := map[uint64]struct{}{}
+	for _, change := range p.Changes {
+		if err := change.Validate(); err != nil {
+			return err
+		}
+		if _, ok := poolIdSet[change.PoolId]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate pool id: %d", change.PoolId)
+		}
+		poolIdSet[change.PoolId] = struct{}{}
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p StablePoolAmplificationProposal) String() string {
+	return fmt.Sprintf(`Stable Pool Amplification Proposal:
+  Title:       %s
+  Description: %s
+  Changes:     %v
+`, p.Title, p.Description, p.Changes)
+}
+
+// NewStablePoolAmplificationChange returns a new StablePoolAmplificationChange.
+func NewStablePoolAmplificationChange(poolId uint64, amplification uint32) StablePoolAmplificationChange {
+	return StablePoolAmplificationChange{
+		PoolId:        poolId,
+		Amplification: amplification,
+	}
+}
+
+func (change StablePoolAmplificationChange) Validate() error {
+	if change.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	if err := amm.ValidateStablePoolAmplification(change.Amplification); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// NewPoolManagementProposal returns a new PoolManagementProposal.
+func NewPoolManagementProposal(
+	title, description string, pairChanges []PairStatusChange, poolChanges []PoolStatusChange,
+	migrations []PoolMigration) *PoolManagementProposal {
+	return &PoolManagementProposal{
+		Title:       title,
+		Description: description,
+		PairChanges: pairChanges,
+		PoolChanges: poolChanges,
+		Migrations:  migrations,
+	}
+}
+
+func (p *PoolManagementProposal) GetTitle() string       { return p.Title }
+func (p *PoolManagementProposal) GetDescription() string { return p.Description }
+func (p *PoolManagementProposal) ProposalRoute() string  { return RouterKey }
+func (p *PoolManagementProposal) ProposalType() string   { return ProposalTypePoolManagement }
+
+func (p *PoolManagementProposal) ValidateBasic() error {
+	if len(p.PairChanges) == 0 && len(p.PoolChanges) == 0 && len(p.Migrations) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "changes and migrations must not be empty")
+	}
+	pairIdSet := map[uint64]struct{}{}
+	for _, change := range p.PairChanges {
+		if err := change.Validate(); err != nil {
+			return err
+		}
+		if _, ok := pairIdSet[change.PairId]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate pair id: %d", change.PairId)
+		}
+		pairIdSet[change.PairId] = struct{}{}
+	}
+	poolIdSet := map[uint64]struct{}{}
+	for _, change := range p.PoolChanges {
+		if err := change.Validate(); err != nil {
+			return err
+		}
+		if _, ok := poolIdSet[change.PoolId]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate pool id: %d", change.PoolId)
+		}
+		poolIdSet[change.PoolId] = struct{}{}
+	}
+	// A pool can be migrated only once, and a migrated pool cannot be
+	// the target of another migration.
+	sourcePoolIdSet := map[uint64]struct{}{}
+	for _, migration := range p.Migrations {
+		if err := migration.Validate(); err != nil {
+			return err
+		}
+		if _, ok := sourcePoolIdSet[migration.SourcePoolId]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate source pool id: %d", migration.SourcePoolId)
+		}
+		sourcePoolIdSet[migration.SourcePoolId] = struct{}{}
+	}
+	for _, migration := range p.Migrations {
+		if _, ok := sourcePoolIdSet[migration.TargetPoolId]; ok {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "pool %d cannot be both a source and a target", migration.TargetPoolId)
+		}
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p PoolManagementProposal) String() string {
+	return fmt.Sprintf(`Pool Management Proposal:
+  Title:        %s
+  Description:  %s
+  Pair Changes: %v
+  Pool Changes: %v
+  Migrations:   %v
+`, p.Title, p.Description, p.PairChanges, p.PoolChanges, p.Migrations)
+}
+
+// NewPairStatusChange returns a new PairStatusChange.
+func NewPairStatusChange(pairId uint64, depositsPaused, ordersPaused bool) PairStatusChange {
+	return PairStatusChange{
+		PairId:         pairId,
+		DepositsPaused: depositsPaused,
+		OrdersPaused:   ordersPaused,
+	}
+}
+
+func (change PairStatusChange) Validate() error {
+	if change.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	return nil
+}
+
+// NewPoolStatusChange returns a new PoolStatusChange.
+func NewPoolStatusChange(poolId uint64, depositsPaused, ordersPaused, disabled bool) PoolStatusChange {
+	return PoolStatusChange{
+		PoolId:         poolId,
+		DepositsPaused: depositsPaused,
+		OrdersPaused:   ordersPaused,
+		Disabled:       disabled,
+	}
+}
+
+func (change PoolStatusChange) Validate() error {
+	if change.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pool id must not be 0")
+	}
+	return nil
+}
+
+// NewPoolMigration returns a new PoolMigration.
+func NewPoolMigration(sourcePoolId, targetPoolId uint64) PoolMigration {
+	return PoolMigration{
+		SourcePoolId: sourcePoolId,
+		TargetPoolId: targetPoolId,
+	}
+}
+
+func (migration PoolMigration) Validate() error {
+	if migration.SourcePoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "source pool id must not be 0")
+	}
+	if migration.TargetPoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "target pool id must not be 0")
+	}
+	if migration.SourcePoolId == migration.TargetPoolId {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "source pool and target pool must be different")
+	}
+	return nil
+}
+
+// NewPairOrderRulesProposal returns a new PairOrderRulesProposal.
+func NewPairOrderRulesProposal(title, description string, changes []PairOrderRulesChange) *PairOrderRulesProposal {
+	return &PairOrderRulesProposal{
+		Title:       title,
+		Description: description,
+		Changes:     changes,
+	}
+}
+
+func (p *PairOrderRulesProposal) GetTitle() string       { return p.Title }
+func (p *PairOrderRulesProposal) GetDescription() string { return p.Description }
+func (p *PairOrderRulesProposal) ProposalRoute() string  { return RouterKey }
+func (p *PairOrderRulesProposal) ProposalType() string   { return ProposalTypePairOrderRules }
+
+func (p *PairOrderRulesProposal) ValidateBasic() error {
+	if len(p.Changes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "changes must not be empty")
+	}
+	pairIdSet := map[uint64]struct{}{}
+	for _, change := range p.Changes {
+		if err := change.Validate(); err != nil {
+			return err
+		}
+		if _, ok := pairIdSet[change.PairId]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate pair id: %d", change.PairId)
+		}
+		pairIdSet[change.PairId] = struct{}{}
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p PairOrderRulesProposal) String() string {
+	return fmt.Sprintf(`Pair Order Rules Proposal:
+  Title:       %s
+  Description: %s
+  Changes:     %v
+`, p.Title, p.Description, p.Changes)
+}
+
+// NewPairOrderRulesChange returns a new PairOrderRulesChange.
+func NewPairOrderRulesChange(pairId uint64, tickPrec *uint32, minOrderNotional *sdk.Int) PairOrderRulesChange {
+	return PairOrderRulesChange{
+		PairId:           pairId,
+		TickPrecision:    tickPrec,
+		MinOrderNotional: minOrderNotional,
+	}
+}
+
+func (change PairOrderRulesChange) Validate() error {
+	if change.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if change.MinOrderNotional != nil {
+		if err := ValidatePairMinOrderNotional(*change.MinOrderNotional); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,356 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	utils "github.com/crescent-network/crescent/v5/types"
+	"github.com/crescent-network/crescent/v5/x/liquidity/types"
+)
+
+func TestPairFeeRatesProposal_ValidateBasic(t *testing.T) {
+	feeRate := func(s string) *sdk.Dec {
+		d := utils.ParseDec(s)
+		return &d
+	}
+	for _, tc := range []struct {
+		name        string
+		malleate    func(p *types.PairFeeRatesProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(p *types.PairFeeRatesProposal) {},
+			"",
+		},
+		{
+			"reset fee rates",
+			func(p *types.PairFeeRatesProposal) {
+				p.Changes[0].MakerFeeRate = nil
+				p.Changes[0].TakerFeeRate = nil
+			},
+			"",
+		},
+		{
+			"empty changes",
+			func(p *types.PairFeeRatesProposal) {
+				p.Changes = nil
+			},
+			"changes must not be empty: invalid request",
+		},
+		{
+			"zero pair id",
+			func(p *types.PairFeeRatesProposal) {
+				p.Changes[0].PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"duplicate pair id",
+			func(p *types.PairFeeRatesProposal) {
+				p.Changes = append(p.Changes, p.Changes[0])
+			},
+			"duplicate pair id: 1: invalid request",
+		},
+		{
+			"negative maker fee rate",
+			func(p *types.PairFeeRatesProposal) {
+				p.Changes[0].MakerFeeRate = feeRate("-0.001")
+			},
+			"invalid maker fee rate: fee rate must not be negative: -0.001000000000000000: invalid request",
+		},
+		{
+			"too high taker fee rate",
+			func(p *types.PairFeeRatesProposal) {
+				p.Changes[0].TakerFeeRate = feeRate("1.0")
+			},
+			"invalid taker fee rate: fee rate must be less than 1: 1.000000000000000000: invalid request",
+		},
+		{
+			"empty title",
+			func(p *types.PairFeeRatesProposal) {
+				p.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := types.NewPairFeeRatesProposal(
+				"Title", "Description",
+				[]types.PairFeeRatesChange{
+					types.NewPairFeeRatesChange(1, feeRate("0.001"), feeRate("0.003")),
+				})
+			tc.malleate(p)
+			err := p.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestStablePoolAmplificationProposal_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(p *types.StablePoolAmplificationProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(p *types.StablePoolAmplificationProposal) {},
+			"",
+		},
+		{
+			"empty changes",
+			func(p *types.StablePoolAmplificationProposal) {
+				p.Changes = nil
+			},
+			"changes must not be empty: invalid request",
+		},
+		{
+			"zero pool id",
+			func(p *types.StablePoolAmplificationProposal) {
+				p.Changes[0].PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"duplicate pool id",
+			func(p *types.StablePoolAmplificationProposal) {
+				p.Changes = append(p.Changes, p.Changes[0])
+			},
+			"duplicate pool id: 1: invalid request",
+		},
+		{
+			"zero amplification",
+			func(p *types.StablePoolAmplificationProposal) {
+				p.Changes[0].Amplification = 0
+			},
+			"amplification must not be lower than 1: invalid request",
+		},
+		{
+			"too high amplification",
+			func(p *types.StablePoolAmplificationProposal) {
+				p.Changes[0].Amplification = 10001
+			},
+			"amplification must not be greater than 10000: invalid request",
+		},
+		{
+			"empty title",
+			func(p *types.StablePoolAmplificationProposal) {
+				p.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := types.NewStablePoolAmplificationProposal(
+				"Title", "Description",
+				[]types.StablePoolAmplificationChange{
+					types.NewStablePoolAmplificationChange(1, 100),
+				})
+			tc.malleate(p)
+			err := p.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPoolManagementProposal_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(p *types.PoolManagementProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(p *types.PoolManagementProposal) {},
+			"",
+		},
+		{
+			"only migrations",
+			func(p *types.PoolManagementProposal) {
+				p.PairChanges = nil
+				p.PoolChanges = nil
+			},
+			"",
+		},
+		{
+			"empty changes and migrations",
+			func(p *types.PoolManagementProposal) {
+				p.PairChanges = nil
+				p.PoolChanges = nil
+				p.Migrations = nil
+			},
+			"changes and migrations must not be empty: invalid request",
+		},
+		{
+			"zero pair id",
+			func(p *types.PoolManagementProposal) {
+				p.PairChanges[0].PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"duplicate pair id",
+			func(p *types.PoolManagementProposal) {
+				p.PairChanges = append(p.PairChanges, p.PairChanges[0])
+			},
+			"duplicate pair id: 1: invalid request",
+		},
+		{
+			"zero pool id",
+			func(p *types.PoolManagementProposal) {
+				p.PoolChanges[0].PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"duplicate pool id",
+			func(p *types.PoolManagementProposal) {
+				p.PoolChanges = append(p.PoolChanges, p.PoolChanges[0])
+			},
+			"duplicate pool id: 3: invalid request",
+		},
+		{
+			"zero source pool id",
+			func(p *types.PoolManagementProposal) {
+				p.Migrations[0].SourcePoolId = 0
+			},
+			"source pool id must not be 0: invalid request",
+		},
+		{
+			"zero target pool id",
+			func(p *types.PoolManagementProposal) {
+				p.Migrations[0].TargetPoolId = 0
+			},
+			"target pool id must not be 0: invalid request",
+		},
+		{
+			"same source and target",
+			func(p *types.PoolManagementProposal) {
+				p.Migrations[0].TargetPoolId = 1
+			},
+			"source pool and target pool must be different: invalid request",
+		},
+		{
+			"duplicate source pool id",
+			func(p *types.PoolManagementProposal) {
+				p.Migrations = append(p.Migrations, types.NewPoolMigration(1, 4))
+			},
+			"duplicate source pool id: 1: invalid request",
+		},
+		{
+			"migrated pool as a target",
+			func(p *types.PoolManagementProposal) {
+				p.Migrations = append(p.Migrations, types.NewPoolMigration(4, 1))
+			},
+			"pool 1 cannot be both a source and a target: invalid request",
+		},
+		{
+			"empty title",
+			func(p *types.PoolManagementProposal) {
+				p.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := types.NewPoolManagementProposal(
+				"Title", "Description",
+				[]types.PairStatusChange{types.NewPairStatusChange(1, true, false)},
+				[]types.PoolStatusChange{types.NewPoolStatusChange(3, false, true, false)},
+				[]types.PoolMigration{types.NewPoolMigration(1, 2)})
+			tc.malleate(p)
+			err := p.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPairOrderRulesProposal_ValidateBasic(t *testing.T) {
+	notional := func(i int64) *sdk.Int {
+		amt := sdk.NewInt(i)
+		return &amt
+	}
+	for _, tc := range []struct {
+		name        string
+		malleate    func(p *types.PairOrderRulesProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(p *types.PairOrderRulesProposal) {},
+			"",
+		},
+		{
+			"reset order rules",
+			func(p *types.PairOrderRulesProposal) {
+				p.Changes[0].TickPrecision = nil
+				p.Changes[0].MinOrderNotional = nil
+			},
+			"",
+		},
+		{
+			"empty changes",
+			func(p *types.PairOrderRulesProposal) {
+				p.Changes = nil
+			},
+			"changes must not be empty: invalid request",
+		},
+		{
+			"zero pair id",
+			func(p *types.PairOrderRulesProposal) {
+				p.Changes[0].PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"duplicate pair id",
+			func(p *types.PairOrderRulesProposal) {
+				p.Changes = append(p.Changes, p.Changes[0])
+			},
+			"duplicate pair id: 1: invalid request",
+		},
+		{
+			"zero min order notional",
+			func(p *types.PairOrderRulesProposal) {
+				p.Changes[0].MinOrderNotional = notional(0)
+			},
+			"min order notional must be positive: 0: invalid request",
+		},
+		{
+			"empty title",
+			func(p *types.PairOrderRulesProposal) {
+				p.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tickPrec := uint32(2)
+			p := types.NewPairOrderRulesProposal(
+				"Title", "Description",
+				[]types.PairOrderRulesChange{types.NewPairOrderRulesChange(1, &tickPrec, notional(1000000))})
+			tc.malleate(p)
+			err := p.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -76,6 +76,102 @@ func (req *DepositRequest) SetStatus(status RequestStatus) {
 	req.Status = status
 }
 
+// NewSingleSidedDepositRequest returns a new SingleSidedDepositRequest.
+func NewSingleSidedDepositRequest(
+	msg *MsgDepositSingleSided, pool Pool, pair Pair, id uint64, msgHeight int64) SingleSidedDepositRequest {
+	receivedCoinDenom := pair.BaseCoinDenom
+	if msg.DepositCoin.Denom == pair.BaseCoinDenom {
+		receivedCoinDenom = pair.QuoteCoinDenom
+	}
+	return SingleSidedDepositRequest{
+		Id:                id,
+		PoolId:            msg.PoolId,
+		MsgHeight:         msgHeight,
+		Depositor:         msg.Depositor,
+		DepositCoin:       msg.DepositCoin,
+		MinMintedPoolCoin: msg.MinMintedPoolCoin,
+		SwappedCoin:       sdk.NewCoin(msg.DepositCoin.Denom, sdk.ZeroInt()),
+		ReceivedCoin:      sdk.NewCoin(receivedCoinDenom, sdk.ZeroInt()),
+		AcceptedCoins:     nil,
+		RefundedCoins:     nil,
+		MintedPoolCoin:    sdk.NewCoin(pool.PoolCoinDenom, sdk.ZeroInt()),
+		Status:            RequestStatusNotExecuted,
+	}
+}
+
+func (req SingleSidedDepositRequest) GetDepositor() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(req.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate validates SingleSidedDepositRequest for genesis.
+func (req SingleSidedDepositRequest) Validate() error {
+	if req.Id == 0 {
+		return fmt.Errorf("id must not be 0")
+	}
+	if req.PoolId == 0 {
+		return fmt.Errorf("pool id must not be 0")
+	}
+	if req.MsgHeight == 0 {
+		return fmt.Errorf("message height must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Depositor); err != nil {
+		return fmt.Errorf("invalid depositor address %s: %w", req.Depositor, err)
+	}
+	if err := req.DepositCoin.Validate(); err != nil {
+		return fmt.Errorf("invalid deposit coin %s: %w", req.DepositCoin, err)
+	}
+	if !req.DepositCoin.IsPositive() {
+		return fmt.Errorf("deposit coin must be positive: %s", req.DepositCoin)
+	}
+	if err := req.MinMintedPoolCoin.Validate(); err != nil {
+		return fmt.Errorf("invalid min minted pool coin %s: %w", req.MinMintedPoolCoin, err)
+	}
+	if err := req.SwappedCoin.Validate(); err != nil {
+		return fmt.Errorf("invalid swapped coin %s: %w", req.SwappedCoin, err)
+	}
+	if req.SwappedCoin.Denom != req.DepositCoin.Denom {
+		return fmt.Errorf("mismatching denom between deposit coin and swapped coin")
+	}
+	if req.DepositCoin.IsLT(req.SwappedCoin) {
+		return fmt.Errorf("swapped coin %s is greater than deposit coin %s", req.SwappedCoin, req.DepositCoin)
+	}
+	if err := req.ReceivedCoin.Validate(); err != nil {
+		return fmt.Errorf("invalid received coin %s: %w", req.ReceivedCoin, err)
+	}
+	if req.ReceivedCoin.Denom == req.DepositCoin.Denom {
+		return fmt.Errorf("received coin denom must not be same as deposit coin denom")
+	}
+	if err := req.AcceptedCoins.Validate(); err != nil {
+		return fmt.Errorf("invalid accepted coins: %w", err)
+	}
+	if len(req.AcceptedCoins) > 2 {
+		return fmt.Errorf("wrong number of accepted coins: %d", len(req.AcceptedCoins))
+	}
+	if err := req.RefundedCoins.Validate(); err != nil {
+		return fmt.Errorf("invalid refunded coins: %w", err)
+	}
+	if err := req.MintedPoolCoin.Validate(); err != nil {
+		return fmt.Errorf("invalid minted pool coin %s: %w", req.MintedPoolCoin, err)
+	}
+	if req.MintedPoolCoin.Denom != req.MinMintedPoolCoin.Denom {
+		return fmt.Errorf("mismatching denom between min minted pool coin and minted pool coin")
+	}
+	if !req.Status.IsValid() {
+		return fmt.Errorf("invalid status: %s", req.Status)
+	}
+	return nil
+}
+
+// SetStatus sets the request's status.
+// SetStatus is to easily find locations where the status is changed.
+func (req *SingleSidedDepositRequest) SetStatus(status RequestStatus) {
+	req.Status = status
+}
+
 // NewWithdrawRequest returns a new WithdrawRequest.
 func NewWithdrawRequest(msg *MsgWithdraw, id uint64, msgHeight int64) WithdrawRequest {
 	return WithdrawRequest{
@@ -89,6 +185,30 @@ func NewWithdrawRequest(msg *MsgWithdraw, id uint64, msgHeight int64) WithdrawRe
 	}
 }
 
+// NewSingleSidedWithdrawRequest returns a new WithdrawRequest for
+// MsgWithdrawSingleSided.
+func NewSingleSidedWithdrawRequest(
+	msg *MsgWithdrawSingleSided, pair Pair, id uint64, msgHeight int64) WithdrawRequest {
+	swappedCoinDenom := pair.BaseCoinDenom
+	if msg.MinWithdrawnCoin.Denom == pair.BaseCoinDenom {
+		swappedCoinDenom = pair.QuoteCoinDenom
+	}
+	return WithdrawRequest{
+		Id:             id,
+		PoolId:         msg.PoolId,
+		MsgHeight:      msgHeight,
+		Withdrawer:     msg.Withdrawer,
+		PoolCoin:       msg.PoolCoin,
+		WithdrawnCoins: nil,
+		Status:         RequestStatusNotExecuted,
+		SingleSided: &SingleSidedWithdrawal{
+			MinWithdrawnCoin: msg.MinWithdrawnCoin,
+			SwappedCoin:      sdk.NewCoin(swappedCoinDenom, sdk.ZeroInt()),
+			ReceivedCoin:     sdk.NewCoin(msg.MinWithdrawnCoin.Denom, sdk.ZeroInt()),
+		},
+	}
+}
+
 func (req WithdrawRequest) GetWithdrawer() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(req.Withdrawer)
 	if err != nil {
@@ -120,9 +240,26 @@ func (req WithdrawRequest) Validate() error {
 	if err := req.WithdrawnCoins.Validate(); err != nil {
 		return fmt.Errorf("invalid withdrawn coins: %w", err)
 	}
-	if len(req.WithdrawnCoins) > 2 {
+	// A withdrawal from a migrated pool can withdraw the target pool's pool
+	// coin along with the reserve coins left by the migration.
+	if len(req.WithdrawnCoins) > 3 {
 		return fmt.Errorf("wrong number of withdrawn coins: %d", len(req.WithdrawnCoins))
 	}
+	if req.SingleSided != nil {
+		if err := req.SingleSided.MinWithdrawnCoin.Validate(); err != nil {
+			return fmt.Errorf("invalid min withdrawn coin %s: %w", req.SingleSided.MinWithdrawnCoin, err)
+		}
+		if err := req.SingleSided.SwappedCoin.Validate(); err != nil {
+			return fmt.Errorf("invalid swapped coin %s: %w", req.SingleSided.SwappedCoin, err)
+		}
+		if err := req.SingleSided.ReceivedCoin.Validate(); err != nil {
+			return fmt.Errorf("invalid received coin %s: %w", req.SingleSided.ReceivedCoin, err)
+		}
+		if req.SingleSided.ReceivedCoin.Denom != req.SingleSided.MinWithdrawnCoin.Denom {
+			return fmt.Errorf("received coin denom %s must be the min withdrawn coin denom %s",
+				req.SingleSided.ReceivedCoin.Denom, req.SingleSided.MinWithdrawnCoin.Denom)
+		}
+	}
 	if !req.Status.IsValid() {
 		return fmt.Errorf("invalid status: %s", req.Status)
 	}
@@ -135,6 +272,78 @@ func (req *WithdrawRequest) SetStatus(status RequestStatus) {
 	req.Status = status
 }
 
+// NewSwapRequest returns a new SwapRequest.
+func NewSwapRequest(msg *MsgSwapExactIn, id uint64, msgHeight int64) SwapRequest {
+	return SwapRequest{
+		Id:            id,
+		MsgHeight:     msgHeight,
+		Orderer:       msg.Orderer,
+		PairIds:       msg.PairIds,
+		OfferCoin:     msg.OfferCoin,
+		MinOutCoin:    msg.MinOutCoin,
+		OutCoin:       sdk.NewCoin(msg.MinOutCoin.Denom, sdk.ZeroInt()),
+		RefundedCoins: nil,
+		Status:        RequestStatusNotExecuted,
+	}
+}
+
+func (req SwapRequest) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(req.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate validates SwapRequest for genesis.
+func (req SwapRequest) Validate() error {
+	if req.Id == 0 {
+		return fmt.Errorf("id must not be 0")
+	}
+	if req.MsgHeight == 0 {
+		return fmt.Errorf("message height must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Orderer); err != nil {
+		return fmt.Errorf("invalid orderer address %s: %w", req.Orderer, err)
+	}
+	if len(req.PairIds) == 0 {
+		return fmt.Errorf("route must not be empty")
+	}
+	for _, pairId := range req.PairIds {
+		if pairId == 0 {
+			return fmt.Errorf("pair id must not be 0")
+		}
+	}
+	if err := req.OfferCoin.Validate(); err != nil {
+		return fmt.Errorf("invalid offer coin %s: %w", req.OfferCoin, err)
+	}
+	if req.OfferCoin.IsZero() {
+		return fmt.Errorf("offer coin must not be 0")
+	}
+	if err := req.MinOutCoin.Validate(); err != nil {
+		return fmt.Errorf("invalid min out coin %s: %w", req.MinOutCoin, err)
+	}
+	if err := req.OutCoin.Validate(); err != nil {
+		return fmt.Errorf("invalid out coin %s: %w", req.OutCoin, err)
+	}
+	if req.OutCoin.Denom != req.MinOutCoin.Denom {
+		return fmt.Errorf("out coin denom %s != min out coin denom %s", req.OutCoin.Denom, req.MinOutCoin.Denom)
+	}
+	if err := req.RefundedCoins.Validate(); err != nil {
+		return fmt.Errorf("invalid refunded coins: %w", err)
+	}
+	if !req.Status.IsValid() {
+		return fmt.Errorf("invalid status: %s", req.Status)
+	}
+	return nil
+}
+
+// SetStatus sets the request's status.
+// SetStatus is to easily find locations where the status is changed.
+func (req *SwapRequest) SetStatus(status RequestStatus) {
+	req.Status = status
+}
+
 // NewOrderForLimitOrder returns a new Order from MsgLimitOrder.
 func NewOrderForLimitOrder(msg *MsgLimitOrder, id uint64, pair Pair, offerCoin sdk.Coin, price sdk.Dec, expireAt time.Time, msgHeight int64) Order {
 	return Order{
@@ -147,12 +356,15 @@ func NewOrderForLimitOrder(msg *MsgLimitOrder, id uint64, pair Pair, offerCoin s
 		OfferCoin:          offerCoin,
 		RemainingOfferCoin: offerCoin,
 		ReceivedCoin:       sdk.NewCoin(msg.DemandCoinDenom, sdk.ZeroInt()),
+		Fee:                sdk.NewCoin(msg.DemandCoinDenom, sdk.ZeroInt()),
 		Price:              price,
 		Amount:             msg.Amount,
 		OpenAmount:         msg.Amount,
 		BatchId:            pair.CurrentBatchId,
 		ExpireAt:           expireAt,
 		Status:             OrderStatusNotExecuted,
+		TimeInForce:        msg.TimeInForce,
+		PostOnly:           msg.PostOnly,
 	}
 }
 
@@ -168,6 +380,7 @@ func NewOrderForMarketOrder(msg *MsgMarketOrder, id uint64, pair Pair, offerCoin
 		OfferCoin:          offerCoin,
 		RemainingOfferCoin: offerCoin,
 		ReceivedCoin:       sdk.NewCoin(msg.DemandCoinDenom, sdk.ZeroInt()),
+		Fee:                sdk.NewCoin(msg.DemandCoinDenom, sdk.ZeroInt()),
 		Price:              price,
 		Amount:             msg.Amount,
 		OpenAmount:         msg.Amount,
@@ -201,6 +414,7 @@ func NewOrder(
 		OfferCoin:          offerCoin,
 		RemainingOfferCoin: offerCoin,
 		ReceivedCoin:       sdk.NewCoin(demandCoinDenom, sdk.ZeroInt()),
+		Fee:                sdk.NewCoin(demandCoinDenom, sdk.ZeroInt()),
 		Price:              price,
 		Amount:             amt,
 		OpenAmount:         amt,
@@ -250,6 +464,12 @@ func (order Order) Validate() error {
 	if err := order.ReceivedCoin.Validate(); err != nil {
 		return fmt.Errorf("invalid received coin %s: %w", order.ReceivedCoin, err)
 	}
+	if err := order.Fee.Validate(); err != nil {
+		return fmt.Errorf("invalid fee %s: %w", order.Fee, err)
+	}
+	if order.ReceivedCoin.Denom != order.Fee.Denom {
+		return fmt.Errorf("received coin denom %s != fee denom %s", order.ReceivedCoin.Denom, order.Fee.Denom)
+	}
 	if !order.Price.IsPositive() {
 		return fmt.Errorf("price must be positive: %s", order.Price)
 	}
@@ -268,9 +488,39 @@ func (order Order) Validate() error {
 	if !order.Status.IsValid() {
 		return fmt.Errorf("invalid status: %s", order.Status)
 	}
+	if !order.TimeInForce.IsValid() {
+		return fmt.Errorf("invalid time in force: %s", order.TimeInForce)
+	}
+	if order.Type == OrderTypeTrigger {
+		if order.TriggerPrice == nil || !order.TriggerPrice.IsPositive() {
+			return fmt.Errorf("trigger price must be positive: %v", order.TriggerPrice)
+		}
+		if order.TriggerCondition != TriggerConditionGTE && order.TriggerCondition != TriggerConditionLTE {
+			return fmt.Errorf("invalid trigger condition: %s", order.TriggerCondition)
+		}
+		if order.TriggeredOrderType != OrderTypeLimit && order.TriggeredOrderType != OrderTypeMarket {
+			return fmt.Errorf("invalid triggered order type: %s", order.TriggeredOrderType)
+		}
+	}
 	return nil
 }
 
+// ShouldBeTriggered returns whether the trigger order should be triggered
+// with given last price of the pair.
+func (order Order) ShouldBeTriggered(lastPrice sdk.Dec) bool {
+	if order.Type != OrderTypeTrigger || order.TriggerPrice == nil {
+		return false
+	}
+	switch order.TriggerCondition {
+	case TriggerConditionGTE:
+		return lastPrice.GTE(*order.TriggerPrice)
+	case TriggerConditionLTE:
+		return lastPrice.LTE(*order.TriggerPrice)
+	default:
+		return false
+	}
+}
+
 // ExpiredAt returns whether the order should be deleted at given time.
 func (order Order) ExpiredAt(t time.Time) bool {
 	return !order.ExpireAt.After(t)
@@ -317,6 +567,17 @@ func (status OrderStatus) IsValid() bool {
 	}
 }
 
+// IsValid returns true if the TimeInForce is one of:
+// TimeInForceUnspecified, TimeInForceImmediateOrCancel, TimeInForceFillOrKill.
+func (tif TimeInForce) IsValid() bool {
+	switch tif {
+	case TimeInForceUnspecified, TimeInForceImmediateOrCancel, TimeInForceFillOrKill:
+		return true
+	default:
+		return false
+	}
+}
+
 // IsMatchable returns true if the OrderStatus is one of:
 // OrderStatusNotExecuted, OrderStatusNotMatched, OrderStatusPartiallyMatched.
 func (status OrderStatus) IsMatchable() bool {
@@ -382,6 +643,28 @@ func MustUnmarshalDepositRequest(cdc codec.BinaryCodec, value []byte) DepositReq
 	return msg
 }
 
+// MustMarshalSingleSidedDepositRequest returns the SingleSidedDepositRequest bytes.
+// It throws panic if it fails.
+func MustMarshalSingleSidedDepositRequest(cdc codec.BinaryCodec, req SingleSidedDepositRequest) []byte {
+	return cdc.MustMarshal(&req)
+}
+
+// UnmarshalSingleSidedDepositRequest returns the SingleSidedDepositRequest from bytes.
+func UnmarshalSingleSidedDepositRequest(cdc codec.BinaryCodec, value []byte) (req SingleSidedDepositRequest, err error) {
+	err = cdc.Unmarshal(value, &req)
+	return req, err
+}
+
+// MustUnmarshalSingleSidedDepositRequest returns the SingleSidedDepositRequest from bytes.
+// It throws panic if it fails.
+func MustUnmarshalSingleSidedDepositRequest(cdc codec.BinaryCodec, value []byte) SingleSidedDepositRequest {
+	req, err := UnmarshalSingleSidedDepositRequest(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return req
+}
+
 // MustMarshaWithdrawRequest returns the WithdrawRequest bytes.
 // It throws panic if it fails.
 func MustMarshaWithdrawRequest(cdc codec.BinaryCodec, msg WithdrawRequest) []byte {
@@ -404,6 +687,28 @@ func MustUnmarshalWithdrawRequest(cdc codec.BinaryCodec, value []byte) WithdrawR
 	return msg
 }
 
+// MustMarshalSwapRequest returns the SwapRequest bytes.
+// It throws panic if it fails.
+func MustMarshalSwapRequest(cdc codec.BinaryCodec, req SwapRequest) []byte {
+	return cdc.MustMarshal(&req)
+}
+
+// UnmarshalSwapRequest returns the SwapRequest from bytes.
+func UnmarshalSwapRequest(cdc codec.BinaryCodec, value []byte) (req SwapRequest, err error) {
+	err = cdc.Unmarshal(value, &req)
+	return req, err
+}
+
+// MustUnmarshalSwapRequest returns the SwapRequest from bytes.
+// It throws panic if it fails.
+func MustUnmarshalSwapRequest(cdc codec.BinaryCodec, value []byte) SwapRequest {
+	req, err := UnmarshalSwapRequest(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return req
+}
+
 // MustMarshaOrder returns the Order bytes.
 // It throws panic if it fails.
 func MustMarshaOrder(cdc codec.BinaryCodec, order Order) []byte {
@@ -195,9 +195,9 @@ func TestWithdrawRequest_Validate(t *testing.T) {
 		{
 			"wrong number of withdrawn coins",
 			func(req *types.WithdrawRequest) {
-				req.WithdrawnCoins = utils.ParseCoins("100000denom1,1000000denom2,1000000denom3")
+				req.WithdrawnCoins = utils.ParseCoins("100000denom1,1000000denom2,1000000denom3,1000000denom4")
 			},
-			"wrong number of withdrawn coins: 3",
+			"wrong number of withdrawn coins: 4",
 		},
 		{
 			"invalid status",
@@ -360,6 +360,44 @@ func TestOrder_Validate(t *testing.T) {
 			},
 			"invalid status: 10",
 		},
+		{
+			"invalid time in force",
+			func(order *types.Order) {
+				order.TimeInForce = 10
+			},
+			"invalid time in force: 10",
+		},
+		{
+			"invalid trigger price",
+			func(order *types.Order) {
+				order.Type = types.OrderTypeTrigger
+				order.TriggerPrice = nil
+				order.TriggerCondition = types.TriggerConditionGTE
+				order.TriggeredOrderType = types.OrderTypeLimit
+			},
+			"trigger price must be positive: <nil>",
+		},
+		{
+			"invalid trigger condition",
+			func(order *types.Order) {
+				p := utils.ParseDec("1.1")
+				order.Type = types.OrderTypeTrigger
+				order.TriggerPrice = &p
+				order.TriggeredOrderType = types.OrderTypeLimit
+			},
+			"invalid trigger condition: TRIGGER_CONDITION_UNSPECIFIED",
+		},
+		{
+			"invalid triggered order type",
+			func(order *types.Order) {
+				p := utils.ParseDec("1.1")
+				order.Type = types.OrderTypeTrigger
+				order.TriggerPrice = &p
+				order.TriggerCondition = types.TriggerConditionGTE
+				order.TriggeredOrderType = types.OrderTypeMM
+			},
+			"invalid triggered order type: ORDER_TYPE_MM",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			pair := types.NewPair(1, "denom1", "denom2")
@@ -379,3 +417,32 @@ func TestOrder_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestOrder_ShouldBeTriggered(t *testing.T) {
+	triggerPrice := utils.ParseDec("1.0")
+	for _, tc := range []struct {
+		cond      types.TriggerCondition
+		lastPrice sdk.Dec
+		expected  bool
+	}{
+		{types.TriggerConditionGTE, utils.ParseDec("0.99"), false},
+		{types.TriggerConditionGTE, utils.ParseDec("1.0"), true},
+		{types.TriggerConditionGTE, utils.ParseDec("1.01"), true},
+		{types.TriggerConditionLTE, utils.ParseDec("0.99"), true},
+		{types.TriggerConditionLTE, utils.ParseDec("1.0"), true},
+		{types.TriggerConditionLTE, utils.ParseDec("1.01"), false},
+	} {
+		t.Run("", func(t *testing.T) {
+			order := types.Order{
+				Type:               types.OrderTypeTrigger,
+				TriggerPrice:       &triggerPrice,
+				TriggerCondition:   tc.cond,
+				TriggeredOrderType: types.OrderTypeMarket,
+			}
+			require.Equal(t, tc.expected, order.ShouldBeTriggered(tc.lastPrice))
+			// Orders which are already triggered are never triggered again.
+			order.Type = types.OrderTypeMarket
+			require.False(t, order.ShouldBeTriggered(tc.lastPrice))
+		})
+	}
+}
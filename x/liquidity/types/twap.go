@@ -0,0 +1,134 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTWAPAccumulator returns a new TWAPAccumulator with zero cumulative
+// price, which starts accumulating the given price from given time.
+func NewTWAPAccumulator(pairId uint64, lastPrice sdk.Dec, t time.Time) TWAPAccumulator {
+	return TWAPAccumulator{
+		PairId:          pairId,
+		CumulativePrice: sdk.ZeroDec(),
+		LastPrice:       lastPrice,
+		LastUpdatedAt:   t,
+	}
+}
+
+// CumulativePriceAt returns the cumulative price at given time, assuming
+// that the last price lasted until then.
+func (acc TWAPAccumulator) CumulativePriceAt(t time.Time) sdk.Dec {
+	if !t.After(acc.LastUpdatedAt) {
+		return acc.CumulativePrice
+	}
+	return acc.CumulativePrice.Add(acc.LastPrice.Mul(DurationToSeconds(t.Sub(acc.LastUpdatedAt))))
+}
+
+// Update accumulates the last price until given time and sets the new last
+// price.
+func (acc *TWAPAccumulator) Update(lastPrice sdk.Dec, t time.Time) {
+	acc.CumulativePrice = acc.CumulativePriceAt(t)
+	acc.LastPrice = lastPrice
+	acc.LastUpdatedAt = t
+}
+
+// Snapshot returns the snapshot of the accumulator at given time with
+// given sequence.
+func (acc TWAPAccumulator) Snapshot(seq uint64, t time.Time) TWAPSnapshot {
+	return TWAPSnapshot{
+		PairId:          acc.PairId,
+		Seq:             seq,
+		Time:            t,
+		CumulativePrice: acc.CumulativePriceAt(t),
+	}
+}
+
+// Validate validates TWAPAccumulator for genesis.
+func (acc TWAPAccumulator) Validate() error {
+	if acc.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if acc.CumulativePrice.IsNil() || acc.CumulativePrice.IsNegative() {
+		return fmt.Errorf("invalid cumulative price: %s", acc.CumulativePrice)
+	}
+	if acc.LastPrice.IsNil() || !acc.LastPrice.IsPositive() {
+		return fmt.Errorf("last price must be positive: %s", acc.LastPrice)
+	}
+	return nil
+}
+
+// Validate validates TWAPSnapshot for genesis.
+func (snapshot TWAPSnapshot) Validate() error {
+	if snapshot.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if snapshot.Seq == 0 {
+		return fmt.Errorf("seq must not be 0")
+	}
+	if snapshot.CumulativePrice.IsNil() || snapshot.CumulativePrice.IsNegative() {
+		return fmt.Errorf("invalid cumulative price: %s", snapshot.CumulativePrice)
+	}
+	return nil
+}
+
+// TWAPBetween returns the time-weighted average price between the snapshot
+// and the cumulative price at given time.
+func (snapshot TWAPSnapshot) TWAPBetween(cumulativePrice sdk.Dec, t time.Time) sdk.Dec {
+	return cumulativePrice.Sub(snapshot.CumulativePrice).Quo(DurationToSeconds(t.Sub(snapshot.Time)))
+}
+
+// DurationToSeconds returns the duration in seconds as sdk.Dec.
+func DurationToSeconds(d time.Duration) sdk.Dec {
+	return sdk.NewDecWithPrec(d.Nanoseconds(), 9)
+}
+
+// MustMarshalTWAPAccumulator returns the twap accumulator bytes.
+// It throws panic if it fails.
+func MustMarshalTWAPAccumulator(cdc codec.BinaryCodec, acc TWAPAccumulator) []byte {
+	return cdc.MustMarshal(&acc)
+}
+
+// MustUnmarshalTWAPAccumulator return the unmarshalled twap accumulator
+// from bytes.
+// It throws panic if it fails.
+func MustUnmarshalTWAPAccumulator(cdc codec.BinaryCodec, value []byte) TWAPAccumulator {
+	acc, err := UnmarshalTWAPAccumulator(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return acc
+}
+
+// UnmarshalTWAPAccumulator returns the twap accumulator from bytes.
+func UnmarshalTWAPAccumulator(cdc codec.BinaryCodec, value []byte) (acc TWAPAccumulator, err error) {
+	err = cdc.Unmarshal(value, &acc)
+	return acc, err
+}
+
+// MustMarshalTWAPSnapshot returns the twap snapshot bytes.
+// It throws panic if it fails.
+func MustMarshalTWAPSnapshot(cdc codec.BinaryCodec, snapshot TWAPSnapshot) []byte {
+	return cdc.MustMarshal(&snapshot)
+}
+
+// MustUnmarshalTWAPSnapshot return the unmarshalled twap snapshot from bytes.
+// It throws panic if it fails.
+func MustUnmarshalTWAPSnapshot(cdc codec.BinaryCodec, value []byte) TWAPSnapshot {
+	snapshot, err := UnmarshalTWAPSnapshot(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return snapshot
+}
+
+// UnmarshalTWAPSnapshot returns the twap snapshot from bytes.
+func UnmarshalTWAPSnapshot(cdc codec.BinaryCodec, value []byte) (snapshot TWAPSnapshot, err error) {
+	err = cdc.Unmarshal(value, &snapshot)
+	return snapshot, err
+}
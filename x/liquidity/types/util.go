@@ -87,6 +87,11 @@ func NewPoolResponse(pool Pool, rx, ry sdk.Coin, poolCoinSupply sdk.Int) PoolRes
 		LastDepositRequestId:  pool.LastDepositRequestId,
 		LastWithdrawRequestId: pool.LastWithdrawRequestId,
 		Disabled:              pool.Disabled,
+		Amplification:         pool.Amplification,
+		BaseCoinWeight:        pool.BaseCoinWeight,
+		QuoteCoinWeight:       pool.QuoteCoinWeight,
+		LiquidityTicks:        pool.LiquidityTicks,
+		LastPositionId:        pool.LastPositionId,
 	}
 }
 
@@ -32,9 +32,23 @@ func PoolRewardWeight(pool amm.Pool) (weight sdk.Dec) {
 	switch pool := pool.(type) {
 	case *amm.BasicPool:
 		weight = sqrt(sdk.NewDecFromInt(rx.Mul(ry)))
+	case *amm.StablePool:
+		// The amplification is not taken into account, so a stable pool
+		// has the same weight as a basic pool with the same reserves.
+		weight = sqrt(sdk.NewDecFromInt(rx.Mul(ry)))
+	case *amm.WeightedPool:
+		// The weight equals to the weight of a basic pool holding the same
+		// value of reserves, which is sqrt(rx*ry) * (wx+wy) / (2*sqrt(wx*wy)).
+		wx, wy := pool.Weights()
+		n := int64(wx + wy)
+		weight = sqrt(sdk.NewDecFromInt(rx.Mul(ry).MulRaw(n * n)).QuoInt64(4 * int64(wx) * int64(wy)))
 	case *amm.RangedPool:
 		transX, transY := pool.Translation()
 		weight = sqrt(transX.Add(sdk.NewDecFromInt(rx))).Mul(sqrt(transY.Add(sdk.NewDecFromInt(ry))))
+	case *amm.ConcentratedPool:
+		// The liquidity at the current price is used, which is same as
+		// sqrt(rx*ry) for a basic pool.
+		weight = pool.Liquidity()
 	default:
 		panic("invalid pool type")
 	}
@@ -72,6 +72,24 @@ func TestPoolRewardWeight(t *testing.T) {
 				utils.ParseDec("0.99"), utils.ParseDec("1.01")),
 			utils.ParseDec("200493749898.277059377703066722"),
 		},
+		{
+			"#5",
+			amm.NewWeightedPool(sdk.NewInt(1000_000000), sdk.NewInt(1000_000000), sdk.Int{}, 50, 50),
+			utils.ParseDec("1000000000"),
+		},
+		{
+			"#6",
+			amm.NewWeightedPool(sdk.NewInt(4000_000000), sdk.NewInt(1000_000000), sdk.Int{}, 80, 20),
+			utils.ParseDec("2500000000"),
+		},
+		{
+			"#7",
+			amm.NewConcentratedPool(utils.ParseDec("1"), []amm.LiquidityTick{
+				{Price: utils.ParseDec("0.5"), LiquidityNet: utils.ParseDec("1000000000")},
+				{Price: utils.ParseDec("2"), LiquidityNet: utils.ParseDec("-1000000000")},
+			}),
+			utils.ParseDec("1000000000"),
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			weight := types.PoolRewardWeight(tc.pool)